@@ -36,6 +36,8 @@ func newOperatorCmds() *cobra.Command {
 		newCreateConsensusKeyCmd(),
 		newUnjailCmd(),
 		newDelegateCmd(),
+		newUndelegateCmd(),
+		newRedelegateCmd(),
 		newEditValCmd(),
 	)
 
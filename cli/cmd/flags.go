@@ -86,6 +86,26 @@ func bindDelegateConfig(cmd *cobra.Command, cfg *DelegateConfig) {
 	_ = cmd.MarkFlagRequired(flagDelegationAmount)
 }
 
+func bindUndelegateConfig(cmd *cobra.Command, cfg *UndelegateConfig) {
+	bindEOAConfig(cmd, &cfg.EOAConfig)
+	cmd.Flags().Uint64Var(&cfg.Amount, flagDelegationAmount, cfg.Amount, "Undelegation amount in OMNI")
+	cmd.Flags().StringVar(&cfg.ValidatorAddress, "validator-address", cfg.ValidatorAddress, "Validator address to undelegate from")
+
+	_ = cmd.MarkFlagRequired(flagDelegationAmount)
+	_ = cmd.MarkFlagRequired("validator-address")
+}
+
+func bindRedelegateConfig(cmd *cobra.Command, cfg *RedelegateConfig) {
+	bindEOAConfig(cmd, &cfg.EOAConfig)
+	cmd.Flags().Uint64Var(&cfg.Amount, flagDelegationAmount, cfg.Amount, "Redelegation amount in OMNI")
+	cmd.Flags().StringVar(&cfg.SrcValidatorAddress, "src-validator-address", cfg.SrcValidatorAddress, "Validator address to move stake from")
+	cmd.Flags().StringVar(&cfg.DstValidatorAddress, "dst-validator-address", cfg.DstValidatorAddress, "Validator address to move stake to")
+
+	_ = cmd.MarkFlagRequired(flagDelegationAmount)
+	_ = cmd.MarkFlagRequired("src-validator-address")
+	_ = cmd.MarkFlagRequired("dst-validator-address")
+}
+
 func bindCreateValConfig(cmd *cobra.Command, cfg *CreateValConfig) {
 	bindEOAConfig(cmd, &cfg.EOAConfig)
 
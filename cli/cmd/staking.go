@@ -380,6 +380,240 @@ func Delegate(ctx context.Context, cfg DelegateConfig) error {
 	return nil
 }
 
+type UndelegateConfig struct {
+	EOAConfig
+	Amount           uint64
+	ValidatorAddress string
+}
+
+func (d UndelegateConfig) validate() error {
+	if !common.IsHexAddress(d.ValidatorAddress) {
+		return errors.New("invalid --validator-address")
+	}
+
+	if d.Amount == 0 {
+		return errors.New("zero --amount")
+	}
+
+	return d.EOAConfig.validate()
+}
+
+func newUndelegateCmd() *cobra.Command {
+	var cfg UndelegateConfig
+
+	cmd := &cobra.Command{
+		Use:   "undelegate",
+		Short: "Undelegate Omni tokens from a validator",
+		Long: `Undelegate an amount of Omni tokens from a validator.
+The tokens are withdrawn to your wallet once the unbonding period completes.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cfg.validate(); err != nil {
+				return errors.Wrap(err, "verify flags")
+			}
+
+			err := Undelegate(cmd.Context(), cfg)
+			if err != nil {
+				return errors.Wrap(err, "undelegate")
+			}
+
+			return nil
+		},
+	}
+
+	bindUndelegateConfig(cmd, &cfg)
+
+	return cmd
+}
+
+func Undelegate(ctx context.Context, cfg UndelegateConfig) error {
+	delegatorPriv, err := cfg.privateKey()
+	if err != nil {
+		return err
+	}
+	delegatorAddr := crypto.PubkeyToAddress(delegatorPriv.PublicKey)
+
+	_, cprov, backend, err := setupClients(cfg.EOAConfig, delegatorPriv)
+	if err != nil {
+		return err
+	}
+
+	validatorAddr := common.HexToAddress(cfg.ValidatorAddress)
+	if _, ok, err := cprov.SDKValidator(ctx, validatorAddr); err != nil {
+		return err
+	} else if !ok {
+		return &CliError{
+			Msg:     "Not a validator address: " + validatorAddr.Hex(),
+			Suggest: "Ensure the --validator-address is a validator you delegated to",
+		}
+	}
+
+	contract, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+	if err != nil {
+		return err
+	}
+
+	fee, err := contract.Fee(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	txOpts, err := backend.BindOpts(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	txOpts.Value = fee
+
+	amount := new(big.Int).Mul(umath.NewBigInt(cfg.Amount), big.NewInt(params.Ether))
+	tx, err := contract.Undelegate(txOpts, validatorAddr, amount)
+	if err != nil {
+		return errors.Wrap(err, "undelegate")
+	}
+
+	rec, err := backend.WaitMined(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	log.Info(ctx, "🎉 Undelegate transaction sent and included on-chain",
+		"link", cfg.Network.Static().OmniScanTXURL(tx.Hash()),
+		"block", rec.BlockNumber.Uint64(),
+	)
+
+	log.Info(ctx, "⏳ Staking events are delayed in the Omni Consensus chain and may take up to 12h to apply. Undelegated tokens are withdrawn after the unbonding period.")
+
+	return nil
+}
+
+type RedelegateConfig struct {
+	EOAConfig
+	Amount              uint64
+	SrcValidatorAddress string
+	DstValidatorAddress string
+}
+
+func (d RedelegateConfig) validate() error {
+	if !common.IsHexAddress(d.SrcValidatorAddress) {
+		return errors.New("invalid --src-validator-address")
+	}
+
+	if !common.IsHexAddress(d.DstValidatorAddress) {
+		return errors.New("invalid --dst-validator-address")
+	}
+
+	if common.HexToAddress(d.SrcValidatorAddress) == common.HexToAddress(d.DstValidatorAddress) {
+		return errors.New("identical --src-validator-address and --dst-validator-address")
+	}
+
+	if d.Amount == 0 {
+		return errors.New("zero --amount")
+	}
+
+	return d.EOAConfig.validate()
+}
+
+func newRedelegateCmd() *cobra.Command {
+	var cfg RedelegateConfig
+
+	cmd := &cobra.Command{
+		Use:   "redelegate",
+		Short: "Redelegate Omni tokens from one validator to another",
+		Long:  `Move an amount of delegated Omni tokens from one validator to another, without unbonding.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cfg.validate(); err != nil {
+				return errors.Wrap(err, "verify flags")
+			}
+
+			err := Redelegate(cmd.Context(), cfg)
+			if err != nil {
+				return errors.Wrap(err, "redelegate")
+			}
+
+			return nil
+		},
+	}
+
+	bindRedelegateConfig(cmd, &cfg)
+
+	return cmd
+}
+
+func Redelegate(ctx context.Context, cfg RedelegateConfig) error {
+	delegatorPriv, err := cfg.privateKey()
+	if err != nil {
+		return err
+	}
+	delegatorAddr := crypto.PubkeyToAddress(delegatorPriv.PublicKey)
+
+	_, cprov, backend, err := setupClients(cfg.EOAConfig, delegatorPriv)
+	if err != nil {
+		return err
+	}
+
+	srcAddr := common.HexToAddress(cfg.SrcValidatorAddress)
+	dstAddr := common.HexToAddress(cfg.DstValidatorAddress)
+	for _, validatorAddr := range []common.Address{srcAddr, dstAddr} {
+		if _, ok, err := cprov.SDKValidator(ctx, validatorAddr); err != nil {
+			return err
+		} else if !ok {
+			return &CliError{
+				Msg:     "Not a validator address: " + validatorAddr.Hex(),
+				Suggest: "Ensure both source and destination validators exist",
+			}
+		}
+	}
+
+	contract, err := bindings.NewStaking(common.HexToAddress(predeploys.Staking), backend)
+	if err != nil {
+		return err
+	}
+
+	callOpts := &bind.CallOpts{Context: ctx}
+	ok, err := contract.IsAllowlistEnabled(callOpts)
+	if err != nil {
+		return errors.Wrap(err, "check allowlist enabled")
+	} else if ok {
+		ok, err := contract.IsAllowedValidator(callOpts, dstAddr)
+		if err != nil {
+			return err
+		} else if !ok {
+			return errors.New("active validator not in allowed list [BUG]")
+		}
+	}
+
+	fee, err := contract.Fee(callOpts)
+	if err != nil {
+		return err
+	}
+
+	txOpts, err := backend.BindOpts(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	txOpts.Value = fee
+
+	amount := new(big.Int).Mul(umath.NewBigInt(cfg.Amount), big.NewInt(params.Ether))
+	tx, err := contract.Redelegate(txOpts, srcAddr, dstAddr, amount)
+	if err != nil {
+		return errors.Wrap(err, "redelegate")
+	}
+
+	rec, err := backend.WaitMined(ctx, tx)
+	if err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	log.Info(ctx, "🎉 Redelegate transaction sent and included on-chain",
+		"link", cfg.Network.Static().OmniScanTXURL(tx.Hash()),
+		"block", rec.BlockNumber.Uint64(),
+	)
+
+	log.Info(ctx, "⏳ Staking events are delayed in the Omni Consensus chain and may take up to 12h to apply.")
+
+	return nil
+}
+
 func newUnjailCmd() *cobra.Command {
 	var cfg EOAConfig
 
@@ -42,7 +42,7 @@ type StakingEditValidatorParams struct {
 
 // StakingMetaData contains all meta data concerning the Staking contract.
 var StakingMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"Fee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDelegation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegateFor\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"disableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"disallowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"editValidator\",\"inputs\":[{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"security_contact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commission_rate_percentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"min_self_delegation\",\"type\":\"int128\",\"internalType\":\"int128\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"enableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getConsPubkeyDigest\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initializeV1\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initializeV2\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isAllowedValidator\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isAllowlistEnabled\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"AllowlistDisabled\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AllowlistEnabled\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CreateValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"pubkey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"deposit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Delegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EditValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"params\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"security_contact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commission_rate_percentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"min_self_delegation\",\"type\":\"int128\",\"internalType\":\"int128\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorAllowed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorDisallowed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"Fee\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDelegation\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MinDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"createValidator\",\"inputs\":[{\"name\":\"pubkey\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegateFor\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"disableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"disallowValidators\",\"inputs\":[{\"name\":\"validators\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"editValidator\",\"inputs\":[{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"security_contact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commission_rate_percentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"min_self_delegation\",\"type\":\"int128\",\"internalType\":\"int128\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"eip712Domain\",\"inputs\":[],\"outputs\":[{\"name\":\"fields\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"},{\"name\":\"name\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"version\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"chainId\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"verifyingContract\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"extensions\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"enableAllowlist\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getConsPubkeyDigest\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initializeV1\",\"inputs\":[{\"name\":\"owner_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"isAllowlistEnabled_\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initializeV2\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isAllowedValidator\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isAllowlistEnabled\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"owner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"redelegate\",\"inputs\":[{\"name\":\"srcValidator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"dstValidator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"renounceOwnership\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferOwnership\",\"inputs\":[{\"name\":\"newOwner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"undelegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"event\",\"name\":\"AllowlistDisabled\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AllowlistEnabled\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CreateValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"pubkey\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"deposit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Delegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EIP712DomainChanged\",\"inputs\":[],\"anonymous\":false},{\"type\":\"event\",\"name\":\"EditValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"params\",\"type\":\"tuple\",\"indexed\":false,\"internalType\":\"structStaking.EditValidatorParams\",\"components\":[{\"name\":\"moniker\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"identity\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"website\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"security_contact\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"details\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"commission_rate_percentage\",\"type\":\"int32\",\"internalType\":\"int32\"},{\"name\":\"min_self_delegation\",\"type\":\"int128\",\"internalType\":\"int128\"}]}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OwnershipTransferred\",\"inputs\":[{\"name\":\"previousOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newOwner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Redelegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"srcValidator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"dstValidator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Undelegate\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorAllowed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ValidatorDisallowed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OwnableInvalidOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"OwnableUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
 	Bin: "0x608060405234801561001057600080fd5b5061001961001e565b6100d0565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff161561006e5760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146100cd5780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b6123b080620000e06000396000f3fe6080604052600436106101355760003560e01c806384b0196e116100ab578063b62ac12c1161006f578063b62ac12c14610340578063bef7a2f014610360578063c6a2aac81461037c578063cf8e629a14610391578063d146fd1b146103a6578063f2fde38b146103c057600080fd5b806384b0196e1461028b5780638da5cb5b146102b35780638f38fae8146102fa578063a5a470ad1461031a578063af1e5fcf1461032d57600080fd5b806359bcddde116100fd57806359bcddde146101df5780635c19a95c146101fb5780635cd8a76b1461020e578063715018a61461022357806378fcbe5b1461023857806384768b7a1461024b57600080fd5b8063117407e31461013a57806311bcd8301461015c5780631fa10bcc1461018c5780633f0b1edf1461019f578063400ada75146101bf575b600080fd5b34801561014657600080fd5b5061015a610155366004611b9a565b6103e0565b005b34801561016857600080fd5b5061017968056bc75e2d6310000081565b6040519081526020015b60405180910390f35b61015a61019a366004611c4f565b6104b0565b3480156101ab57600080fd5b5061015a6101ba366004611b9a565b61058a565b3480156101cb57600080fd5b5061015a6101da366004611cd6565b610656565b3480156101eb57600080fd5b50610179670de0b6b3a764000081565b61015a610209366004611d12565b6107a3565b34801561021a57600080fd5b5061015a6107b0565b34801561022f57600080fd5b5061015a6108b0565b61015a610246366004611d2d565b6108c4565b34801561025757600080fd5b5061027b610266366004611d12565b60016020526000908152604090205460ff1681565b6040519015158152602001610183565b34801561029757600080fd5b506102a06108d2565b6040516101839796959493929190611da6565b3480156102bf57600080fd5b507f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546040516001600160a01b039091168152602001610183565b34801561030657600080fd5b5061015a610315366004611cd6565b61097e565b61015a610328366004611e3f565b610a2c565b61015a61033b366004611e80565b610ae5565b34801561034c57600080fd5b5061017961035b366004611d12565b610e79565b34801561036c57600080fd5b5061017967016345785d8a000081565b34801561038857600080fd5b5061015a610ee4565b34801561039d57600080fd5b5061015a610f22565b3480156103b257600080fd5b5060005461027b9060ff1681565b3480156103cc57600080fd5b5061015a6103db366004611d12565b610f5d565b6103e8610f98565b60005b818110156104ab57600180600085858581811061040a5761040a611eba565b905060200201602081019061041f9190611d12565b6001600160a01b031681526020810191909152604001600020805460ff191691151591909117905582828281811061045957610459611eba565b905060200201602081019061046e9190611d12565b6001600160a01b03167fc6bdfc1f9b9f1f30ad26b86a7c623e58400512467a50e0c80439bfdaf3a2de9860405160405180910390a26001016103eb565b505050565b60005460ff1615806104d157503360009081526001602052604090205460ff165b6104f65760405162461bcd60e51b81526004016104ed90611ed0565b60405180910390fd5b68056bc75e2d6310000034101561051f5760405162461bcd60e51b81526004016104ed90611efe565b60008061052c8686610ff3565b9150915061053d828233878761118d565b336001600160a01b03167fc7abef7b73f049da6a9bc2349ba5066a39e316eabc9f671b6f9406aa9490a45387873460405161057a93929190611f5e565b60405180910390a2505050505050565b610592610f98565b60005b818110156104ab576000600160008585858181106105b5576105b5611eba565b90506020020160208101906105ca9190611d12565b6001600160a01b031681526020810191909152604001600020805460ff191691151591909117905582828281811061060457610604611eba565b90506020020160208101906106199190611d12565b6001600160a01b03167f3df1f5fcca9e1ece84ca685a63062905d8fe97ddb23246224be416f2d3c8613f60405160405180910390a2600101610595565b60008051602061235b8339815191528054600160401b810460ff1615906001600160401b03166000811580156106895750825b90506000826001600160401b031660011480156106a55750303b155b9050811580156106b3575080155b156106d15760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff1916600117855583156106fb57845460ff60401b1916600160401b1785555b6107048761124d565b610746604051806040016040528060078152602001665374616b696e6760c81b815250604051806040016040528060018152602001603160f81b81525061125e565b6000805460ff1916871515179055831561079a57845460ff60401b19168555604051600181527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50505050505050565b6107ad3382611270565b50565b60008051602061235b833981519152805460029190600160401b900460ff16806107e7575080546001600160401b03808416911610155b156108055760405163f92ee8a960e01b815260040160405180910390fd5b805468ffffffffffffffffff19166001600160401b03831617600160401b17815560408051808201825260078152665374616b696e6760c81b602080830191909152825180840190935260018352603160f81b908301526108659161125e565b805460ff60401b191681556040516001600160401b03831681527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15050565b6108b8610f98565b6108c2600061135f565b565b6108ce8282611270565b5050565b6000606080828080838160008051602061233b83398151915280549091501580156108ff57506001810154155b6109435760405162461bcd60e51b81526020600482015260156024820152741152540dcc4c8e88155b9a5b9a5d1a585b1a5e9959605a1b60448201526064016104ed565b61094b6113d0565b610953611493565b60408051600080825260208201909252600f60f81b9c939b5091995046985030975095509350915050565b60008051602061235b8339815191528054600160401b810460ff1615906001600160401b03166000811580156109b15750825b90506000826001600160401b031660011480156109cd5750303b155b9050811580156109db575080155b156109f95760405163f92ee8a960e01b815260040160405180910390fd5b845467ffffffffffffffff191660011785558315610a2357845460ff60401b1916600160401b1785555b6107468761124d565b60005460ff161580610a4d57503360009081526001602052604090205460ff165b610a695760405162461bcd60e51b81526004016104ed90611ed0565b68056bc75e2d63100000341015610a925760405162461bcd60e51b81526004016104ed90611efe565b610a9c82826114d2565b336001600160a01b03167fc7abef7b73f049da6a9bc2349ba5066a39e316eabc9f671b6f9406aa9490a453838334604051610ad993929190611f5e565b60405180910390a25050565b60005460ff161580610b0657503360009081526001602052604090205460ff165b610b225760405162461bcd60e51b81526004016104ed90611ed0565b6046610b2e8280611f98565b90501115610b7e5760405162461bcd60e51b815260206004820152601960248201527f5374616b696e673a206d6f6e696b657220746f6f206c6f6e670000000000000060448201526064016104ed565b610bb8610b8e6020830183611f98565b90501115610bde5760405162461bcd60e51b815260206004820152601a60248201527f5374616b696e673a206964656e7469747920746f6f206c6f6e6700000000000060448201526064016104ed565b608c610bed6040830183611f98565b90501115610c3d5760405162461bcd60e51b815260206004820152601960248201527f5374616b696e673a207765627369746520746f6f206c6f6e670000000000000060448201526064016104ed565b608c610c4c6060830183611f98565b90501115610ca75760405162461bcd60e51b815260206004820152602260248201527f5374616b696e673a20736563757269747920636f6e7461637420746f6f206c6f6044820152616e6760f01b60648201526084016104ed565b610118610cb76080830183611f98565b90501115610d075760405162461bcd60e51b815260206004820152601960248201527f5374616b696e673a2064657461696c7320746f6f206c6f6e670000000000000060448201526064016104ed565b610d1760e0820160c08301611ff0565b600f0b60001914610d90576000610d3460e0830160c08401611ff0565b600f0b13610d905760405162461bcd60e51b8152602060048201526024808201527f5374616b696e673a20696e76616c6964206d696e2073656c662064656c6567616044820152633a34b7b760e11b60648201526084016104ed565b610da060c0820160a0830161201d565b60030b60001914610e2d576064610dbd60c0830160a0840161201d565b60030b13158015610de157506000610ddb60c0830160a0840161201d565b60030b12155b610e2d5760405162461bcd60e51b815260206004820181905260248201527f5374616b696e673a20696e76616c696420636f6d6d697373696f6e207261746560448201526064016104ed565b610e356114e2565b336001600160a01b03167f52fda57d92c07920b3143ee96551411bc0f261142bf5ca457a3bc960a4f811a182604051610e6e919061207d565b60405180910390a250565b6000610ede7fe316059dc16f1f20ea16d30fdc082cbc9b5d03db34e48a41a1eea88ba9d168a283604051602001610ec39291909182526001600160a01b0316602082015260400190565b60405160208183030381529060405280519060200120611568565b92915050565b610eec610f98565b6000805460ff191660011781556040517f8a943acd5f4e6d3df7565a4a08a93f6b04cc31bb6c01ca4aef7abd6baf455ec39190a1565b610f2a610f98565b6000805460ff191681556040517f2d35c8d348a345fd7b3b03b7cfcf7ad0b60c2d46742d5ca536342e4185becb079190a1565b610f65610f98565b6001600160a01b038116610f8f57604051631e4fbdf760e01b8152600060048201526024016104ed565b6107ad8161135f565b33610fca7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316146108c25760405163118cdaa760e01b81523360048201526024016104ed565b600080602183146110465760405162461bcd60e51b815260206004820152601e60248201527f536563703235366b313a207075626b6579206e6f74203333206279746573000060448201526064016104ed565b8383600081811061105957611059611eba565b9050013560f81c60f81b6001600160f81b031916600260f81b14806110a757508383600081811061108c5761108c611eba565b9050013560f81c60f81b6001600160f81b031916600360f81b145b6110f35760405162461bcd60e51b815260206004820181905260248201527f536563703235366b313a20696e76616c6964207075626b65792070726566697860448201526064016104ed565b600184013560006111288686838161110d5761110d611eba565b919091013560f81c905083600060076401000003d019611595565b905061113482826116c9565b6111805760405162461bcd60e51b815260206004820152601e60248201527f536563703235366b313a207075626b6579206e6f74206f6e206375727665000060448201526064016104ed565b90925090505b9250929050565b60006111d761119b85610e79565b84848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506116e792505050565b505090506111e58686611734565b6001600160a01b0316816001600160a01b0316146112455760405162461bcd60e51b815260206004820152601a60248201527f5374616b696e673a20696e76616c6964207369676e617475726500000000000060448201526064016104ed565b505050505050565b61125561176a565b6107ad816117a1565b61126661176a565b6108ce82826117a9565b60005460ff16158061129a57506001600160a01b03811660009081526001602052604090205460ff165b6112e65760405162461bcd60e51b815260206004820152601860248201527f5374616b696e673a206e6f7420616c6c6f7765642076616c000000000000000060448201526064016104ed565b670de0b6b3a764000034101561130e5760405162461bcd60e51b81526004016104ed90611efe565b806001600160a01b0316826001600160a01b03167f510b11bb3f3c799b11307c01ab7db0d335683ef5b2da98f7697de744f465eacc3460405161135391815260200190565b60405180910390a35050565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b7fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d102805460609160008051602061233b8339815191529161140f9061217f565b80601f016020809104026020016040519081016040528092919081815260200182805461143b9061217f565b80156114885780601f1061145d57610100808354040283529160200191611488565b820191906000526020600020905b81548152906001019060200180831161146b57829003601f168201915b505050505091505090565b7fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d103805460609160008051602061233b8339815191529161140f9061217f565b6114dc8282610ff3565b50505050565b67016345785d8a000034101561153a5760405162461bcd60e51b815260206004820152601960248201527f5374616b696e673a20696e73756666696369656e74206665650000000000000060448201526064016104ed565b60405161dead903480156108fc02916000818181858888f193505050501580156107ad573d6000803e3d6000fd5b6000610ede61157561180a565b8360405161190160f01b8152600281019290925260228201526042902090565b60008560ff16600214806115ac57508560ff166003145b6116125760405162461bcd60e51b815260206004820152603160248201527f456c6c697074696343757276653a696e6e76616c696420636f6d7072657373656044820152700c8408a8640e0ded2dce840e0e4caccd2f607b1b60648201526084016104ed565b60008280611622576116226121b9565b8380611630576116306121b9565b85858061163f5761163f6121b9565b888a09088480611651576116516121b9565b858061165f5761165f6121b9565b898a0989090890506116888160046116788660016121e5565b61168291906121f8565b85611819565b90506000600261169b60ff8a16846121e5565b6116a5919061220c565b156116b9576116b48285612220565b6116bb565b815b925050505b95945050505050565b60006116e08383600060076401000003d0196118f0565b9392505050565b600080600083516041036117215760208401516040850151606086015160001a611713888285856119a9565b95509550955050505061172d565b50508151600091506002905b9250925092565b60408051818152606081018252600091829190602082018180368337505050602081019485526040810193909352505051902090565b60008051602061235b83398151915254600160401b900460ff166108c257604051631afcd79f60e31b815260040160405180910390fd5b610f6561176a565b6117b161176a565b60008051602061233b8339815191527fa16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d1026117eb848261227b565b50600381016117fa838261227b565b5060008082556001909101555050565b6000611814611a78565b905090565b60008160000361186b5760405162461bcd60e51b815260206004820152601e60248201527f456c6c697074696343757276653a206d6f64756c7573206973207a65726f000060448201526064016104ed565b8360000361187b575060006116e0565b8260000361188b575060016116e0565b6001600160ff1b5b80156118e757838186161515870a85848509099150836002820486161515870a85848509099150836004820486161515870a85848509099150836008820486161515870a8584850909915060109004611893565b50949350505050565b60008515806118ff5750818610155b80611908575084155b806119135750818510155b15611920575060006116c0565b60008280611930576119306121b9565b868709905060008380611945576119456121b9565b888580611954576119546121b9565b8a8b09099050851561198457838061196e5761196e6121b9565b848061197c5761197c6121b9565b878a09820890505b841561199e578380611998576119986121b9565b85820890505b149695505050505050565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156119e45750600091506003905082611a6e565b604080516000808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015611a38573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b038116611a6457506000925060019150829050611a6e565b9250600091508190505b9450945094915050565b60007f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f611aa3611aec565b611aab611b56565b60408051602081019490945283019190915260608201524660808201523060a082015260c00160405160208183030381529060405280519060200120905090565b600060008051602061233b83398151915281611b066113d0565b805190915015611b1e57805160209091012092915050565b81548015611b2d579392505050565b7fc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470935050505090565b600060008051602061233b83398151915281611b70611493565b805190915015611b8857805160209091012092915050565b60018201548015611b2d579392505050565b60008060208385031215611bad57600080fd5b82356001600160401b0380821115611bc457600080fd5b818501915085601f830112611bd857600080fd5b813581811115611be757600080fd5b8660208260051b8501011115611bfc57600080fd5b60209290920196919550909350505050565b60008083601f840112611c2057600080fd5b5081356001600160401b03811115611c3757600080fd5b60208301915083602082850101111561118657600080fd5b60008060008060408587031215611c6557600080fd5b84356001600160401b0380821115611c7c57600080fd5b611c8888838901611c0e565b90965094506020870135915080821115611ca157600080fd5b50611cae87828801611c0e565b95989497509550505050565b80356001600160a01b0381168114611cd157600080fd5b919050565b60008060408385031215611ce957600080fd5b611cf283611cba565b915060208301358015158114611d0757600080fd5b809150509250929050565b600060208284031215611d2457600080fd5b6116e082611cba565b60008060408385031215611d4057600080fd5b611d4983611cba565b9150611d5760208401611cba565b90509250929050565b6000815180845260005b81811015611d8657602081850181015186830182015201611d6a565b506000602082860101526020601f19601f83011685010191505092915050565b60ff60f81b881681526000602060e06020840152611dc760e084018a611d60565b8381036040850152611dd9818a611d60565b606085018990526001600160a01b038816608086015260a0850187905284810360c08601528551808252602080880193509091019060005b81811015611e2d57835183529284019291840191600101611e11565b50909c9b505050505050505050505050565b60008060208385031215611e5257600080fd5b82356001600160401b03811115611e6857600080fd5b611e7485828601611c0e565b90969095509350505050565b600060208284031215611e9257600080fd5b81356001600160401b03811115611ea857600080fd5b820160e081850312156116e057600080fd5b634e487b7160e01b600052603260045260246000fd5b60208082526014908201527314dd185ada5b99ce881b9bdd08185b1b1bddd95960621b604082015260600190565b6020808252601d908201527f5374616b696e673a20696e73756666696369656e74206465706f736974000000604082015260600190565b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b604081526000611f72604083018587611f35565b9050826020830152949350505050565b634e487b7160e01b600052604160045260246000fd5b6000808335601e19843603018112611faf57600080fd5b8301803591506001600160401b03821115611fc957600080fd5b60200191503681900382131561118657600080fd5b8035600f81900b8114611cd157600080fd5b60006020828403121561200257600080fd5b6116e082611fde565b8035600381900b8114611cd157600080fd5b60006020828403121561202f57600080fd5b6116e08261200b565b6000808335601e1984360301811261204f57600080fd5b83016020810192503590506001600160401b0381111561206e57600080fd5b80360382131561118657600080fd5b60208152600061208d8384612038565b60e060208501526120a361010085018284611f35565b9150506120b36020850185612038565b601f19808685030160408701526120cb848385611f35565b93506120da6040880188612038565b93509150808685030160608701526120f3848484611f35565b93506121026060880188612038565b935091508086850301608087015261211b848484611f35565b935061212a6080880188612038565b93509150808685030160a087015250612144838383611f35565b9250505061215460a0850161200b565b60030b60c084015261216860c08501611fde565b61217760e0850182600f0b9052565b509392505050565b600181811c9082168061219357607f821691505b6020821081036121b357634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115610ede57610ede6121cf565b600082612207576122076121b9565b500490565b60008261221b5761221b6121b9565b500690565b81810381811115610ede57610ede6121cf565b601f8211156104ab576000816000526020600020601f850160051c8101602086101561225c5750805b601f850160051c820191505b8181101561124557828155600101612268565b81516001600160401b0381111561229457612294611f82565b6122a8816122a2845461217f565b84612233565b602080601f8311600181146122dd57600084156122c55750858301515b600019600386901b1c1916600185901b178555611245565b600085815260208120601f198616915b8281101561230c578886015182559484019460019091019084016122ed565b508582101561232a5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea16a46d94261c7517cc8ff89f61c0ce93598e3c849801011dee649a6a557d100f0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00a264697066735822122050b1fd2b5ac8876835b3ad29d31c1c38b0dfe53525512c79e9b39d294b59448f64736f6c63430008180033",
 }
 
@@ -752,6 +752,27 @@ func (_Staking *StakingTransactorSession) InitializeV2() (*types.Transaction, er
 	return _Staking.Contract.InitializeV2(&_Staking.TransactOpts)
 }
 
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address srcValidator, address dstValidator, uint256 amount) payable returns()
+func (_Staking *StakingTransactor) Redelegate(opts *bind.TransactOpts, srcValidator common.Address, dstValidator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "redelegate", srcValidator, dstValidator, amount)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address srcValidator, address dstValidator, uint256 amount) payable returns()
+func (_Staking *StakingSession) Redelegate(srcValidator common.Address, dstValidator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Redelegate(&_Staking.TransactOpts, srcValidator, dstValidator, amount)
+}
+
+// Redelegate is a paid mutator transaction binding the contract method 0x6bd8f804.
+//
+// Solidity: function redelegate(address srcValidator, address dstValidator, uint256 amount) payable returns()
+func (_Staking *StakingTransactorSession) Redelegate(srcValidator common.Address, dstValidator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Redelegate(&_Staking.TransactOpts, srcValidator, dstValidator, amount)
+}
+
 // RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
 //
 // Solidity: function renounceOwnership() returns()
@@ -794,6 +815,27 @@ func (_Staking *StakingTransactorSession) TransferOwnership(newOwner common.Addr
 	return _Staking.Contract.TransferOwnership(&_Staking.TransactOpts, newOwner)
 }
 
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingTransactor) Undelegate(opts *bind.TransactOpts, validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.contract.Transact(opts, "undelegate", validator, amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingSession) Undelegate(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, validator, amount)
+}
+
+// Undelegate is a paid mutator transaction binding the contract method 0x4d99dd16.
+//
+// Solidity: function undelegate(address validator, uint256 amount) payable returns()
+func (_Staking *StakingTransactorSession) Undelegate(validator common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _Staking.Contract.Undelegate(&_Staking.TransactOpts, validator, amount)
+}
+
 // StakingAllowlistDisabledIterator is returned from FilterAllowlistDisabled and is used to iterate over the raw logs and unpacked data for AllowlistDisabled events raised by the Staking contract.
 type StakingAllowlistDisabledIterator struct {
 	Event *StakingAllowlistDisabled // Event containing the contract specifics and raw log
@@ -1925,6 +1967,323 @@ func (_Staking *StakingFilterer) ParseOwnershipTransferred(log types.Log) (*Stak
 	return event, nil
 }
 
+// StakingRedelegateIterator is returned from FilterRedelegate and is used to iterate over the raw logs and unpacked data for Redelegate events raised by the Staking contract.
+type StakingRedelegateIterator struct {
+	Event *StakingRedelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingRedelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingRedelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingRedelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingRedelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingRedelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingRedelegate represents a Redelegate event raised by the Staking contract.
+type StakingRedelegate struct {
+	Delegator    common.Address
+	SrcValidator common.Address
+	DstValidator common.Address
+	Amount       *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterRedelegate is a free log retrieval operation binding the contract event 0x3ca49cdc02409b294ed8ec6b982a9785702a2ae85e08b6208625dd6beee36036.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed srcValidator, address indexed dstValidator, uint256 amount)
+func (_Staking *StakingFilterer) FilterRedelegate(opts *bind.FilterOpts, delegator []common.Address, srcValidator []common.Address, dstValidator []common.Address) (*StakingRedelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var srcValidatorRule []interface{}
+	for _, srcValidatorItem := range srcValidator {
+		srcValidatorRule = append(srcValidatorRule, srcValidatorItem)
+	}
+	var dstValidatorRule []interface{}
+	for _, dstValidatorItem := range dstValidator {
+		dstValidatorRule = append(dstValidatorRule, dstValidatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Redelegate", delegatorRule, srcValidatorRule, dstValidatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingRedelegateIterator{contract: _Staking.contract, event: "Redelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchRedelegate is a free log subscription operation binding the contract event 0x3ca49cdc02409b294ed8ec6b982a9785702a2ae85e08b6208625dd6beee36036.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed srcValidator, address indexed dstValidator, uint256 amount)
+func (_Staking *StakingFilterer) WatchRedelegate(opts *bind.WatchOpts, sink chan<- *StakingRedelegate, delegator []common.Address, srcValidator []common.Address, dstValidator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var srcValidatorRule []interface{}
+	for _, srcValidatorItem := range srcValidator {
+		srcValidatorRule = append(srcValidatorRule, srcValidatorItem)
+	}
+	var dstValidatorRule []interface{}
+	for _, dstValidatorItem := range dstValidator {
+		dstValidatorRule = append(dstValidatorRule, dstValidatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Redelegate", delegatorRule, srcValidatorRule, dstValidatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingRedelegate)
+				if err := _Staking.contract.UnpackLog(event, "Redelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRedelegate is a log parse operation binding the contract event 0x3ca49cdc02409b294ed8ec6b982a9785702a2ae85e08b6208625dd6beee36036.
+//
+// Solidity: event Redelegate(address indexed delegator, address indexed srcValidator, address indexed dstValidator, uint256 amount)
+func (_Staking *StakingFilterer) ParseRedelegate(log types.Log) (*StakingRedelegate, error) {
+	event := new(StakingRedelegate)
+	if err := _Staking.contract.UnpackLog(event, "Redelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StakingUndelegateIterator is returned from FilterUndelegate and is used to iterate over the raw logs and unpacked data for Undelegate events raised by the Staking contract.
+type StakingUndelegateIterator struct {
+	Event *StakingUndelegate // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StakingUndelegateIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StakingUndelegate)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StakingUndelegate)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StakingUndelegateIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StakingUndelegateIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StakingUndelegate represents a Undelegate event raised by the Staking contract.
+type StakingUndelegate struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterUndelegate is a free log retrieval operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) FilterUndelegate(opts *bind.FilterOpts, delegator []common.Address, validator []common.Address) (*StakingUndelegateIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.FilterLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StakingUndelegateIterator{contract: _Staking.contract, event: "Undelegate", logs: logs, sub: sub}, nil
+}
+
+// WatchUndelegate is a free log subscription operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) WatchUndelegate(opts *bind.WatchOpts, sink chan<- *StakingUndelegate, delegator []common.Address, validator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _Staking.contract.WatchLogs(opts, "Undelegate", delegatorRule, validatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StakingUndelegate)
+				if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUndelegate is a log parse operation binding the contract event 0xbda8c0e95802a0e6788c3e9027292382d5a41b86556015f846b03a9874b2b827.
+//
+// Solidity: event Undelegate(address indexed delegator, address indexed validator, uint256 amount)
+func (_Staking *StakingFilterer) ParseUndelegate(log types.Log) (*StakingUndelegate, error) {
+	event := new(StakingUndelegate)
+	if err := _Staking.contract.UnpackLog(event, "Undelegate", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // StakingValidatorAllowedIterator is returned from FilterValidatorAllowed and is used to iterate over the raw logs and unpacked data for ValidatorAllowed events raised by the Staking contract.
 type StakingValidatorAllowedIterator struct {
 	Event *StakingValidatorAllowed // Event containing the contract specifics and raw log
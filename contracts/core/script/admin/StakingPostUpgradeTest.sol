@@ -21,6 +21,8 @@ contract StakingPostUpgradeTest is Test {
         _testAllowlist();
         _testCreateValidator();
         _testDelegate();
+        _testUndelegate();
+        _testRedelegate();
     }
 
     function _setup() internal {
@@ -98,4 +100,25 @@ contract StakingPostUpgradeTest is Test {
         vm.prank(validator);
         staking.delegateFor{ value: deposit }(validator, validator);
     }
+
+    function _testUndelegate() internal {
+        uint256 fee = staking.Fee();
+        vm.deal(validator, fee);
+
+        vm.expectEmit();
+        emit Staking.Undelegate(validator, validator, 1 ether);
+        vm.prank(validator);
+        staking.undelegate{ value: fee }(validator, 1 ether);
+    }
+
+    function _testRedelegate() internal {
+        address dstValidator = makeAddr("dstValidator");
+        uint256 fee = staking.Fee();
+        vm.deal(validator, fee);
+
+        vm.expectEmit();
+        emit Staking.Redelegate(validator, validator, dstValidator, 1 ether);
+        vm.prank(validator);
+        staking.redelegate{ value: fee }(validator, dstValidator, 1 ether);
+    }
 }
@@ -40,6 +40,25 @@ contract Staking is OwnableUpgradeable, EIP712Upgradeable {
      */
     event EditValidator(address indexed validator, EditValidatorParams params);
 
+    /**
+     * @notice Emitted when an undelegation is requested
+     * @param delegator     (MsgUndelegate.delegator_addr) The address of the delegator
+     * @param validator     (MsgUndelegate.validator_addr) The address of the validator to undelegate from
+     * @param amount        (MsgUndelegate.amount) The amount of tokens to undelegate
+     */
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /**
+     * @notice Emitted when a redelegation is requested
+     * @param delegator     (MsgBeginRedelegate.delegator_addr) The address of the delegator
+     * @param srcValidator  (MsgBeginRedelegate.validator_src_address) The address of the validator to move stake from
+     * @param dstValidator  (MsgBeginRedelegate.validator_dst_address) The address of the validator to move stake to
+     * @param amount        (MsgBeginRedelegate.amount) The amount of tokens to redelegate
+     */
+    event Redelegate(
+        address indexed delegator, address indexed srcValidator, address indexed dstValidator, uint256 amount
+    );
+
     /**
      * @notice Emitted when a validator is allowed to create a validator
      * @param validator     The validator address
@@ -230,6 +249,36 @@ contract Staking is OwnableUpgradeable, EIP712Upgradeable {
         _delegate(delegator, validator);
     }
 
+    /**
+     * @notice Undelegate tokens from a validator. Requires the fee to be sent with the call.
+     *         Undelegated tokens are withdrawn to the delegator once the unbonding period completes.
+     * @dev Proxies x/staking.MsgUndelegate
+     * @param validator The address of the validator to undelegate from
+     * @param amount    The amount of tokens to undelegate
+     */
+    function undelegate(address validator, uint256 amount) external payable {
+        require(amount > 0, "Staking: zero amount");
+
+        _burnFee();
+        emit Undelegate(msg.sender, validator, amount);
+    }
+
+    /**
+     * @notice Redelegate tokens from one validator to another. Requires the fee to be sent with the call.
+     * @dev Proxies x/staking.MsgBeginRedelegate
+     * @param srcValidator The address of the validator to move stake from
+     * @param dstValidator The address of the validator to move stake to
+     * @param amount       The amount of tokens to redelegate
+     */
+    function redelegate(address srcValidator, address dstValidator, uint256 amount) external payable {
+        require(!isAllowlistEnabled || isAllowedValidator[dstValidator], "Staking: not allowed val");
+        require(srcValidator != dstValidator, "Staking: same validator");
+        require(amount > 0, "Staking: zero amount");
+
+        _burnFee();
+        emit Redelegate(msg.sender, srcValidator, dstValidator, amount);
+    }
+
     //////////////////////////////////////////////////////////////////////////////
     //                                  Admin                                   //
     //////////////////////////////////////////////////////////////////////////////
@@ -16,6 +16,14 @@ contract Staking_Test is Test {
     /// @dev Matches Staking.Delegate event
     event Delegate(address indexed delegator, address indexed validator, uint256 amount);
 
+    /// @dev Matches Staking.Undelegate event
+    event Undelegate(address indexed delegator, address indexed validator, uint256 amount);
+
+    /// @dev Matches Staking.Redelegate event
+    event Redelegate(
+        address indexed delegator, address indexed srcValidator, address indexed dstValidator, uint256 amount
+    );
+
     address owner;
     address validator;
     address[] validators;
@@ -238,6 +246,66 @@ contract Staking_Test is Test {
         staking.delegate{ value: minDelegation }(validator);
     }
 
+    function test_undelegate() public {
+        address delegator = makeAddr("delegator");
+        uint256 fee = staking.Fee();
+
+        vm.deal(delegator, fee * 2);
+
+        // requires non-zero amount
+        vm.expectRevert("Staking: zero amount");
+        vm.prank(delegator);
+        staking.undelegate{ value: fee }(validator, 0);
+
+        // requires fee
+        vm.expectRevert("Staking: insufficient fee");
+        vm.prank(delegator);
+        staking.undelegate{ value: fee - 1 }(validator, 1 ether);
+
+        // succeeds
+        vm.expectEmit();
+        emit Undelegate(delegator, validator, 1 ether);
+
+        vm.prank(delegator);
+        staking.undelegate{ value: fee }(validator, 1 ether);
+    }
+
+    function test_redelegate() public {
+        address delegator = makeAddr("delegator");
+        address dstValidator = makeAddr("dstValidator");
+        uint256 fee = staking.Fee();
+
+        vm.deal(delegator, fee * 2);
+
+        // requires different validators
+        vm.expectRevert("Staking: same validator");
+        vm.prank(delegator);
+        staking.redelegate{ value: fee }(validator, validator, 1 ether);
+
+        // requires non-zero amount
+        vm.expectRevert("Staking: zero amount");
+        vm.prank(delegator);
+        staking.redelegate{ value: fee }(validator, dstValidator, 0);
+
+        // if allowlist enabled, destination must be in allowlist
+        vm.prank(owner);
+        staking.enableAllowlist();
+
+        vm.expectRevert("Staking: not allowed val");
+        vm.prank(delegator);
+        staking.redelegate{ value: fee }(validator, dstValidator, 1 ether);
+
+        // succeeds
+        vm.prank(owner);
+        staking.allowValidators(validators);
+
+        vm.expectEmit();
+        emit Redelegate(delegator, dstValidator, validator, 1 ether);
+
+        vm.prank(delegator);
+        staking.redelegate{ value: fee }(dstValidator, validator, 1 ether);
+    }
+
     function _sign(bytes32 digest, uint256 privkey) private pure returns (bytes memory) {
         (uint8 v, bytes32 r, bytes32 s) = vm.sign(privkey, digest);
         return abi.encodePacked(r, s, v);
@@ -2,10 +2,13 @@ package app
 
 import (
 	"context"
+	"path/filepath"
 	"time"
 
 	"github.com/omni-network/omni/e2e/docker"
 	"github.com/omni-network/omni/e2e/types"
+	"github.com/omni-network/omni/halo/attest/voter"
+	halocfg "github.com/omni-network/omni/halo/config"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
 
@@ -27,7 +30,7 @@ func perturb(ctx context.Context, testnet types.Testnet) error {
 
 	for service, purturbs := range testnet.Perturb {
 		for _, p := range purturbs {
-			if err := perturbService(ctx, service, testnet.Dir, p); err != nil {
+			if err := perturbService(ctx, testnet, service, p); err != nil {
 				return errors.Wrap(err, "purturb service", "service", service)
 			}
 			time.Sleep(3 * time.Second) // Give network some time to recover between each
@@ -38,8 +41,9 @@ func perturb(ctx context.Context, testnet types.Testnet) error {
 }
 
 // perturbService perturbs a docker service with a given perturbation.
-func perturbService(ctx context.Context, service string, testnetDir string, perturb types.Perturb) error {
+func perturbService(ctx context.Context, testnet types.Testnet, service string, perturb types.Perturb) error {
 	ctx = log.WithCtx(ctx, "service", service)
+	testnetDir := testnet.Dir
 
 	log.Info(ctx, "Perturbing service", "perturb", perturb)
 	switch perturb {
@@ -73,6 +77,10 @@ func perturbService(ctx context.Context, service string, testnetDir string, pert
 		if err := docker.ExecCompose(ctx, testnetDir, "exec", service, "wget", "-O-", "localhost:8545/fuzzy_disable"); err != nil {
 			return errors.Wrap(err, "disable fuzzy head")
 		}
+	case types.PerturbDoubleSign:
+		if err := perturbDoubleSign(ctx, testnet, service); err != nil {
+			return errors.Wrap(err, "double sign")
+		}
 	case types.PerturbUpgrade:
 		if err := docker.ExecCompose(ctx, testnetDir, "down", service); err != nil {
 			return errors.Wrap(err, "down service")
@@ -92,6 +100,46 @@ func perturbService(ctx context.Context, service string, testnetDir string, pert
 	return nil
 }
 
+// perturbDoubleSign makes the halo validator service double sign attestations.
+// Fuzzy head attestation roots are enabled on all anvil chains, so validators vote on conflicting roots
+// which prevents attestations from being approved. The validator's voter state is then reset,
+// so it votes again on the pending attestations, likely with roots conflicting with its previous votes.
+// Fuzzy roots are random, so the periods are long enough for many pending attestations to be voted on again.
+func perturbDoubleSign(ctx context.Context, testnet types.Testnet, service string) error {
+	setFuzzyHead := func(query string) error {
+		for _, anvil := range testnet.AnvilChains {
+			if err := docker.ExecCompose(ctx, testnet.Dir, "exec", anvil.Chain.Name, "wget", "-O-", "localhost:8545/"+query); err != nil {
+				return errors.Wrap(err, "set fuzzy head", "chain", anvil.Chain.Name)
+			}
+		}
+
+		return nil
+	}
+
+	if err := setFuzzyHead("fuzzy_enable?perturb=" + string(types.PerturbFuzzyHeadAttRoot)); err != nil {
+		return err
+	}
+	time.Sleep(30 * time.Second) // Vote on conflicting roots.
+
+	if err := docker.ExecCompose(ctx, testnet.Dir, "stop", service); err != nil {
+		return errors.Wrap(err, "stop service")
+	}
+
+	// The halo home directory is mounted from the testnet dir.
+	haloCfg := halocfg.DefaultConfig()
+	haloCfg.HomeDir = filepath.Join(testnet.Dir, service)
+	if err := voter.GenEmptyStateFile(haloCfg.VoterStateFile()); err != nil {
+		return errors.Wrap(err, "reset voter state")
+	}
+
+	if err := docker.ExecCompose(ctx, testnet.Dir, "start", service); err != nil {
+		return errors.Wrap(err, "start service")
+	}
+	time.Sleep(30 * time.Second) // Vote again on pending attestations.
+
+	return setFuzzyHead("fuzzy_disable")
+}
+
 // perturbNode perturbs a node with a given perturbation, returning its status
 // after recovering.
 func perturbNode(ctx context.Context, node *e2e.Node, perturbation e2e.Perturbation) (*rpctypes.ResultStatus, error) {
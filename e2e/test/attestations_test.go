@@ -2,9 +2,12 @@ package e2e_test
 
 import (
 	"context"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/omni-network/omni/e2e/types"
+	atypes "github.com/omni-network/omni/halo/attest/types"
 	"github.com/omni-network/omni/lib/cchain"
 	"github.com/omni-network/omni/lib/cchain/provider"
 	"github.com/omni-network/omni/lib/k1util"
@@ -13,6 +16,9 @@ import (
 
 	e2e "github.com/cometbft/cometbft/test/e2e/pkg"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sltypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -134,3 +140,70 @@ func fetchAllAtts(ctx context.Context, cprov cchain.Provider, chainVer xchain.Ch
 
 	return resp, nil
 }
+
+// TestAttestationDoubleSigns tests that honest validators never double sign, including when fuzzy head
+// attestation roots are perturbed (see fuzzyhead manifest), and that validators perturbed to double sign
+// (see doublesign manifest) have evidence recorded and are jailed and slashed.
+func TestAttestationDoubleSigns(t *testing.T) {
+	t.Parallel()
+	testnet, _, _ := loadEnv(t)
+
+	doubleSigners := make(map[string]bool)
+	for service, perturbs := range testnet.Perturb {
+		if slices.Contains(perturbs, types.PerturbDoubleSign) {
+			doubleSigners[service] = true
+		}
+	}
+
+	testNode(t, func(t *testing.T, network netconf.Network, node *e2e.Node, _ []Portal) {
+		t.Helper()
+		ctx := context.Background()
+
+		client, err := node.Client()
+		require.NoError(t, err)
+		cprov := provider.NewABCI(client, network.ID)
+
+		for _, val := range testnet.Nodes {
+			if val.Mode != types.ModeValidator {
+				continue
+			}
+
+			valAddr, err := k1util.PubKeyToAddress(val.PrivvalKey.PubKey())
+			require.NoError(t, err)
+
+			resp, err := cprov.QueryClients().Attest.DoubleSigns(ctx, &atypes.DoubleSignsRequest{ValidatorAddress: valAddr.Bytes()})
+			require.NoError(t, err)
+
+			if !doubleSigners[val.Name] {
+				require.Empty(t, resp.DoubleSigns, "Unexpected attestation double signs", "validator", val.Name)
+				continue
+			}
+
+			require.NotEmpty(t, resp.DoubleSigns, "Missing attestation double signs", "validator", val.Name)
+			for _, ds := range resp.DoubleSigns {
+				require.NotEqual(t, ds.AttestationRoot, ds.ConflictingAttestationRoot)
+			}
+			require.True(t, resp.DoubleSigns[0].Slashed, "Double signer not slashed", "validator", val.Name)
+
+			// Double signer is jailed.
+			consAddr := sdk.ConsAddress(val.PrivvalKey.PubKey().Address())
+			info, err := cprov.QueryClients().Slashing.SigningInfo(ctx, &sltypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()})
+			require.NoError(t, err)
+			require.True(t, info.ValSigningInfo.JailedUntil.After(time.Now()), "Double signer not jailed", "validator", val.Name)
+
+			// Double signer's stake is slashed, so its tokens are less than its delegator shares.
+			sdkVals, err := cprov.SDKValidators(ctx)
+			require.NoError(t, err)
+			var found bool
+			for _, sdkVal := range sdkVals {
+				if ethAddr, err := sdkVal.ConsensusEthAddr(); err != nil || ethAddr != valAddr {
+					continue
+				}
+				found = true
+				require.True(t, sdkVal.IsJailed(), "Double signer not jailed", "validator", val.Name)
+				require.True(t, sdkVal.Tokens.LT(sdkVal.DelegatorShares.TruncateInt()), "Double signer not slashed", "validator", val.Name)
+			}
+			require.True(t, found, "Double signer not found", "validator", val.Name)
+		}
+	})
+}
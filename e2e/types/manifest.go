@@ -72,6 +72,10 @@ const (
 	PerturbFuzzyHeadAttRoot Perturb = "fuzzyhead_attroot"
 	// PerturbFuzzyHeadMoreMsgs defines a perturbation that enables fuzzyhead more/duplicate xmsgs for a while.
 	PerturbFuzzyHeadMoreMsgs Perturb = "fuzzyhead_moremsgs"
+	// PerturbDoubleSign defines a perturbation that makes a halo validator double sign attestations.
+	// It resets the validator's voter state (slashing protection) while fuzzyhead_attroot is enabled
+	// on all anvil chains, so it re-votes pending attestations with conflicting roots.
+	PerturbDoubleSign Perturb = "doublesign"
 )
 
 // Manifest wraps e2e.Manifest with additional omni-specific fields.
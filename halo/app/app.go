@@ -216,10 +216,6 @@ func (App) LegacyAmino() *codec.LegacyAmino {
 	return nil
 }
 
-func (App) ExportAppStateAndValidators(_ bool, _, _ []string) (servertypes.ExportedApp, error) {
-	return servertypes.ExportedApp{}, errors.New("not implemented")
-}
-
 // SimulationManager implements the SimulationApp interface.
 func (App) SimulationManager() *module.SimulationManager {
 	return nil
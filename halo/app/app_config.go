@@ -64,6 +64,7 @@ const (
 	genesisVoteExtLimit   uint64 = 256
 	genesisTrimLag        uint64 = 1      // Allow deleting attestations in block after approval.
 	genesisCTrimLag       uint64 = 72_000 // Delete consensus attestations state after +-1 day (given a period of 1.2s).
+	genesisDoubleSignJail uint64 = 86_400 // Jail attestation double signers for 1 day (in seconds).
 
 	deliverIntervalProtected = 20_000 // Roughly ~12h assuming 0.5bps
 	deliverIntervalEphemeral = 2      // Fast updates while testing
@@ -85,6 +86,10 @@ var (
 		upgradetypes.ModuleName,
 		valsynctypes.ModuleName,
 		engevmtypes.ModuleName,
+		attesttypes.ModuleName,
+		portaltypes.ModuleName,
+		registrytypes.ModuleName,
+		evmstakingtypes.ModuleName,
 	}
 
 	beginBlockers = func(context.Context) []string {
@@ -212,11 +217,13 @@ var (
 					{
 						Name: attesttypes.ModuleName,
 						Config: appconfig.WrapAny(&attestmodule.Module{
-							VoteWindowUp:       genesisVoteWindowUp,
-							VoteWindowDown:     genesisVoteWindowDown,
-							VoteExtensionLimit: genesisVoteExtLimit,
-							TrimLag:            genesisTrimLag,
-							ConsensusTrimLag:   genesisCTrimLag,
+							VoteWindowUp:            genesisVoteWindowUp,
+							VoteWindowDown:          genesisVoteWindowDown,
+							VoteExtensionLimit:      genesisVoteExtLimit,
+							TrimLag:                 genesisTrimLag,
+							ConsensusTrimLag:        genesisCTrimLag,
+							DoubleSignSlashFraction: "", // Use the slashing module's slash_fraction_double_sign param.
+							DoubleSignJailSeconds:   genesisDoubleSignJail,
 						}),
 					},
 					{
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omni-network/omni/halo/attest/attestserve"
+	"github.com/omni-network/omni/lib/attestindex"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+	xprovider "github.com/omni-network/omni/lib/xchain/provider"
+)
+
+// indexLoader wraps an off-chain attestation index that is lazy loaded from the on-chain registry.
+type indexLoader struct {
+	mu      sync.Mutex
+	indexer *attestindex.Indexer
+}
+
+// LazyLoad blocks until the network config can be loaded from the on-chain registry, then it initializes and starts
+// the attestation indexer from the most recent size attestations of each chain version and binds it to the lazy wrapper.
+func (l *indexLoader) LazyLoad(
+	ctx context.Context,
+	netID netconf.ID,
+	omniEVMCl ethclient.Client,
+	endpoints xchain.RPCEndpoints,
+	cprov cchain.Provider,
+	size int,
+	xprovOpts ...xprovider.Option,
+) error {
+	network, err := netconf.AwaitOnConsensusChain(ctx, netID, cprov, nil)
+	if err != nil {
+		return err
+	}
+
+	xprov, err := newXProvider(netID, network, omniEVMCl, endpoints, cprov, xprovOpts...)
+	if err != nil {
+		return err
+	}
+
+	indexer, err := attestindex.New(cprov, xprov, size)
+	if err != nil {
+		return err
+	}
+
+	for _, chain := range network.Chains {
+		for _, chainVer := range chain.ChainVersions() {
+			latest, ok, err := cprov.LatestAttestation(ctx, chainVer)
+			if err != nil {
+				return errors.Wrap(err, "latest attestation")
+			}
+
+			fromOffset := uint64(1)
+			if ok && latest.AttestOffset > uint64(size) {
+				fromOffset = latest.AttestOffset - uint64(size) + 1
+			}
+
+			indexer.Start(ctx, chainVer, fromOffset)
+		}
+	}
+
+	log.Info(ctx, "Started off-chain attestation index", "size", size)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.indexer = indexer
+
+	return nil
+}
+
+// get returns the attestation indexer or false if not loaded yet.
+func (l *indexLoader) get() (attestserve.Indexer, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.indexer == nil {
+		return nil, false
+	}
+
+	return l.indexer, true
+}
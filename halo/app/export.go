@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/lib/buildinfo"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func DefaultExportConfig() ExportConfig {
+	return ExportConfig{
+		Height: 0, // Latest height
+	}
+}
+
+type ExportConfig struct {
+	Height  int64    // Height to export the state of, or latest if zero.
+	Modules []string // Modules to export, or all if empty.
+}
+
+// Export returns a consensus genesis containing the application state at the configured height.
+// The returned genesis starts at the next height, resuming the chain from the exported state.
+func Export(ctx context.Context, cfg Config, eCfg ExportConfig) (*types.AppGenesis, error) {
+	db, err := dbm.NewDB("application", cfg.BackendType(), cfg.DataDir())
+	if err != nil {
+		return nil, errors.Wrap(err, "create db")
+	}
+	defer db.Close()
+
+	baseAppOpts, err := makeBaseAppOpts(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "make base app opts")
+	}
+
+	// Exporting only reads application state, so the engine client and voter are stubbed out,
+	// similar to ClientEncodingConfig. Neither an execution client nor the validator key is required.
+	engineCl := struct {
+		ethclient.EngineClient
+	}{}
+
+	noopVoter, err := newVoterLoader(k1.GenPrivKey())
+	if err != nil {
+		return nil, errors.Wrap(err, "new voter loader")
+	}
+
+	app, err := newApp(
+		ctx,
+		cfg.Network,
+		newSDKLogger(ctx),
+		db,
+		engineCl,
+		noopVoter,
+		netconf.ChainVersionNamer(cfg.Network),
+		netconf.ChainNamer(cfg.Network),
+		burnEVMFees{},
+		serverAppOptsFromCfg(cfg),
+		make(chan<- error, 1),
+		baseAppOpts...,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "new app")
+	}
+
+	if eCfg.Height > 0 {
+		if err := app.LoadHeight(eCfg.Height); err != nil {
+			return nil, errors.Wrap(err, "load height", "height", eCfg.Height)
+		}
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, eCfg.Modules)
+	if err != nil {
+		return nil, errors.Wrap(err, "export app state")
+	}
+
+	appGen, err := types.AppGenesisFromFile(cfg.Comet.GenesisFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "read genesis file")
+	}
+
+	params := cmttypes.ConsensusParamsFromProto(exported.ConsensusParams)
+
+	appGen.AppName = Name
+	appGen.AppVersion = buildinfo.Version()
+	appGen.AppState = exported.AppState
+	appGen.InitialHeight = exported.Height
+	appGen.Consensus = &types.ConsensusGenesis{
+		Params:     &params,
+		Validators: exported.Validators,
+	}
+
+	log.Info(ctx, "Exported application state", "height", exported.Height-1, "validators", len(exported.Validators))
+
+	return appGen, nil
+}
+
+// ExportAppStateAndValidators exports the state of all (or the provided) modules and the validator set.
+// Zero height exports are not supported; the exported state resumes the chain at the next height.
+func (a App) ExportAppStateAndValidators(forZeroHeight bool, _, modulesToExport []string) (servertypes.ExportedApp, error) {
+	if forZeroHeight {
+		return servertypes.ExportedApp{}, errors.New("zero height export not supported")
+	}
+
+	ctx := a.NewContextLegacy(true, cmtproto.Header{Height: a.LastBlockHeight()})
+
+	genState, err := a.ModuleManager.ExportGenesisForModules(ctx, a.appCodec, modulesToExport)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export genesis")
+	}
+
+	appState, err := json.MarshalIndent(genState, "", " ")
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "marshal app state")
+	}
+
+	validators, err := staking.WriteValidators(ctx, a.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, errors.Wrap(err, "export validators")
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          a.LastBlockHeight() + 1,
+		ConsensusParams: a.GetConsensusParams(ctx),
+	}, nil
+}
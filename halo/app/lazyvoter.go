@@ -72,6 +72,7 @@ func (l *voterLoader) LazyLoad(
 	voterStateFile string,
 	cmtAPI comet.API,
 	asyncAbort chan<- error,
+	xprovOpts ...xprovider.Option,
 ) error {
 	if len(endpoints) == 0 {
 		log.Warn(ctx, "Flag --xchain-evm-rpc-endpoints empty. The app will crash if it becomes a validator since it cannot perform xchain voting duties", nil)
@@ -118,40 +119,9 @@ func (l *voterLoader) LazyLoad(
 		return err
 	}
 
-	var xprov xchain.Provider
-	if netID == netconf.Simnet {
-		omni, ok := network.OmniConsensusChain()
-		if !ok {
-			return errors.New("omni chain not found in network")
-		}
-
-		xprov, err = xprovider.NewMock(omni.BlockPeriod*8/10, omni.ID, cprov)
-		if err != nil {
-			return err
-		}
-	} else {
-		ethClients := make(map[uint64]ethclient.Client)
-		for _, chain := range network.EVMChains() {
-			// Use EngineAPI as omni_evm RPC client.
-			if netconf.IsOmniExecution(netID, chain.ID) {
-				ethClients[chain.ID] = omniEVMCl
-				continue
-			}
-
-			rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
-			if err != nil {
-				return err
-			}
-
-			ethCl, err := ethclient.Dial(chain.Name, rpc)
-			if err != nil {
-				return err
-			}
-
-			ethClients[chain.ID] = ethCl
-		}
-
-		xprov = xprovider.New(network, ethClients, cprov)
+	xprov, err := newXProvider(netID, network, omniEVMCl, endpoints, cprov, xprovOpts...)
+	if err != nil {
+		return err
 	}
 
 	deps := voteDeps{
@@ -192,6 +162,49 @@ func (l *voterLoader) LazyLoad(
 	return nil
 }
 
+// newXProvider returns a xchain provider for the chains of the network.
+// It returns a mock provider for simnet.
+func newXProvider(
+	netID netconf.ID,
+	network netconf.Network,
+	omniEVMCl ethclient.Client,
+	endpoints xchain.RPCEndpoints,
+	cprov cchain.Provider,
+	xprovOpts ...xprovider.Option,
+) (xchain.Provider, error) {
+	if netID == netconf.Simnet {
+		omni, ok := network.OmniConsensusChain()
+		if !ok {
+			return nil, errors.New("omni chain not found in network")
+		}
+
+		return xprovider.NewMock(omni.BlockPeriod*8/10, omni.ID, cprov)
+	}
+
+	ethClients := make(map[uint64]ethclient.Client)
+	for _, chain := range network.EVMChains() {
+		// Use EngineAPI as omni_evm RPC client.
+		if netconf.IsOmniExecution(netID, chain.ID) {
+			ethClients[chain.ID] = omniEVMCl
+			continue
+		}
+
+		rpcs, err := endpoints.AllByNameOrID(chain.Name, chain.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		ethCl, err := ethclient.DialMulti(chain.Name, rpcs)
+		if err != nil {
+			return nil, err
+		}
+
+		ethClients[chain.ID] = ethCl
+	}
+
+	return xprovider.New(network, ethClients, cprov, xprovOpts...), nil
+}
+
 func (l *voterLoader) getVoter() (*voter.Voter, bool) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
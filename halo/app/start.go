@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/omni-network/omni/halo/attest/attestserve"
 	"github.com/omni-network/omni/halo/comet"
 	halocfg "github.com/omni-network/omni/halo/config"
 	"github.com/omni-network/omni/halo/genutil/genserve"
@@ -18,6 +19,7 @@ import (
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/tracer"
+	xprovider "github.com/omni-network/omni/lib/xchain/provider"
 	etypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	cmtcfg "github.com/cometbft/cometbft/config"
@@ -172,6 +174,11 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 		return nil, nil, err
 	}
 
+	indexer := new(indexLoader) // Construct a lazy attestation index loader
+	if cfg.AttestIndexSize > 0 {
+		attestserve.Register(app.GRPCQueryRouter(), indexer.get)
+	}
+
 	app.EVMEngKeeper.SetBuildDelay(cfg.EVMBuildDelay)
 	app.EVMEngKeeper.SetBuildOptimistic(cfg.EVMBuildOptimistic)
 
@@ -194,6 +201,20 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 		return nil, nil, err
 	}
 
+	var xprovOpts []xprovider.Option
+	if cfg.XBlockCacheSize > 0 {
+		cacheDB, err := dbm.NewDB("xblocks", cfg.BackendType(), cfg.DataDir())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "create xblock cache db")
+		}
+
+		cache, err := xprovider.NewBlockCache(cacheDB, cfg.XBlockCacheSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		xprovOpts = append(xprovOpts, xprovider.WithBlockCache(cache))
+	}
+
 	go func() {
 		err := voter.LazyLoad(
 			ctx,
@@ -205,12 +226,31 @@ func Start(ctx context.Context, cfg Config) (<-chan error, func(context.Context)
 			cfg.VoterStateFile(),
 			cmtAPI,
 			asyncAbort,
+			xprovOpts...,
 		)
 		if err != nil {
 			asyncAbort <- err
 		}
 	}()
 
+	if cfg.AttestIndexSize > 0 {
+		go func() {
+			err := indexer.LazyLoad(
+				ctx,
+				cfg.Network,
+				engineCl,
+				cfg.RPCEndpoints,
+				cProvider,
+				cfg.AttestIndexSize,
+				xprovOpts...,
+			)
+			if err != nil && ctx.Err() == nil {
+				// The index is an optional non-critical feature, so don't abort the app.
+				log.Error(ctx, "Failed loading off-chain attestation index", err)
+			}
+		}()
+	}
+
 	log.Info(ctx, "Starting CometBFT")
 
 	if err := cmtNode.Start(); err != nil {
@@ -0,0 +1,45 @@
+// Package drake defines the third Omni consensus chain upgrade, named after
+// Sir Francis Drake, the English explorer who completed the second
+// circumnavigation of the globe.
+//
+// It includes:
+// - EVM staking undelegations and redelegations (previously only delegations)
+// - EVM withdrawals of completed unbondings (previously unexpected)
+// - Slashing and jailing of attestation double signers (previously only logged)
+//
+// The features are gated by the evmstaking module consensus version, see evmstakingtypes.IsDrake.
+package drake
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/lib/log"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const UpgradeName = "3_drake"
+
+func StoreUpgrades(context.Context) *storetypes.StoreUpgrades {
+	return &storetypes.StoreUpgrades{} // Zero store upgrades
+}
+
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		log.Info(ctx, "Running 3_drake upgrade handler")
+
+		// Migrating the evmstaking module consensus version activates the drake features.
+		return mm.RunMigrations(ctx, configurator, fromVM)
+	}
+}
+
+func GenesisState(codec.JSONCodec) (map[string]json.RawMessage, error) {
+	return nil, nil // Drake doesn't change any genesis state.
+}
@@ -0,0 +1,147 @@
+// Package attestserve provides a gRPC server that allows querying the off-chain attestation index
+// for the approved attestations covering cross-chain messages, including the merkle proofs required to submit them.
+package attestserve
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Indexer is the off-chain attestation index served by the query server, see lib/attestindex.
+type Indexer interface {
+	SubmissionByMsgID(msgID xchain.MsgID) (xchain.Submission, bool, error)
+	SubmissionsByTxHash(txHash common.Hash) ([]xchain.Submission, error)
+}
+
+// Register constructs a new attestation query server instance and registers it with the provided gRPC server.
+// The indexer function returns false while the index isn't available yet.
+func Register(s grpc1.Server, indexer func() (Indexer, bool)) {
+	RegisterQueryServer(s, &server{
+		indexer: indexer,
+	})
+}
+
+var _ QueryServer = (*server)(nil)
+
+type server struct {
+	indexer func() (Indexer, bool)
+}
+
+func (s server) AttestationByMsgID(_ context.Context, req *AttestationByMsgIDRequest) (*AttestationByMsgIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	indexer, ok := s.indexer()
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "attestation index not loaded yet")
+	}
+
+	sub, ok, err := indexer.SubmissionByMsgID(xchain.MsgID{
+		StreamID: xchain.StreamID{
+			SourceChainID: req.SourceChainId,
+			DestChainID:   req.DestChainId,
+			ShardID:       xchain.ShardID(req.ShardId),
+		},
+		StreamOffset: req.StreamOffset,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if !ok {
+		return nil, status.Error(codes.NotFound, "msg not indexed")
+	}
+
+	return &AttestationByMsgIDResponse{Submission: submissionToProto(sub)}, nil
+}
+
+func (s server) AttestationsByTxHash(_ context.Context, req *AttestationsByTxHashRequest) (*AttestationsByTxHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	txHash, err := cast.EthHash(req.TxHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	indexer, ok := s.indexer()
+	if !ok {
+		return nil, status.Error(codes.Unavailable, "attestation index not loaded yet")
+	}
+
+	subs, err := indexer.SubmissionsByTxHash(txHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	} else if len(subs) == 0 {
+		return nil, status.Error(codes.NotFound, "tx msgs not indexed")
+	}
+
+	resp := make([]*Submission, 0, len(subs))
+	for _, sub := range subs {
+		resp = append(resp, submissionToProto(sub))
+	}
+
+	return &AttestationsByTxHashResponse{Submissions: resp}, nil
+}
+
+// submissionToProto converts a single message submission to its protobuf representation.
+func submissionToProto(sub xchain.Submission) *Submission {
+	sigs := make([]*Signature, 0, len(sub.Signatures))
+	for _, sig := range sub.Signatures {
+		sigs = append(sigs, &Signature{
+			ValidatorAddress: sig.ValidatorAddress.Bytes(),
+			Signature:        sig.Signature[:],
+		})
+	}
+
+	proof := make([][]byte, 0, len(sub.Proof))
+	for _, p := range sub.Proof {
+		proof = append(proof, p[:])
+	}
+
+	var msg *Msg
+	if len(sub.Msgs) > 0 {
+		m := sub.Msgs[0]
+		var fees string
+		if m.Fees != nil {
+			fees = m.Fees.String()
+		}
+
+		msg = &Msg{
+			SourceChainId:   m.SourceChainID,
+			DestChainId:     m.DestChainID,
+			ShardId:         uint64(m.ShardID),
+			StreamOffset:    m.StreamOffset,
+			SourceMsgSender: m.SourceMsgSender.Bytes(),
+			DestAddress:     m.DestAddress.Bytes(),
+			Data:            m.Data,
+			DestGasLimit:    m.DestGasLimit,
+			TxHash:          m.TxHash.Bytes(),
+			FeesWei:         fees,
+			LogIndex:        m.LogIndex,
+		}
+	}
+
+	return &Submission{
+		ConsensusChainId: sub.AttHeader.ConsensusChainID,
+		SourceChainId:    sub.AttHeader.ChainVersion.ID,
+		ConfLevel:        uint32(sub.AttHeader.ChainVersion.ConfLevel),
+		AttestOffset:     sub.AttHeader.AttestOffset,
+		BlockHeight:      sub.BlockHeader.BlockHeight,
+		BlockHash:        sub.BlockHeader.BlockHash.Bytes(),
+		AttestationRoot:  sub.AttestationRoot.Bytes(),
+		ValidatorSetId:   sub.ValidatorSetID,
+		Signatures:       sigs,
+		Msg:              msg,
+		Proof:            proof,
+		ProofFlags:       sub.ProofFlags,
+	}
+}
@@ -0,0 +1,151 @@
+package attestserve
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	msg := xchain.Msg{
+		MsgID: xchain.MsgID{
+			StreamID: xchain.StreamID{
+				SourceChainID: 1,
+				DestChainID:   2,
+				ShardID:       xchain.ShardFinalized0,
+			},
+			StreamOffset: 3,
+		},
+		SourceMsgSender: common.Address{0x01},
+		DestAddress:     common.Address{0x02},
+		Data:            []byte("data"),
+		DestGasLimit:    100_000,
+		TxHash:          common.Hash{0x03},
+		Fees:            big.NewInt(1e18),
+		LogIndex:        4,
+	}
+	sub := xchain.Submission{
+		AttestationRoot: common.Hash{0x04},
+		ValidatorSetID:  5,
+		AttHeader: xchain.AttestHeader{
+			ConsensusChainID: 6,
+			ChainVersion:     xchain.ChainVersion{ID: 1, ConfLevel: xchain.ConfFinalized},
+			AttestOffset:     7,
+		},
+		BlockHeader: xchain.BlockHeader{ChainID: 1, BlockHeight: 8, BlockHash: common.Hash{0x05}},
+		Msgs:        []xchain.Msg{msg},
+		Proof:       [][32]byte{{0x06}},
+		ProofFlags:  []bool{true},
+		Signatures:  []xchain.SigTuple{{ValidatorAddress: common.Address{0x07}, Signature: xchain.Signature65{0x08}}},
+		DestChainID: 2,
+	}
+
+	idx := &testIndexer{subs: []xchain.Submission{sub}}
+	var loaded bool
+	srv := server{indexer: func() (Indexer, bool) { return idx, loaded }}
+
+	byMsgID := &AttestationByMsgIDRequest{SourceChainId: 1, DestChainId: 2, ShardId: uint64(xchain.ShardFinalized0), StreamOffset: 3}
+	byTxHash := &AttestationsByTxHashRequest{TxHash: msg.TxHash.Bytes()}
+
+	// Nil requests are invalid
+	_, err := srv.AttestationByMsgID(ctx, nil)
+	requireCode(t, codes.InvalidArgument, err)
+	_, err = srv.AttestationsByTxHash(ctx, nil)
+	requireCode(t, codes.InvalidArgument, err)
+
+	// Index not loaded yet
+	_, err = srv.AttestationByMsgID(ctx, byMsgID)
+	requireCode(t, codes.Unavailable, err)
+	_, err = srv.AttestationsByTxHash(ctx, byTxHash)
+	requireCode(t, codes.Unavailable, err)
+
+	loaded = true
+
+	// Invalid tx hash
+	_, err = srv.AttestationsByTxHash(ctx, &AttestationsByTxHashRequest{TxHash: []byte{0x01}})
+	requireCode(t, codes.InvalidArgument, err)
+
+	// Not indexed
+	_, err = srv.AttestationByMsgID(ctx, &AttestationByMsgIDRequest{SourceChainId: 1, DestChainId: 2, StreamOffset: 99})
+	requireCode(t, codes.NotFound, err)
+	_, err = srv.AttestationsByTxHash(ctx, &AttestationsByTxHashRequest{TxHash: common.Hash{0x99}.Bytes()})
+	requireCode(t, codes.NotFound, err)
+
+	// Indexed
+	expected := &Submission{
+		ConsensusChainId: 6,
+		SourceChainId:    1,
+		ConfLevel:        uint32(xchain.ConfFinalized),
+		AttestOffset:     7,
+		BlockHeight:      8,
+		BlockHash:        common.Hash{0x05}.Bytes(),
+		AttestationRoot:  common.Hash{0x04}.Bytes(),
+		ValidatorSetId:   5,
+		Signatures:       []*Signature{{ValidatorAddress: common.Address{0x07}.Bytes(), Signature: append([]byte{0x08}, make([]byte, 64)...)}},
+		Msg: &Msg{
+			SourceChainId:   1,
+			DestChainId:     2,
+			ShardId:         uint64(xchain.ShardFinalized0),
+			StreamOffset:    3,
+			SourceMsgSender: common.Address{0x01}.Bytes(),
+			DestAddress:     common.Address{0x02}.Bytes(),
+			Data:            []byte("data"),
+			DestGasLimit:    100_000,
+			TxHash:          common.Hash{0x03}.Bytes(),
+			FeesWei:         "1000000000000000000",
+			LogIndex:        4,
+		},
+		Proof:      [][]byte{common.Hash{0x06}.Bytes()},
+		ProofFlags: []bool{true},
+	}
+
+	resp1, err := srv.AttestationByMsgID(ctx, byMsgID)
+	require.NoError(t, err)
+	require.Equal(t, expected, resp1.Submission)
+
+	resp2, err := srv.AttestationsByTxHash(ctx, byTxHash)
+	require.NoError(t, err)
+	require.Equal(t, []*Submission{expected}, resp2.Submissions)
+}
+
+func requireCode(t *testing.T, expected codes.Code, err error) {
+	t.Helper()
+	require.Error(t, err)
+	require.Equal(t, expected, status.Code(err))
+}
+
+type testIndexer struct {
+	subs []xchain.Submission
+}
+
+func (i *testIndexer) SubmissionByMsgID(msgID xchain.MsgID) (xchain.Submission, bool, error) {
+	for _, sub := range i.subs {
+		if sub.Msgs[0].MsgID == msgID {
+			return sub, true, nil
+		}
+	}
+
+	return xchain.Submission{}, false, nil
+}
+
+func (i *testIndexer) SubmissionsByTxHash(txHash common.Hash) ([]xchain.Submission, error) {
+	var resp []xchain.Submission
+	for _, sub := range i.subs {
+		if sub.Msgs[0].TxHash == txHash {
+			resp = append(resp, sub)
+		}
+	}
+
+	return resp, nil
+}
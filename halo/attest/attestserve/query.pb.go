@@ -0,0 +1,2630 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: halo/attest/attestserve/query.proto
+
+package attestserve
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AttestationByMsgIDRequest struct {
+	SourceChainId uint64 `protobuf:"varint,1,opt,name=source_chain_id,json=sourceChainId,proto3" json:"source_chain_id,omitempty"`
+	DestChainId   uint64 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	ShardId       uint64 `protobuf:"varint,3,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	StreamOffset  uint64 `protobuf:"varint,4,opt,name=stream_offset,json=streamOffset,proto3" json:"stream_offset,omitempty"`
+}
+
+func (m *AttestationByMsgIDRequest) Reset()         { *m = AttestationByMsgIDRequest{} }
+func (m *AttestationByMsgIDRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationByMsgIDRequest) ProtoMessage()    {}
+func (*AttestationByMsgIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{0}
+}
+func (m *AttestationByMsgIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationByMsgIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationByMsgIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationByMsgIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationByMsgIDRequest.Merge(m, src)
+}
+func (m *AttestationByMsgIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationByMsgIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationByMsgIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationByMsgIDRequest proto.InternalMessageInfo
+
+func (m *AttestationByMsgIDRequest) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *AttestationByMsgIDRequest) GetDestChainId() uint64 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *AttestationByMsgIDRequest) GetShardId() uint64 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *AttestationByMsgIDRequest) GetStreamOffset() uint64 {
+	if m != nil {
+		return m.StreamOffset
+	}
+	return 0
+}
+
+type AttestationByMsgIDResponse struct {
+	Submission *Submission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (m *AttestationByMsgIDResponse) Reset()         { *m = AttestationByMsgIDResponse{} }
+func (m *AttestationByMsgIDResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationByMsgIDResponse) ProtoMessage()    {}
+func (*AttestationByMsgIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{1}
+}
+func (m *AttestationByMsgIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationByMsgIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationByMsgIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationByMsgIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationByMsgIDResponse.Merge(m, src)
+}
+func (m *AttestationByMsgIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationByMsgIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationByMsgIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationByMsgIDResponse proto.InternalMessageInfo
+
+func (m *AttestationByMsgIDResponse) GetSubmission() *Submission {
+	if m != nil {
+		return m.Submission
+	}
+	return nil
+}
+
+type AttestationsByTxHashRequest struct {
+	TxHash []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *AttestationsByTxHashRequest) Reset()         { *m = AttestationsByTxHashRequest{} }
+func (m *AttestationsByTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByTxHashRequest) ProtoMessage()    {}
+func (*AttestationsByTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{2}
+}
+func (m *AttestationsByTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationsByTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByTxHashRequest.Merge(m, src)
+}
+func (m *AttestationsByTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByTxHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationsByTxHashRequest proto.InternalMessageInfo
+
+func (m *AttestationsByTxHashRequest) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+type AttestationsByTxHashResponse struct {
+	Submissions []*Submission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+}
+
+func (m *AttestationsByTxHashResponse) Reset()         { *m = AttestationsByTxHashResponse{} }
+func (m *AttestationsByTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByTxHashResponse) ProtoMessage()    {}
+func (*AttestationsByTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{3}
+}
+func (m *AttestationsByTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationsByTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByTxHashResponse.Merge(m, src)
+}
+func (m *AttestationsByTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByTxHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationsByTxHashResponse proto.InternalMessageInfo
+
+func (m *AttestationsByTxHashResponse) GetSubmissions() []*Submission {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+// Submission is a cross-chain message, the approved attestation covering it and the merkle proof required to submit it.
+type Submission struct {
+	ConsensusChainId uint64       `protobuf:"varint,1,opt,name=consensus_chain_id,json=consensusChainId,proto3" json:"consensus_chain_id,omitempty"`
+	SourceChainId    uint64       `protobuf:"varint,2,opt,name=source_chain_id,json=sourceChainId,proto3" json:"source_chain_id,omitempty"`
+	ConfLevel        uint32       `protobuf:"varint,3,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset     uint64       `protobuf:"varint,4,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
+	BlockHeight      uint64       `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash        []byte       `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	AttestationRoot  []byte       `protobuf:"bytes,7,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+	ValidatorSetId   uint64       `protobuf:"varint,8,opt,name=validator_set_id,json=validatorSetId,proto3" json:"validator_set_id,omitempty"`
+	Signatures       []*Signature `protobuf:"bytes,9,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Msg              *Msg         `protobuf:"bytes,10,opt,name=msg,proto3" json:"msg,omitempty"`
+	Proof            [][]byte     `protobuf:"bytes,11,rep,name=proof,proto3" json:"proof,omitempty"`
+	ProofFlags       []bool       `protobuf:"varint,12,rep,packed,name=proof_flags,json=proofFlags,proto3" json:"proof_flags,omitempty"`
+}
+
+func (m *Submission) Reset()         { *m = Submission{} }
+func (m *Submission) String() string { return proto.CompactTextString(m) }
+func (*Submission) ProtoMessage()    {}
+func (*Submission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{4}
+}
+func (m *Submission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Submission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Submission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Submission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Submission.Merge(m, src)
+}
+func (m *Submission) XXX_Size() int {
+	return m.Size()
+}
+func (m *Submission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Submission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Submission proto.InternalMessageInfo
+
+func (m *Submission) GetConsensusChainId() uint64 {
+	if m != nil {
+		return m.ConsensusChainId
+	}
+	return 0
+}
+
+func (m *Submission) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *Submission) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *Submission) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
+}
+
+func (m *Submission) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Submission) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Submission) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
+	}
+	return nil
+}
+
+func (m *Submission) GetValidatorSetId() uint64 {
+	if m != nil {
+		return m.ValidatorSetId
+	}
+	return 0
+}
+
+func (m *Submission) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *Submission) GetMsg() *Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *Submission) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *Submission) GetProofFlags() []bool {
+	if m != nil {
+		return m.ProofFlags
+	}
+	return nil
+}
+
+// Signature is a validator signature of an attestation root.
+type Signature struct {
+	ValidatorAddress []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Signature        []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{5}
+}
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
+}
+func (m *Signature) XXX_Size() int {
+	return m.Size()
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *Signature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// Msg is a cross-chain message.
+type Msg struct {
+	SourceChainId   uint64 `protobuf:"varint,1,opt,name=source_chain_id,json=sourceChainId,proto3" json:"source_chain_id,omitempty"`
+	DestChainId     uint64 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	ShardId         uint64 `protobuf:"varint,3,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	StreamOffset    uint64 `protobuf:"varint,4,opt,name=stream_offset,json=streamOffset,proto3" json:"stream_offset,omitempty"`
+	SourceMsgSender []byte `protobuf:"bytes,5,opt,name=source_msg_sender,json=sourceMsgSender,proto3" json:"source_msg_sender,omitempty"`
+	DestAddress     []byte `protobuf:"bytes,6,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+	Data            []byte `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	DestGasLimit    uint64 `protobuf:"varint,8,opt,name=dest_gas_limit,json=destGasLimit,proto3" json:"dest_gas_limit,omitempty"`
+	TxHash          []byte `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	FeesWei         string `protobuf:"bytes,10,opt,name=fees_wei,json=feesWei,proto3" json:"fees_wei,omitempty"`
+	LogIndex        uint64 `protobuf:"varint,11,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+}
+
+func (m *Msg) Reset()         { *m = Msg{} }
+func (m *Msg) String() string { return proto.CompactTextString(m) }
+func (*Msg) ProtoMessage()    {}
+func (*Msg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec6190159377881, []int{6}
+}
+func (m *Msg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Msg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Msg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Msg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Msg.Merge(m, src)
+}
+func (m *Msg) XXX_Size() int {
+	return m.Size()
+}
+func (m *Msg) XXX_DiscardUnknown() {
+	xxx_messageInfo_Msg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Msg proto.InternalMessageInfo
+
+func (m *Msg) GetSourceChainId() uint64 {
+	if m != nil {
+		return m.SourceChainId
+	}
+	return 0
+}
+
+func (m *Msg) GetDestChainId() uint64 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *Msg) GetShardId() uint64 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *Msg) GetStreamOffset() uint64 {
+	if m != nil {
+		return m.StreamOffset
+	}
+	return 0
+}
+
+func (m *Msg) GetSourceMsgSender() []byte {
+	if m != nil {
+		return m.SourceMsgSender
+	}
+	return nil
+}
+
+func (m *Msg) GetDestAddress() []byte {
+	if m != nil {
+		return m.DestAddress
+	}
+	return nil
+}
+
+func (m *Msg) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Msg) GetDestGasLimit() uint64 {
+	if m != nil {
+		return m.DestGasLimit
+	}
+	return 0
+}
+
+func (m *Msg) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *Msg) GetFeesWei() string {
+	if m != nil {
+		return m.FeesWei
+	}
+	return ""
+}
+
+func (m *Msg) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AttestationByMsgIDRequest)(nil), "halo.attest.attestserve.AttestationByMsgIDRequest")
+	proto.RegisterType((*AttestationByMsgIDResponse)(nil), "halo.attest.attestserve.AttestationByMsgIDResponse")
+	proto.RegisterType((*AttestationsByTxHashRequest)(nil), "halo.attest.attestserve.AttestationsByTxHashRequest")
+	proto.RegisterType((*AttestationsByTxHashResponse)(nil), "halo.attest.attestserve.AttestationsByTxHashResponse")
+	proto.RegisterType((*Submission)(nil), "halo.attest.attestserve.Submission")
+	proto.RegisterType((*Signature)(nil), "halo.attest.attestserve.Signature")
+	proto.RegisterType((*Msg)(nil), "halo.attest.attestserve.Msg")
+}
+
+func init() {
+	proto.RegisterFile("halo/attest/attestserve/query.proto", fileDescriptor_8ec6190159377881)
+}
+
+var fileDescriptor_8ec6190159377881 = []byte{
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0x16, 0x25, 0xdb, 0x12, 0x47, 0x92, 0x1f, 0x0b, 0x03, 0xa6, 0x1f, 0x55, 0x55, 0xba, 0x28,
+	0xd4, 0x07, 0x64, 0xd4, 0x6e, 0x7b, 0xb7, 0xdc, 0x87, 0x05, 0x58, 0x28, 0x4a, 0x17, 0x09, 0x90,
+	0x0b, 0xb1, 0x16, 0x57, 0x24, 0x11, 0x8a, 0x6b, 0x73, 0x56, 0x8e, 0x7d, 0xca, 0x29, 0x77, 0xff,
+	0x8b, 0xfc, 0x95, 0x1c, 0x7d, 0xcc, 0x31, 0xb0, 0x2f, 0x01, 0xf2, 0x27, 0x82, 0x1d, 0x52, 0x12,
+	0xe1, 0x48, 0x80, 0x7d, 0xcb, 0x49, 0xda, 0x6f, 0xbe, 0xd9, 0xfd, 0x76, 0xbe, 0xd9, 0x21, 0xec,
+	0x06, 0x3c, 0x92, 0x7b, 0x5c, 0x29, 0x81, 0x2a, 0xfb, 0x41, 0x91, 0x5c, 0x8a, 0xbd, 0x8b, 0x91,
+	0x48, 0xae, 0xdb, 0xe7, 0x89, 0x54, 0x92, 0x6d, 0x68, 0x52, 0x3b, 0x8d, 0xb6, 0x73, 0x24, 0xfb,
+	0xad, 0x01, 0x9b, 0x87, 0xb4, 0xe6, 0x2a, 0x94, 0x71, 0xe7, 0xba, 0x87, 0x7e, 0xf7, 0x4f, 0x47,
+	0x5c, 0x8c, 0x04, 0x2a, 0xf6, 0x03, 0xac, 0xa0, 0x1c, 0x25, 0x7d, 0xe1, 0xf6, 0x03, 0x1e, 0xc6,
+	0x6e, 0xe8, 0x59, 0x46, 0xd3, 0x68, 0x2d, 0x38, 0xf5, 0x14, 0x3e, 0xd2, 0x68, 0xd7, 0x63, 0x36,
+	0xd4, 0x3d, 0x81, 0x6a, 0xca, 0x2a, 0x12, 0xab, 0xaa, 0xc1, 0x31, 0x67, 0x13, 0x2a, 0x18, 0xf0,
+	0xc4, 0xd3, 0xe1, 0x12, 0x85, 0xcb, 0xb4, 0xee, 0x7a, 0x6c, 0x17, 0xea, 0xa8, 0x12, 0xc1, 0x87,
+	0xae, 0x1c, 0x0c, 0x50, 0x28, 0x6b, 0x81, 0xe2, 0xb5, 0x14, 0xfc, 0x97, 0x30, 0x9b, 0xc3, 0xd6,
+	0x2c, 0xa1, 0x78, 0x2e, 0x63, 0x14, 0xec, 0x08, 0x00, 0x47, 0x67, 0xc3, 0x10, 0x31, 0x94, 0x31,
+	0x89, 0xac, 0xee, 0xef, 0xb6, 0xe7, 0xdc, 0xba, 0x7d, 0x3a, 0xa1, 0x3a, 0xb9, 0x34, 0xfb, 0x0f,
+	0xd8, 0xce, 0x1d, 0x81, 0x9d, 0xeb, 0xff, 0xaf, 0x8e, 0x39, 0x06, 0xe3, 0x6a, 0x6c, 0x40, 0x59,
+	0x5d, 0xb9, 0x01, 0xc7, 0x80, 0x0e, 0xa8, 0x39, 0x4b, 0x8a, 0xe2, 0xb6, 0x80, 0x9d, 0xd9, 0x79,
+	0x99, 0xb8, 0xbf, 0xa0, 0x3a, 0x3d, 0x05, 0x2d, 0xa3, 0x59, 0x7a, 0xac, 0xba, 0x7c, 0x9e, 0xfd,
+	0xb1, 0x04, 0x30, 0x8d, 0xb1, 0x5f, 0x80, 0xf5, 0xf5, 0xf6, 0x31, 0x8e, 0xf0, 0xa1, 0x3f, 0xab,
+	0x93, 0xc8, 0xb8, 0xfc, 0x33, 0xac, 0x2c, 0xce, 0xb2, 0xf2, 0x1b, 0x80, 0xbe, 0x8c, 0x07, 0x6e,
+	0x24, 0x2e, 0x45, 0x44, 0x46, 0xd5, 0x1d, 0x53, 0x23, 0x27, 0x1a, 0xd0, 0x56, 0xa5, 0x52, 0x1f,
+	0x58, 0x95, 0x82, 0xa9, 0x55, 0xec, 0x3b, 0xa8, 0x9d, 0x45, 0xb2, 0xff, 0xd2, 0x0d, 0x44, 0xe8,
+	0x07, 0xca, 0x5a, 0x4c, 0xbb, 0x81, 0xb0, 0x63, 0x82, 0xf4, 0x31, 0x19, 0x45, 0x97, 0x73, 0x89,
+	0xca, 0x69, 0xa6, 0x04, 0x8e, 0x01, 0xfb, 0x11, 0x56, 0xf9, 0xb4, 0xa2, 0x6e, 0x22, 0xa5, 0xb2,
+	0xca, 0x44, 0x5a, 0xc9, 0xe1, 0x8e, 0x94, 0x8a, 0xb5, 0x60, 0xf5, 0x92, 0x47, 0xa1, 0xc7, 0x95,
+	0x4c, 0x5c, 0x14, 0x4a, 0xdf, 0xac, 0x42, 0x07, 0x2e, 0x4f, 0xf0, 0x53, 0xa1, 0xba, 0x1e, 0xeb,
+	0x00, 0x60, 0xe8, 0xc7, 0x5c, 0x8d, 0x12, 0x81, 0x96, 0x49, 0x2e, 0xd8, 0xf3, 0x5d, 0x18, 0x53,
+	0x9d, 0x5c, 0x16, 0x6b, 0x43, 0x69, 0x88, 0xbe, 0x05, 0xd4, 0x60, 0x3b, 0x73, 0x93, 0x7b, 0xe8,
+	0x3b, 0x9a, 0xc8, 0xd6, 0x61, 0xf1, 0x3c, 0x91, 0x72, 0x60, 0x55, 0x9b, 0xa5, 0x56, 0xcd, 0x49,
+	0x17, 0xec, 0x5b, 0xa8, 0xd2, 0x1f, 0x77, 0x10, 0x71, 0x1f, 0xad, 0x5a, 0xb3, 0xd4, 0xaa, 0x38,
+	0x40, 0xd0, 0xdf, 0x1a, 0xb1, 0x9f, 0x81, 0x39, 0x39, 0x9f, 0xfd, 0x0c, 0x6b, 0xd3, 0x1b, 0x72,
+	0xcf, 0x4b, 0x04, 0x62, 0xd6, 0x81, 0xd3, 0xab, 0x1f, 0xa6, 0x38, 0xdb, 0x01, 0x73, 0x22, 0x97,
+	0x1c, 0xae, 0x39, 0x53, 0xc0, 0xfe, 0x54, 0x84, 0x52, 0x0f, 0xfd, 0xaf, 0xe9, 0x61, 0xb3, 0x9f,
+	0x60, 0x2d, 0xd3, 0x32, 0x44, 0xdf, 0x45, 0x11, 0x7b, 0x22, 0xa1, 0x96, 0xa9, 0x39, 0x99, 0xc8,
+	0x1e, 0xfa, 0xa7, 0x04, 0xeb, 0xce, 0x22, 0x3d, 0xe3, 0x2a, 0xa4, 0x8d, 0x43, 0x72, 0xc6, 0x05,
+	0x60, 0xb0, 0xe0, 0x71, 0xc5, 0xb3, 0x76, 0xa1, 0xff, 0xec, 0x7b, 0x58, 0xa6, 0x34, 0x9f, 0xa3,
+	0x1b, 0x85, 0xc3, 0x50, 0x65, 0x1d, 0x42, 0x9b, 0xfd, 0xc3, 0xf1, 0x44, 0x63, 0xf9, 0xf7, 0x6d,
+	0xe6, 0xdf, 0xb7, 0xbe, 0xe1, 0x40, 0x08, 0x74, 0x5f, 0x89, 0x90, 0x9c, 0x37, 0x9d, 0xb2, 0x5e,
+	0x3f, 0x17, 0x21, 0xdb, 0x06, 0x33, 0x92, 0xbe, 0x1b, 0xc6, 0x9e, 0xb8, 0xb2, 0xaa, 0xb4, 0x69,
+	0x25, 0x92, 0x7e, 0x57, 0xaf, 0xf7, 0x6f, 0x8a, 0xb0, 0xf8, 0x9f, 0x9e, 0xc2, 0xec, 0x35, 0xb0,
+	0x2f, 0x87, 0x17, 0xdb, 0x9f, 0xdb, 0x3f, 0x73, 0x47, 0xf2, 0xd6, 0xc1, 0x93, 0x72, 0xd2, 0x01,
+	0x64, 0x17, 0xd8, 0x1b, 0x03, 0xd6, 0x67, 0xcd, 0x28, 0xf6, 0xdb, 0x63, 0xf6, 0x7b, 0x38, 0x0a,
+	0xb7, 0x7e, 0x7f, 0x62, 0xd6, 0x58, 0x47, 0xe7, 0xd7, 0x77, 0x77, 0x0d, 0xe3, 0xf6, 0xae, 0x61,
+	0x7c, 0xb8, 0x6b, 0x18, 0x37, 0xf7, 0x8d, 0xc2, 0xed, 0x7d, 0xa3, 0xf0, 0xfe, 0xbe, 0x51, 0x78,
+	0xb1, 0x31, 0xe7, 0x3b, 0x76, 0xb6, 0x44, 0x9f, 0xb0, 0x83, 0xcf, 0x03, 0x00, 0x13, 0xe7, 0x5b,
+	0xf0, 0xe9, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AttestationByMsgID queries halo for the approved attestation covering the cross-chain message with the given ID,
+	// including the merkle proof required to submit it.
+	AttestationByMsgID(ctx context.Context, in *AttestationByMsgIDRequest, opts ...grpc.CallOption) (*AttestationByMsgIDResponse, error)
+	// AttestationsByTxHash queries halo for the approved attestations covering all cross-chain messages
+	// emitted by the given source chain transaction, including the merkle proofs required to submit them.
+	AttestationsByTxHash(ctx context.Context, in *AttestationsByTxHashRequest, opts ...grpc.CallOption) (*AttestationsByTxHashResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) AttestationByMsgID(ctx context.Context, in *AttestationByMsgIDRequest, opts ...grpc.CallOption) (*AttestationByMsgIDResponse, error) {
+	out := new(AttestationByMsgIDResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.attestserve.Query/AttestationByMsgID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationsByTxHash(ctx context.Context, in *AttestationsByTxHashRequest, opts ...grpc.CallOption) (*AttestationsByTxHashResponse, error) {
+	out := new(AttestationsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.attestserve.Query/AttestationsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AttestationByMsgID queries halo for the approved attestation covering the cross-chain message with the given ID,
+	// including the merkle proof required to submit it.
+	AttestationByMsgID(context.Context, *AttestationByMsgIDRequest) (*AttestationByMsgIDResponse, error)
+	// AttestationsByTxHash queries halo for the approved attestations covering all cross-chain messages
+	// emitted by the given source chain transaction, including the merkle proofs required to submit them.
+	AttestationsByTxHash(context.Context, *AttestationsByTxHashRequest) (*AttestationsByTxHashResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AttestationByMsgID(ctx context.Context, req *AttestationByMsgIDRequest) (*AttestationByMsgIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationByMsgID not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsByTxHash(ctx context.Context, req *AttestationsByTxHashRequest) (*AttestationsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByTxHash not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AttestationByMsgID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationByMsgIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationByMsgID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.attestserve.Query/AttestationByMsgID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationByMsgID(ctx, req.(*AttestationByMsgIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.attestserve.Query/AttestationsByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByTxHash(ctx, req.(*AttestationsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "halo.attest.attestserve.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AttestationByMsgID",
+			Handler:    _Query_AttestationByMsgID_Handler,
+		},
+		{
+			MethodName: "AttestationsByTxHash",
+			Handler:    _Query_AttestationsByTxHash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "halo/attest/attestserve/query.proto",
+}
+
+func (m *AttestationByMsgIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationByMsgIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationByMsgIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationByMsgIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationByMsgIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationByMsgIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Submission != nil {
+		{
+			size, err := m.Submission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Submission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Submission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Submission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofFlags) > 0 {
+		for iNdEx := len(m.ProofFlags) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.ProofFlags[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofFlags)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ValidatorSetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AttestOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConsensusChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Signature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Signature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Signature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Msg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Msg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Msg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.FeesWei) > 0 {
+		i -= len(m.FeesWei)
+		copy(dAtA[i:], m.FeesWei)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeesWei)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DestGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestGasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SourceMsgSender) > 0 {
+		i -= len(m.SourceMsgSender)
+		copy(dAtA[i:], m.SourceMsgSender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceMsgSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StreamOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationByMsgIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovQuery(uint64(m.ShardId))
+	}
+	if m.StreamOffset != 0 {
+		n += 1 + sovQuery(uint64(m.StreamOffset))
+	}
+	return n
+}
+
+func (m *AttestationByMsgIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Submission != nil {
+		l = m.Submission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationsByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationsByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Submission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusChainId))
+	}
+	if m.SourceChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ValidatorSetId != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetId))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProofFlags) > 0 {
+		n += 1 + sovQuery(uint64(len(m.ProofFlags))) + len(m.ProofFlags)*1
+	}
+	return n
+}
+
+func (m *Signature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Msg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SourceChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovQuery(uint64(m.ShardId))
+	}
+	if m.StreamOffset != 0 {
+		n += 1 + sovQuery(uint64(m.StreamOffset))
+	}
+	l = len(m.SourceMsgSender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DestGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.DestGasLimit))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeesWei)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovQuery(uint64(m.LogIndex))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationByMsgIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationByMsgIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationByMsgIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamOffset", wireType)
+			}
+			m.StreamOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationByMsgIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationByMsgIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationByMsgIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submission == nil {
+				m.Submission = &Submission{}
+			}
+			if err := m.Submission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsByTxHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsByTxHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsByTxHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsByTxHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &Submission{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Submission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Submission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Submission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusChainId", wireType)
+			}
+			m.ConsensusChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+			}
+			m.AttestOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetId", wireType)
+			}
+			m.ValidatorSetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &Msg{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProofFlags = append(m.ProofFlags, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.ProofFlags) == 0 {
+					m.ProofFlags = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProofFlags = append(m.ProofFlags, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofFlags", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Signature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Msg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Msg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Msg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChainId", wireType)
+			}
+			m.SourceChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamOffset", wireType)
+			}
+			m.StreamOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceMsgSender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceMsgSender = append(m.SourceMsgSender[:0], dAtA[iNdEx:postIndex]...)
+			if m.SourceMsgSender == nil {
+				m.SourceMsgSender = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = append(m.DestAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestAddress == nil {
+				m.DestAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestGasLimit", wireType)
+			}
+			m.DestGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesWei", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesWei = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -296,6 +296,131 @@ func (x *Signature) GetAttestOffset() uint64 {
 	return 0
 }
 
+// DoubleSign is the evidence of a validator signing conflicting attestation roots for the same attest header.
+type DoubleSign struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Id                         uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                                    // Auto-incremented ID
+	ValidatorAddress           []byte                 `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`                                 // Validator ethereum address; 20 bytes.
+	ChainId                    uint64                 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                                           // Chain ID as per https://chainlist.org
+	ConfLevel                  uint32                 `protobuf:"varint,4,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`                                                     // Confirmation level of the cross-chain block
+	AttestOffset               uint64                 `protobuf:"varint,5,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`                                            // Offset of the cross-chain block
+	AttestationRoot            []byte                 `protobuf:"bytes,6,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`                                    // Previously signed attestation root.
+	Signature                  []byte                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                                                                       // Validator signature over the previously signed attestation root.
+	ConflictingAttestationRoot []byte                 `protobuf:"bytes,8,opt,name=conflicting_attestation_root,json=conflictingAttestationRoot,proto3" json:"conflicting_attestation_root,omitempty"` // Conflicting attestation root.
+	ConflictingSignature       []byte                 `protobuf:"bytes,9,opt,name=conflicting_signature,json=conflictingSignature,proto3" json:"conflicting_signature,omitempty"`                     // Validator signature over the conflicting attestation root.
+	CreatedHeight              uint64                 `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`                                        // Consensus height at which this double sign was detected.
+	Slashed                    bool                   `protobuf:"varint,11,opt,name=slashed,proto3" json:"slashed,omitempty"`                                                                         // Whether the validator was slashed and jailed.
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *DoubleSign) Reset() {
+	*x = DoubleSign{}
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleSign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleSign) ProtoMessage() {}
+
+func (x *DoubleSign) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_attest_keeper_attestation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleSign.ProtoReflect.Descriptor instead.
+func (*DoubleSign) Descriptor() ([]byte, []int) {
+	return file_halo_attest_keeper_attestation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DoubleSign) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetValidatorAddress() []byte {
+	if x != nil {
+		return x.ValidatorAddress
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetConfLevel() uint32 {
+	if x != nil {
+		return x.ConfLevel
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetAttestOffset() uint64 {
+	if x != nil {
+		return x.AttestOffset
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetAttestationRoot() []byte {
+	if x != nil {
+		return x.AttestationRoot
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetConflictingAttestationRoot() []byte {
+	if x != nil {
+		return x.ConflictingAttestationRoot
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetConflictingSignature() []byte {
+	if x != nil {
+		return x.ConflictingSignature
+	}
+	return nil
+}
+
+func (x *DoubleSign) GetCreatedHeight() uint64 {
+	if x != nil {
+		return x.CreatedHeight
+	}
+	return 0
+}
+
+func (x *DoubleSign) GetSlashed() bool {
+	if x != nil {
+		return x.Slashed
+	}
+	return false
+}
+
 var File_halo_attest_keeper_attestation_proto protoreflect.FileDescriptor
 
 var file_halo_attest_keeper_attestation_proto_rawDesc = string([]byte{
@@ -357,23 +482,56 @@ var file_halo_attest_keeper_attestation_proto_rawDesc = string([]byte{
 	0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c,
 	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x2c, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x10,
-	0x02, 0x18, 0x01, 0x18, 0x02, 0x2a, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x02, 0x42, 0xc5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e,
-	0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0x42, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f,
-	0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4b, 0xaa, 0x02, 0x12,
-	0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x65, 0x70,
-	0x65, 0x72, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a,
-	0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x18, 0x01, 0x18, 0x02, 0x22, 0x8a, 0x04, 0x0a, 0x0a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x29, 0x0a, 0x10, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x1c, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x1a, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x33, 0x0a, 0x15, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x3a, 0x5f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x59, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
+	0x01, 0x12, 0x39, 0x0a, 0x33, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c,
+	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2c, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x10, 0x01, 0x18, 0x01, 0x12, 0x12, 0x0a, 0x0e,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x10, 0x02,
+	0x18, 0x03, 0x2a, 0x30, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x64, 0x10, 0x02, 0x42, 0xc5, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c,
+	0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x42,
+	0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e,
+	0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6b, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4b, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c,
+	0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xca,
+	0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4b, 0x65,
+	0x65, 0x70, 0x65, 0x72, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -389,11 +547,12 @@ func file_halo_attest_keeper_attestation_proto_rawDescGZIP() []byte {
 }
 
 var file_halo_attest_keeper_attestation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_halo_attest_keeper_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_halo_attest_keeper_attestation_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_halo_attest_keeper_attestation_proto_goTypes = []any{
 	(Status)(0),         // 0: halo.attest.keeper.Status
 	(*Attestation)(nil), // 1: halo.attest.keeper.Attestation
 	(*Signature)(nil),   // 2: halo.attest.keeper.Signature
+	(*DoubleSign)(nil),  // 3: halo.attest.keeper.DoubleSign
 }
 var file_halo_attest_keeper_attestation_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -414,7 +573,7 @@ func file_halo_attest_keeper_attestation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_halo_attest_keeper_attestation_proto_rawDesc), len(file_halo_attest_keeper_attestation_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,19 +2,25 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/testutil"
 	"github.com/omni-network/omni/halo/attest/types"
+	evmstakingtypes "github.com/omni-network/omni/halo/evmstaking/types"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/xchain"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -25,6 +31,8 @@ type mocks struct {
 	namer       *testutil.MockChainNamer
 	valProvider *testutil.MockValProvider
 	registry    *testutil.MockRegistry
+	slashing    *testutil.MockSlashingKeeper
+	upgrades    *testutil.MockUpgradeKeeper
 }
 
 type expectation func(sdk.Context, mocks)
@@ -80,6 +88,48 @@ func activeSetQueried(height uint64) expectation {
 	}
 }
 
+// doubleSignSlashed returns an expectation that the provided double signers are slashed
+// by the configured fraction and jailed for the configured duration once each.
+func doubleSignSlashed(vals ...vtypes.Validator) expectation {
+	return func(ctx sdk.Context, m mocks) {
+		for _, val := range vals {
+			cmtAddr, err := val.CometAddress()
+			if err != nil {
+				panic(err)
+			}
+			consAddr := sdk.ConsAddress(cmtAddr)
+
+			m.slashing.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).
+				Return(slashingtypes.ValidatorSigningInfo{}, nil).Times(1)
+			m.slashing.EXPECT().SlashWithInfractionReason(gomock.Any(), consAddr, math.LegacyMustNewDecFromStr(doubleSignFraction), val.Power, int64(0), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN).
+				Return(nil).Times(1)
+			m.slashing.EXPECT().Jail(gomock.Any(), consAddr).Return(nil).Times(1)
+			m.slashing.EXPECT().JailUntil(gomock.Any(), consAddr, ctx.BlockTime().Add(doubleSignJail)).Return(nil).Times(1)
+		}
+	}
+}
+
+// beforeDrake returns an expectation that the drake network upgrade isn't active yet.
+func beforeDrake() expectation {
+	return func(_ sdk.Context, m mocks) {
+		m.upgrades.EXPECT().GetModuleVersionMap(gomock.Any()).
+			Return(module.VersionMap{evmstakingtypes.ModuleName: evmstakingtypes.DrakeVersion - 1}, nil).AnyTimes()
+	}
+}
+
+// doubleSignAlreadyJailed returns an expectation that double signers are already jailed the provided number of times.
+func doubleSignAlreadyJailed(times int) expectation {
+	return func(ctx sdk.Context, m mocks) {
+		m.slashing.EXPECT().GetValidatorSigningInfo(gomock.Any(), gomock.Any()).
+			Return(slashingtypes.ValidatorSigningInfo{JailedUntil: ctx.BlockTime().Add(time.Hour)}, nil).Times(times)
+	}
+}
+
+const (
+	doubleSignFraction = "0.1"
+	doubleSignJail     = time.Hour
+)
+
 func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk.Context) {
 	t.Helper()
 
@@ -98,6 +148,8 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 		namer:       testutil.NewMockChainNamer(ctrl),
 		valProvider: testutil.NewMockValProvider(ctrl),
 		registry:    testutil.NewMockRegistry(ctrl),
+		slashing:    testutil.NewMockSlashingKeeper(ctrl),
+		upgrades:    testutil.NewMockUpgradeKeeper(ctrl),
 	}
 
 	if len(expectations) == 0 {
@@ -108,10 +160,15 @@ func setupKeeper(t *testing.T, expectations ...expectation) (*keeper.Keeper, sdk
 		}
 	}
 
+	// Drake is active by default, unless overridden by the expectations above.
+	m.upgrades.EXPECT().GetModuleVersionMap(gomock.Any()).
+		Return(module.VersionMap{evmstakingtypes.ModuleName: evmstakingtypes.DrakeVersion}, nil).AnyTimes()
+
 	const voteWindowUp = 1
 	const voteWindowDown = 0
 	const voteLimit = 4
-	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter, voteWindowUp, voteWindowDown, voteLimit, trimLag, cTrimLag)
+	k, err := keeper.New(codec, storeSvc, m.skeeper, m.namer.ChainName, m.voter, voteWindowUp, voteWindowDown, voteLimit, trimLag, cTrimLag,
+		m.slashing, m.upgrades, doubleSignFraction, doubleSignJail)
 	require.NoError(t, err, "new keeper")
 
 	k.SetValidatorProvider(m.valProvider)
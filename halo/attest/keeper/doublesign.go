@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/evmslashing"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	// EventTypeDoubleSign is emitted when a validator double signs an attestation.
+	// Slashed validators are also reported via an evmslashing (consensus chain) jail event, see evmslashing.EmitJail.
+	EventTypeDoubleSign = "attest_double_sign"
+
+	// jailReasonDoubleSign is the evmslashing jail event reason of attestation double signs.
+	jailReasonDoubleSign = "attest_double_sign"
+
+	AttributeKeyValidator    = "validator"
+	AttributeKeyChainID      = "chain_id"
+	AttributeKeyConfLevel    = "conf_level"
+	AttributeKeyAttestOffset = "attest_offset"
+	AttributeKeySlashed      = "slashed"
+)
+
+// recordDoubleSign persists the evidence of the validator signing a conflicting attestation root for the
+// same attest header and slashes and jails the validator. It must only be called after the drake network upgrade.
+// Slashing failures are logged and recorded in the evidence, since they must not fail block execution.
+func (k *Keeper) recordDoubleSign(ctx context.Context, conflictRoot common.Hash, header *types.AttestHeader, sig *types.SigTuple) error {
+	valAddr := sig.GetValidatorAddress()
+
+	if ok, err := k.doubleSignTable.HasByValidatorAddressChainIdConfLevelAttestOffset(ctx, valAddr, header.GetSourceChainId(), header.GetConfLevel(), header.GetAttestOffset()); err != nil {
+		return errors.Wrap(err, "has double sign")
+	} else if ok {
+		return nil // Only record (and slash) the first double sign per attest header.
+	}
+
+	existing, err := k.sigTable.GetByChainIdConfLevelAttestOffsetValidatorAddress(ctx, header.GetSourceChainId(), header.GetConfLevel(), header.GetAttestOffset(), valAddr)
+	if err != nil {
+		return errors.Wrap(err, "get existing signature")
+	}
+
+	existingAtt, err := k.attTable.Get(ctx, existing.GetAttId())
+	if err != nil {
+		return errors.Wrap(err, "get existing attestation")
+	}
+
+	attrs := []any{
+		"chain", k.namer(header.XChainVersion()),
+		"attest_offset", header.GetAttestOffset(),
+		log.Hex7("validator", valAddr),
+	}
+
+	slashed := true
+	if err := k.slashDoubleSign(ctx, common.BytesToAddress(valAddr)); err != nil {
+		log.Warn(ctx, "Slashing attestation double signer failed", err, attrs...)
+		slashed = false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = k.doubleSignTable.Insert(ctx, &DoubleSign{
+		ValidatorAddress:           valAddr,
+		ChainId:                    header.GetSourceChainId(),
+		ConfLevel:                  header.GetConfLevel(),
+		AttestOffset:               header.GetAttestOffset(),
+		AttestationRoot:            existingAtt.GetAttestationRoot(),
+		Signature:                  existing.GetSignature(),
+		ConflictingAttestationRoot: conflictRoot.Bytes(),
+		ConflictingSignature:       sig.GetSignature(),
+		CreatedHeight:              uint64(sdkCtx.BlockHeight()),
+		Slashed:                    slashed,
+	})
+	if err != nil {
+		return errors.Wrap(err, "insert double sign")
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeDoubleSign,
+		sdk.NewAttribute(AttributeKeyValidator, common.BytesToAddress(valAddr).Hex()),
+		sdk.NewAttribute(AttributeKeyChainID, strconv.FormatUint(header.GetSourceChainId(), 10)),
+		sdk.NewAttribute(AttributeKeyConfLevel, strconv.FormatUint(uint64(header.GetConfLevel()), 10)),
+		sdk.NewAttribute(AttributeKeyAttestOffset, strconv.FormatUint(header.GetAttestOffset(), 10)),
+		sdk.NewAttribute(AttributeKeySlashed, strconv.FormatBool(slashed)),
+	))
+
+	doubleSignSlashCounter.WithLabelValues(common.BytesToAddress(valAddr).Hex(), strconv.FormatBool(slashed)).Inc()
+
+	if slashed {
+		log.Warn(ctx, "🚨 Slashed and jailed attestation double signer", nil, attrs...)
+	}
+
+	return nil
+}
+
+// slashDoubleSign slashes and jails the validator on a state branch.
+// The branch is only committed if all steps succeed.
+func (k *Keeper) slashDoubleSign(ctx context.Context, valAddr common.Address) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.New("recovered", "panic", r)
+		}
+	}()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// Votes are signed by the previous block's validator set.
+	prevBlock := sdkCtx.BlockHeight() - 1
+	valset, err := k.valProvider.ActiveSetByHeight(ctx, uint64(prevBlock))
+	if err != nil {
+		return errors.Wrap(err, "active set")
+	}
+
+	var consAddr sdk.ConsAddress
+	var power int64
+	for _, val := range valset.Validators {
+		ethAddr, err := val.EthereumAddress()
+		if err != nil {
+			return err
+		} else if ethAddr != valAddr {
+			continue
+		}
+
+		cmtAddr, err := val.CometAddress()
+		if err != nil {
+			return err
+		}
+
+		consAddr, power = sdk.ConsAddress(cmtAddr), val.Power
+
+		break
+	}
+	if consAddr == nil {
+		return errors.New("validator not in active set")
+	}
+
+	info, err := k.slashKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return errors.Wrap(err, "signing info")
+	} else if info.JailedUntil.After(sdkCtx.BlockTime()) {
+		return errors.New("validator already jailed")
+	}
+
+	fraction, err := k.doubleSignSlashFraction(ctx)
+	if err != nil {
+		return err
+	}
+
+	branchMS := sdkCtx.MultiStore().CacheMultiStore()
+	branchCtx := sdkCtx.WithMultiStore(branchMS).WithEventManager(sdk.NewEventManager())
+
+	if err := k.slashKeeper.SlashWithInfractionReason(branchCtx, consAddr, fraction, power, prevBlock, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN); err != nil {
+		return errors.Wrap(err, "slash")
+	}
+
+	if err := k.slashKeeper.Jail(branchCtx, consAddr); err != nil {
+		return errors.Wrap(err, "jail")
+	}
+
+	if err := k.slashKeeper.JailUntil(branchCtx, consAddr, sdkCtx.BlockTime().Add(k.doubleSignJail)); err != nil {
+		return errors.Wrap(err, "jail until")
+	}
+
+	branchMS.Write()
+	sdkCtx.EventManager().EmitEvents(branchCtx.EventManager().Events())
+	evmslashing.EmitJail(ctx, valAddr, jailReasonDoubleSign, sdkCtx.BlockTime().Add(k.doubleSignJail))
+
+	return nil
+}
+
+// doubleSignSlashFraction returns the configured double sign slash fraction
+// or the slashing module's slash_fraction_double_sign parameter.
+func (k *Keeper) doubleSignSlashFraction(ctx context.Context) (math.LegacyDec, error) {
+	if k.doubleSignFraction != nil {
+		return *k.doubleSignFraction, nil
+	}
+
+	fraction, err := k.slashKeeper.SlashFractionDoubleSign(ctx)
+	if err != nil {
+		return math.LegacyDec{}, errors.Wrap(err, "slash fraction double sign")
+	}
+
+	return fraction, nil
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/types"
+	evmstakingtypes "github.com/omni-network/omni/halo/evmstaking/types"
 	rtypes "github.com/omni-network/omni/halo/registry/types"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/errors"
@@ -18,7 +20,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"cosmossdk.io/orm/model/ormdb"
 	"cosmossdk.io/orm/model/ormlist"
 	"cosmossdk.io/orm/types/ormerrors"
@@ -38,15 +42,19 @@ var _ sdk.VerifyVoteExtensionHandler = (*Keeper)(nil).VerifyVoteExtension
 // Keeper is the attestation keeper.
 // It keeps tracks of all attestations included on-chain and detects when they are approved.
 type Keeper struct {
-	attTable       AttestationTable
-	sigTable       SignatureTable
-	cdc            codec.BinaryCodec
-	storeService   store.KVStoreService
-	skeeper        baseapp.ValidatorStore
-	valProvider    vtypes.ValidatorProvider
-	portalRegistry rtypes.PortalRegistry
-	namer          types.ChainVerNameFunc
-	voter          types.Voter
+	attTable        AttestationTable
+	sigTable        SignatureTable
+	doubleSignTable DoubleSignTable
+	genesis         appmodule.HasGenesis
+	cdc             codec.BinaryCodec
+	storeService    store.KVStoreService
+	skeeper         baseapp.ValidatorStore
+	valProvider     vtypes.ValidatorProvider
+	portalRegistry  rtypes.PortalRegistry
+	namer           types.ChainVerNameFunc
+	voter           types.Voter
+	slashKeeper     types.SlashingKeeper
+	uKeeper         types.UpgradeKeeper
 
 	voteWindowUp   uint64 // Vote window upper bound delta
 	voteWindowDown uint64 // Vote window lower bound delta
@@ -54,6 +62,9 @@ type Keeper struct {
 	trimLag        uint64 // Non-consensus chain trim lag
 	cTrimLag       uint64 // Consensus chain trim lag
 
+	doubleSignFraction *math.LegacyDec // Double sign slash fraction, nil uses the slashing module param
+	doubleSignJail     time.Duration   // Double sign jail duration
+
 	valAddrCache *valAddrCache
 }
 
@@ -69,6 +80,10 @@ func New(
 	voteExtLimit uint64,
 	trimLag uint64,
 	cTrimLag uint64,
+	slashKeeper types.SlashingKeeper,
+	uKeeper types.UpgradeKeeper,
+	doubleSignFraction string,
+	doubleSignJail time.Duration,
 ) (*Keeper, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
 		{Id: 1, ProtoFileName: File_halo_attest_keeper_attestation_proto.Path()},
@@ -88,21 +103,42 @@ func New(
 		return nil, errors.New("consensus trim lag must be greater than or equal to trim lag")
 	}
 
+	if doubleSignJail <= 0 {
+		return nil, errors.New("double sign jail duration must be positive")
+	}
+
+	var fraction *math.LegacyDec
+	if doubleSignFraction != "" {
+		dec, err := math.LegacyNewDecFromStr(doubleSignFraction)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse double sign slash fraction")
+		} else if dec.IsNegative() || dec.GT(math.LegacyOneDec()) {
+			return nil, errors.New("double sign slash fraction must be between 0 and 1", "fraction", doubleSignFraction)
+		}
+		fraction = &dec
+	}
+
 	k := &Keeper{
-		attTable:       attStore.AttestationTable(),
-		sigTable:       attStore.SignatureTable(),
-		cdc:            cdc,
-		storeService:   storeSvc,
-		skeeper:        skeeper,
-		namer:          namer,
-		voter:          voter,
-		voteWindowUp:   voteWindowUp,
-		voteWindowDown: voteWindowDown,
-		voteExtLimit:   voteExtLimit,
-		trimLag:        trimLag,
-		cTrimLag:       cTrimLag,
-		portalRegistry: stubPortalRegistry{},
-		valAddrCache:   new(valAddrCache),
+		attTable:           attStore.AttestationTable(),
+		sigTable:           attStore.SignatureTable(),
+		doubleSignTable:    attStore.DoubleSignTable(),
+		genesis:            modDB.GenesisHandler(),
+		cdc:                cdc,
+		storeService:       storeSvc,
+		skeeper:            skeeper,
+		namer:              namer,
+		voter:              voter,
+		slashKeeper:        slashKeeper,
+		uKeeper:            uKeeper,
+		voteWindowUp:       voteWindowUp,
+		voteWindowDown:     voteWindowDown,
+		voteExtLimit:       voteExtLimit,
+		trimLag:            trimLag,
+		cTrimLag:           cTrimLag,
+		doubleSignFraction: fraction,
+		doubleSignJail:     doubleSignJail,
+		portalRegistry:     stubPortalRegistry{},
+		valAddrCache:       new(valAddrCache),
 	}
 
 	return k, nil
@@ -246,6 +282,15 @@ func (k *Keeper) addOne(ctx context.Context, agg *types.AggVote, valSetID uint64
 			} else if ok {
 				doubleSignCounter.WithLabelValues(sigTup.ValidatorAddress.Hex()).Inc()
 				log.Warn(ctx, "🚨 Ignoring duplicate slashable vote", nil, attrs...)
+
+				// Double signs are only recorded and slashed after the drake network upgrade.
+				if drake, err := evmstakingtypes.IsDrake(ctx, k.uKeeper); err != nil {
+					return err
+				} else if drake {
+					if err := k.recordDoubleSign(ctx, attRoot, agg.AttestHeader, sig); err != nil {
+						return errors.Wrap(err, "record double sign")
+					}
+				}
 			} else {
 				// Ignore identical duplicate. See https://github.com/omni-network/omni/issues/2286.
 				log.Debug(ctx, "Ignoring duplicate vote", attrs...)
@@ -561,6 +606,76 @@ func (k *Keeper) earliestAttestation(ctx context.Context, version xchain.ChainVe
 	return att, true, nil
 }
 
+// attestationByBlockHeight returns the approved attestation for the given chain and source block height or
+// false if none is found. Since block heights increase with attest offsets, it binary searches the
+// approved attestations currently in state.
+func (k *Keeper) attestationByBlockHeight(ctx context.Context, version xchain.ChainVersion, height uint64) (*Attestation, bool, error) {
+	defer latency("attestation_by_block_height")()
+
+	earliest, ok, err := k.earliestAttestation(ctx, version)
+	if err != nil {
+		return nil, false, err
+	} else if !ok {
+		return nil, false, nil
+	}
+
+	latest, ok, err := k.latestAttestation(ctx, version)
+	if err != nil {
+		return nil, false, err
+	} else if !ok {
+		return nil, false, errors.New("latest attestation not found [BUG]")
+	}
+
+	if height < earliest.GetBlockHeight() || height > latest.GetBlockHeight() {
+		return nil, false, nil
+	}
+
+	lo, hi := earliest.GetAttestOffset(), latest.GetAttestOffset()
+	for lo <= hi {
+		mid := lo + (hi-lo)/2
+
+		idx := AttestationStatusChainIdConfLevelAttestOffsetIndexKey{}.WithStatusChainIdConfLevelAttestOffset(uint32(Status_Approved), version.ID, uint32(version.ConfLevel), mid)
+		iter, err := k.attTable.List(ctx, idx)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "list")
+		}
+
+		found := iter.Next()
+		var att *Attestation
+		if found {
+			att, err = iter.Value()
+		}
+		iter.Close()
+		if err != nil {
+			return nil, false, errors.Wrap(err, "value")
+		} else if !found {
+			return nil, false, errors.New("approved attestation gap", "offset", mid)
+		}
+
+		switch {
+		case att.GetBlockHeight() < height:
+			lo = mid + 1
+		case att.GetBlockHeight() > height:
+			if mid == lo {
+				return nil, false, nil // Avoid underflow
+			}
+			hi = mid - 1
+		default:
+			// If this attestation is overridden by a finalized attestation, return that instead.
+			if att.GetFinalizedAttId() != 0 {
+				att, err = k.attTable.Get(ctx, att.GetFinalizedAttId())
+				if err != nil {
+					return nil, false, errors.Wrap(err, "get finalized attestation")
+				}
+			}
+
+			return att, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
 // listAllAttestations returns all attestations for the given chain and status and attestOffset up to a maximum of 100.
 func (k *Keeper) listAllAttestations(ctx context.Context, version xchain.ChainVersion, status Status, attestOffset uint64) ([]*types.Attestation, error) {
 	defer latency("list_all_attestations")()
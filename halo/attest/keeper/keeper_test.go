@@ -5,6 +5,7 @@ import (
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/halo/evmslashing"
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/tutil"
 	"github.com/omni-network/omni/lib/umath"
@@ -13,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/require"
@@ -175,6 +177,10 @@ func TestKeeper_Add(t *testing.T) {
 		},
 		{
 			name: "skip_mismatching_att_root_same_block_and_vals",
+			expectations: []expectation{
+				mockDefaultExpectations,
+				doubleSignSlashed(val1, val2),
+			},
 			args: args{
 				msg: defaultMsg().
 					WithVotes(
@@ -204,6 +210,83 @@ func TestKeeper_Add(t *testing.T) {
 					// Update agg vote's signatures are not added, since they are double signs
 				},
 			},
+			postrequisites: []postrequisite{
+				expectDoubleSigns(true, val1, val2),
+			},
+		},
+		{
+			name: "double_sign_already_jailed",
+			expectations: []expectation{
+				mockDefaultExpectations,
+				doubleSignAlreadyJailed(1),
+			},
+			args: args{
+				msg: defaultMsg().
+					WithVotes(
+						// Update agg vote to a different att root but identical block, signed by val 1 (double sign)
+						defaultAggVote().
+							WithMsgRoot(common.BytesToHash([]byte("different root"))).
+							WithSignatures(sigsTuples(val1)...).
+							Vote(),
+					).Msg(),
+			},
+			prerequisites: []prerequisite{
+				func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+					t.Helper()
+					msg := defaultMsg().Msg()
+					err := k.Add(ctx, msg)
+					require.NoError(t, err)
+				},
+			},
+			want: want{
+				atts: []*keeper.Attestation{
+					expectPendingAtt(1, defaultOffset, 1),
+				},
+				sigs: []*keeper.Signature{
+					expectValSig(1, 1, val1, defaultOffset),
+					expectValSig(2, 1, val2, defaultOffset),
+				},
+			},
+			postrequisites: []postrequisite{
+				expectDoubleSigns(false, val1),
+			},
+		},
+		{
+			name: "double_sign_before_drake",
+			expectations: []expectation{
+				mockDefaultExpectations,
+				beforeDrake(),
+				// Double signers are not slashed before drake
+			},
+			args: args{
+				msg: defaultMsg().
+					WithVotes(
+						// Update agg vote to a different att root but identical block, signed by same vals (double sign)
+						defaultAggVote().
+							WithMsgRoot(common.BytesToHash([]byte("different root"))).
+							Vote(),
+					).Msg(),
+			},
+			prerequisites: []prerequisite{
+				func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+					t.Helper()
+					msg := defaultMsg().Msg()
+					err := k.Add(ctx, msg)
+					require.NoError(t, err)
+				},
+			},
+			want: want{
+				atts: []*keeper.Attestation{
+					expectPendingAtt(1, defaultOffset, 1),
+				},
+				sigs: []*keeper.Signature{
+					expectValSig(1, 1, val1, defaultOffset),
+					expectValSig(2, 1, val2, defaultOffset),
+				},
+			},
+			postrequisites: []postrequisite{
+				expectDoubleSigns(false), // No evidence recorded before drake
+			},
 		},
 		{
 			name: "mismatching_att_root_same_block_diff_vals",
@@ -1036,6 +1119,80 @@ func toValSet(valset *vtypes.ValidatorSetResponse) keeper.ValSet {
 	}
 }
 
+// expectDoubleSigns returns a postrequisite asserting that double sign evidence of the provided validators is recorded.
+func expectDoubleSigns(slashed bool, vals ...vtypes.Validator) postrequisite {
+	return func(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+		t.Helper()
+
+		resp, err := k.DoubleSigns(ctx, &types.DoubleSignsRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.DoubleSigns, len(vals))
+
+		for i, val := range vals {
+			ethAddr, err := val.EthereumAddress()
+			require.NoError(t, err)
+
+			ds := resp.DoubleSigns[i]
+			require.Equal(t, ethAddr.Bytes(), ds.ValidatorAddress)
+			require.Equal(t, uint64(defaultChainID), ds.ChainId)
+			require.Equal(t, defaultOffset, ds.AttestOffset)
+			require.NotEqual(t, ds.AttestationRoot, ds.ConflictingAttestationRoot)
+			require.Equal(t, slashed, ds.Slashed)
+		}
+
+		// Slashed validators are reported via evmslashing jail events
+		var jailed []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != evmslashing.EventTypeJail {
+				continue
+			}
+			attr, ok := event.GetAttribute(evmslashing.AttributeKeyValidator)
+			require.True(t, ok)
+			jailed = append(jailed, attr.Value)
+		}
+		if !slashed {
+			require.Empty(t, jailed)
+		} else {
+			require.Len(t, jailed, len(vals))
+			for i, val := range vals {
+				ethAddr, err := val.EthereumAddress()
+				require.NoError(t, err)
+				require.Equal(t, ethAddr.Hex(), jailed[i])
+			}
+		}
+
+		if len(vals) == 0 {
+			return
+		}
+
+		// Filtering by validator only returns its evidence
+		ethAddr, err := vals[0].EthereumAddress()
+		require.NoError(t, err)
+		resp, err = k.DoubleSigns(ctx, &types.DoubleSignsRequest{ValidatorAddress: ethAddr.Bytes()})
+		require.NoError(t, err)
+		require.Len(t, resp.DoubleSigns, 1)
+
+		// Pages are limited and continue from the next key
+		var paged []*types.DoubleSign
+		var nextKey []byte
+		for {
+			resp, err := k.DoubleSigns(ctx, &types.DoubleSignsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 1}})
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.DoubleSigns), 1)
+			paged = append(paged, resp.DoubleSigns...)
+
+			nextKey = resp.Pagination.GetNextKey()
+			if len(nextKey) == 0 {
+				break
+			}
+		}
+		require.Len(t, paged, len(vals))
+
+		_, err = k.DoubleSigns(ctx, &types.DoubleSignsRequest{Pagination: &query.PageRequest{Limit: 1001}})
+		require.Error(t, err)
+	}
+}
+
 func expectValSig(id uint64, attID uint64, val vtypes.Validator, offset uint64) *keeper.Signature {
 	ethAddr, _ := val.EthereumAddress()
 	paddedSig := pad65(ethAddr.Bytes())
@@ -1114,3 +1271,116 @@ func consensusSig(sig *keeper.Signature) *keeper.Signature {
 	sig.ChainId = consensusID
 	return sig
 }
+
+func TestAttestationsByBlockHeight(t *testing.T) {
+	t.Parallel()
+
+	k, ctx := setupKeeper(t, fuzzyDeps(6))
+
+	// Insert approved attestations at block heights 10, 20, ... for offsets 1..10 (finalized) and 1..5 (latest).
+	insert := func(confLevel xchain.ConfLevel, offsets uint64) {
+		for offset := uint64(1); offset <= offsets; offset++ {
+			err := k.AttestTableForT().Insert(ctx, &keeper.Attestation{
+				ChainId:         defaultChainID,
+				ConfLevel:       uint32(confLevel),
+				AttestOffset:    offset,
+				BlockHeight:     offset * 10,
+				BlockHash:       tutil.RandomHash().Bytes(),
+				MsgRoot:         tutil.RandomHash().Bytes(),
+				AttestationRoot: tutil.RandomHash().Bytes(),
+				Status:          uint32(keeper.Status_Approved),
+				ValidatorSetId:  1,
+				CreatedHeight:   1,
+			})
+			require.NoError(t, err)
+		}
+	}
+	insert(xchain.ConfFinalized, 10)
+	insert(xchain.ConfLatest, 5)
+
+	query := func(height uint64) []*types.Attestation {
+		resp, err := k.AttestationsByBlockHeight(ctx, &types.AttestationsByBlockHeightRequest{
+			ChainId:     defaultChainID,
+			BlockHeight: height,
+		})
+		require.NoError(t, err)
+
+		return resp.Attestations
+	}
+
+	atts := query(30)
+	require.Len(t, atts, 2)
+	for _, att := range atts {
+		require.EqualValues(t, 30, att.BlockHeader.BlockHeight)
+		require.EqualValues(t, 3, att.AttestHeader.AttestOffset)
+	}
+
+	for _, height := range []uint64{70, 100} {
+		atts = query(height)
+		require.Len(t, atts, 1)
+		require.EqualValues(t, height, atts[0].BlockHeader.BlockHeight)
+		require.EqualValues(t, xchain.ConfFinalized, atts[0].AttestHeader.ConfLevel)
+	}
+
+	// Unattested block heights
+	require.Empty(t, query(5))
+	require.Empty(t, query(35))
+
+	// Unknown chain
+	_, err := k.AttestationsByBlockHeight(ctx, &types.AttestationsByBlockHeightRequest{ChainId: 99, BlockHeight: 10})
+	require.Error(t, err)
+}
+
+func TestGenesis(t *testing.T) {
+	t.Parallel()
+
+	var (
+		atteCmpOpts = cmp.Options{cmpopts.IgnoreUnexported(keeper.Attestation{})}
+		sigsCmpOpts = cmp.Options{cmpopts.IgnoreUnexported(keeper.Signature{})}
+	)
+
+	k, ctx := setupKeeper(t)
+
+	for offset := uint64(1); offset <= 3; offset++ {
+		attID, err := k.AttestTableForT().InsertReturningId(ctx, &keeper.Attestation{
+			ChainId:         defaultChainID,
+			ConfLevel:       defaultConfLevel,
+			AttestOffset:    offset,
+			BlockHeight:     offset * 10,
+			BlockHash:       tutil.RandomHash().Bytes(),
+			MsgRoot:         tutil.RandomHash().Bytes(),
+			AttestationRoot: tutil.RandomHash().Bytes(),
+			Status:          uint32(keeper.Status_Approved),
+			ValidatorSetId:  1,
+			CreatedHeight:   1,
+		})
+		require.NoError(t, err)
+
+		sig := expectValSig(0, attID, val1, offset)
+		require.NoError(t, k.SignatureTableForT().Insert(ctx, sig))
+	}
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+
+	// Import into a new keeper
+	imported, importedCtx := setupKeeper(t)
+	require.NoError(t, imported.InitGenesis(importedCtx, exported))
+
+	atts, sigs := dumpTables(t, ctx, k)
+	importedAtts, importedSigs := dumpTables(t, importedCtx, imported)
+	require.Len(t, importedAtts, 3)
+	require.Len(t, importedSigs, 3)
+	require.True(t, cmp.Equal(atts, importedAtts, atteCmpOpts), cmp.Diff(atts, importedAtts, atteCmpOpts))
+	require.True(t, cmp.Equal(sigs, importedSigs, sigsCmpOpts), cmp.Diff(sigs, importedSigs, sigsCmpOpts))
+
+	// Re-exporting the imported state is identical.
+	reexported, err := imported.ExportGenesis(importedCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	// Table sequences are imported, so new rows don't reuse ids.
+	sig := expectValSig(0, 1, val2, 1)
+	require.NoError(t, imported.SignatureTableForT().Insert(importedCtx, sig))
+	require.EqualValues(t, 4, sig.GetId())
+}
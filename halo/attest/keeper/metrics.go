@@ -53,6 +53,13 @@ var (
 		Help:      "Total number of double sign votes detected per validator",
 	}, []string{"validator"})
 
+	doubleSignSlashCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "attest",
+		Name:      "double_sign_evidence_total",
+		Help:      "Total number of double sign evidence recorded per validator and whether it was slashed",
+	}, []string{"validator", "slashed"})
+
 	approvedVotesCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "halo",
 		Subsystem: "attest",
@@ -9,14 +9,21 @@ import (
 	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
 
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"cosmossdk.io/orm/model/ormlist"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
 
-const approvedFromLimit = 100
+const (
+	approvedFromLimit      = 100
+	defaultDoubleSignLimit = 100
+	maxDoubleSignLimit     = 1000
+)
 
 func (k *Keeper) AttestationsFrom(ctx context.Context, req *types.AttestationsFromRequest) (*types.AttestationsFromResponse, error) {
 	if req == nil {
@@ -127,6 +134,119 @@ func (k *Keeper) WindowCompare(ctx context.Context, req *types.WindowCompareRequ
 	return &types.WindowCompareResponse{Cmp: cmpInt32}, nil
 }
 
+func (k *Keeper) AttestationsByBlockHeight(ctx context.Context, req *types.AttestationsByBlockHeightRequest) (*types.AttestationsByBlockHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	chainConfLevels, err := k.portalRegistry.ConfLevels(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	confLevels, ok := chainConfLevels[req.ChainId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "unknown chain")
+	}
+
+	var resp []*types.Attestation
+	for _, confLevel := range confLevels {
+		chainVer := xchain.ChainVersion{ID: req.ChainId, ConfLevel: confLevel}
+
+		att, ok, err := k.attestationByBlockHeight(ctx, chainVer, req.BlockHeight)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		} else if !ok {
+			continue
+		}
+
+		attResp, err := k.formAttestationResponse(ctx, att)
+		if err != nil {
+			return nil, errors.Wrap(err, "form response")
+		}
+
+		resp = append(resp, attResp)
+	}
+
+	return &types.AttestationsByBlockHeightResponse{Attestations: resp}, nil
+}
+
+// DoubleSigns returns a page of attestation double sign evidence, optionally filtered by validator.
+func (k *Keeper) DoubleSigns(ctx context.Context, req *types.DoubleSignsRequest) (*types.DoubleSignsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	} else if req.Pagination.GetLimit() > maxDoubleSignLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination limit exceeds %d", maxDoubleSignLimit)
+	}
+
+	var key DoubleSignIndexKey = DoubleSignIdIndexKey{}
+	if len(req.ValidatorAddress) > 0 {
+		key = DoubleSignValidatorAddressChainIdConfLevelAttestOffsetIndexKey{}.WithValidatorAddress(req.ValidatorAddress)
+	}
+
+	iter, err := k.doubleSignTable.List(ctx, key,
+		ormlist.Paginate(toPageRequest(req.Pagination)),
+		ormlist.DefaultLimit(defaultDoubleSignLimit),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var resp []*types.DoubleSign
+	for iter.Next() {
+		ds, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp = append(resp, &types.DoubleSign{
+			ValidatorAddress:           ds.GetValidatorAddress(),
+			ChainId:                    ds.GetChainId(),
+			ConfLevel:                  ds.GetConfLevel(),
+			AttestOffset:               ds.GetAttestOffset(),
+			AttestationRoot:            ds.GetAttestationRoot(),
+			Signature:                  ds.GetSignature(),
+			ConflictingAttestationRoot: ds.GetConflictingAttestationRoot(),
+			ConflictingSignature:       ds.GetConflictingSignature(),
+			CreatedHeight:              ds.GetCreatedHeight(),
+			Slashed:                    ds.GetSlashed(),
+		})
+	}
+
+	return &types.DoubleSignsResponse{
+		DoubleSigns: resp,
+		Pagination:  fromPageResponse(iter.PageResponse()),
+	}, nil
+}
+
+// toPageRequest converts the gogoproto page request to the ORM (pulsar) page request.
+func toPageRequest(req *query.PageRequest) *queryv1beta1.PageRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &queryv1beta1.PageRequest{
+		Key:        req.Key,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		CountTotal: req.CountTotal,
+		Reverse:    req.Reverse,
+	}
+}
+
+// fromPageResponse converts the ORM (pulsar) page response to the gogoproto page response.
+func fromPageResponse(resp *queryv1beta1.PageResponse) *query.PageResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &query.PageResponse{
+		NextKey: resp.NextKey,
+		Total:   resp.Total,
+	}
+}
+
 func getConsensusChainID(ctx context.Context) (uint64, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return netconf.ConsensusChainIDStr2Uint64(sdkCtx.ChainID())
@@ -2,18 +2,25 @@ package module
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/omni-network/omni/halo/attest/keeper"
 	"github.com/omni-network/omni/halo/attest/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	skeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -25,6 +32,7 @@ var (
 	_ appmodule.AppModule       = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
+	_ module.HasGenesis         = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -91,6 +99,31 @@ func (m AppModule) EndBlock(ctx context.Context) error {
 	return m.keeper.EndBlock(ctx)
 }
 
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	raw, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return raw
+}
+
+// DefaultGenesis returns empty default genesis state, module tables are only populated from exported state.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the attest module.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServiceServer(cfg.MsgServer(), keeper.NewMsgServerImpl(m.keeper))
@@ -127,6 +160,8 @@ type ModuleInputs struct {
 	Logger       log.Logger
 	TXConfig     client.TxConfig
 	SKeeper      *skeeper.Keeper
+	SlashKeeper  slashingkeeper.Keeper
+	UKeeper      *upgradekeeper.Keeper
 	Namer        types.ChainVerNameFunc
 	Voter        types.Voter
 }
@@ -150,6 +185,10 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		in.Config.GetVoteExtensionLimit(),
 		in.Config.GetTrimLag(),
 		in.Config.GetConsensusTrimLag(),
+		in.SlashKeeper,
+		in.UKeeper,
+		in.Config.GetDoubleSignSlashFraction(),
+		time.Duration(in.Config.GetDoubleSignJailSeconds())*time.Second,
 	)
 	if err != nil {
 		return ModuleOutputs{}, err
@@ -14,13 +14,15 @@ import (
 )
 
 var (
-	md_Module                      protoreflect.MessageDescriptor
-	fd_Module_authority            protoreflect.FieldDescriptor
-	fd_Module_vote_window_up       protoreflect.FieldDescriptor
-	fd_Module_vote_window_down     protoreflect.FieldDescriptor
-	fd_Module_vote_extension_limit protoreflect.FieldDescriptor
-	fd_Module_trim_lag             protoreflect.FieldDescriptor
-	fd_Module_consensus_trim_lag   protoreflect.FieldDescriptor
+	md_Module                            protoreflect.MessageDescriptor
+	fd_Module_authority                  protoreflect.FieldDescriptor
+	fd_Module_vote_window_up             protoreflect.FieldDescriptor
+	fd_Module_vote_window_down           protoreflect.FieldDescriptor
+	fd_Module_vote_extension_limit       protoreflect.FieldDescriptor
+	fd_Module_trim_lag                   protoreflect.FieldDescriptor
+	fd_Module_consensus_trim_lag         protoreflect.FieldDescriptor
+	fd_Module_double_sign_slash_fraction protoreflect.FieldDescriptor
+	fd_Module_double_sign_jail_seconds   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -32,6 +34,8 @@ func init() {
 	fd_Module_vote_extension_limit = md_Module.Fields().ByName("vote_extension_limit")
 	fd_Module_trim_lag = md_Module.Fields().ByName("trim_lag")
 	fd_Module_consensus_trim_lag = md_Module.Fields().ByName("consensus_trim_lag")
+	fd_Module_double_sign_slash_fraction = md_Module.Fields().ByName("double_sign_slash_fraction")
+	fd_Module_double_sign_jail_seconds = md_Module.Fields().ByName("double_sign_jail_seconds")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -135,6 +139,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DoubleSignSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.DoubleSignSlashFraction)
+		if !f(fd_Module_double_sign_slash_fraction, value) {
+			return
+		}
+	}
+	if x.DoubleSignJailSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DoubleSignJailSeconds)
+		if !f(fd_Module_double_sign_jail_seconds, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -162,6 +178,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TrimLag != uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return x.ConsensusTrimLag != uint64(0)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		return x.DoubleSignSlashFraction != ""
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		return x.DoubleSignJailSeconds != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -190,6 +210,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.TrimLag = uint64(0)
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = uint64(0)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		x.DoubleSignSlashFraction = ""
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		x.DoubleSignJailSeconds = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -224,6 +248,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "halo.attest.module.Module.consensus_trim_lag":
 		value := x.ConsensusTrimLag
 		return protoreflect.ValueOfUint64(value)
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		value := x.DoubleSignSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		value := x.DoubleSignJailSeconds
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -256,6 +286,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.TrimLag = value.Uint()
 	case "halo.attest.module.Module.consensus_trim_lag":
 		x.ConsensusTrimLag = value.Uint()
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		x.DoubleSignSlashFraction = value.Interface().(string)
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		x.DoubleSignJailSeconds = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -288,6 +322,10 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field trim_lag of message halo.attest.module.Module is not mutable"))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		panic(fmt.Errorf("field consensus_trim_lag of message halo.attest.module.Module is not mutable"))
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		panic(fmt.Errorf("field double_sign_slash_fraction of message halo.attest.module.Module is not mutable"))
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		panic(fmt.Errorf("field double_sign_jail_seconds of message halo.attest.module.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -313,6 +351,10 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "halo.attest.module.Module.consensus_trim_lag":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "halo.attest.module.Module.double_sign_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "halo.attest.module.Module.double_sign_jail_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: halo.attest.module.Module"))
@@ -401,6 +443,13 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.ConsensusTrimLag != 0 {
 			n += 1 + runtime.Sov(uint64(x.ConsensusTrimLag))
 		}
+		l = len(x.DoubleSignSlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DoubleSignJailSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.DoubleSignJailSeconds))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -430,6 +479,18 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.DoubleSignJailSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DoubleSignJailSeconds))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.DoubleSignSlashFraction) > 0 {
+			i -= len(x.DoubleSignSlashFraction)
+			copy(dAtA[i:], x.DoubleSignSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DoubleSignSlashFraction)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.ConsensusTrimLag != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConsensusTrimLag))
 			i--
@@ -638,6 +699,57 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DoubleSignSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DoubleSignSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DoubleSignJailSeconds", wireType)
+				}
+				x.DoubleSignJailSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DoubleSignJailSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -706,6 +818,11 @@ type Module struct {
 	TrimLag uint64 `protobuf:"varint,5,opt,name=trim_lag,json=trimLag,proto3" json:"trim_lag,omitempty"`
 	// consensus_trim_lag defines the number of blocks after which consensus-chain attestations are deleted from the module state.
 	ConsensusTrimLag uint64 `protobuf:"varint,6,opt,name=consensus_trim_lag,json=consensusTrimLag,proto3" json:"consensus_trim_lag,omitempty"`
+	// double_sign_slash_fraction defines the fraction of stake slashed when a validator double signs an attestation,
+	// as a decimal string. If empty, the slashing module's slash_fraction_double_sign parameter is used.
+	DoubleSignSlashFraction string `protobuf:"bytes,7,opt,name=double_sign_slash_fraction,json=doubleSignSlashFraction,proto3" json:"double_sign_slash_fraction,omitempty"`
+	// double_sign_jail_seconds defines the number of seconds a validator is jailed for when it double signs an attestation.
+	DoubleSignJailSeconds uint64 `protobuf:"varint,8,opt,name=double_sign_jail_seconds,json=doubleSignJailSeconds,proto3" json:"double_sign_jail_seconds,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -770,6 +887,20 @@ func (x *Module) GetConsensusTrimLag() uint64 {
 	return 0
 }
 
+func (x *Module) GetDoubleSignSlashFraction() string {
+	if x != nil {
+		return x.DoubleSignSlashFraction
+	}
+	return ""
+}
+
+func (x *Module) GetDoubleSignJailSeconds() uint64 {
+	if x != nil {
+		return x.DoubleSignJailSeconds
+	}
+	return 0
+}
+
 var File_halo_attest_module_module_proto protoreflect.FileDescriptor
 
 var file_halo_attest_module_module_proto_rawDesc = []byte{
@@ -778,7 +909,7 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x12, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d,
 	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x61, 0x70,
 	0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x99, 0x03, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
 	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
 	0x12, 0x24, 0x0a, 0x0e, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
@@ -793,22 +924,30 @@ var file_halo_attest_module_module_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x2c, 0x0a,
 	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f,
 	0x6c, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65,
-	0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x3a, 0x30, 0xba, 0xc0, 0x96,
-	0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e,
-	0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x42, 0xb4, 0x01,
-	0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x61, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xa2, 0x02, 0x03, 0x48,
-	0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xe2, 0x02, 0x1e, 0x48,
-	0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14,
-	0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x3a, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x75, 0x73, 0x54, 0x72, 0x69, 0x6d, 0x4c, 0x61, 0x67, 0x12, 0x3b, 0x0a, 0x1a, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x17, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68,
+	0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x4a, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x3a, 0x30, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x2a, 0x0a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x42, 0xb4, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f,
+	0x2e, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x0b,
+	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0xa2, 0x02, 0x03, 0x48, 0x41, 0x4d, 0xaa, 0x02, 0x12, 0x48, 0x61, 0x6c, 0x6f, 0x2e,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0xca, 0x02, 0x12,
+	0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x5c, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0xe2, 0x02, 0x1e, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x41, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -29,3 +29,11 @@ type ChainNamer interface {
 type Registry interface {
 	rtypes.PortalRegistry
 }
+
+type SlashingKeeper interface {
+	types.SlashingKeeper
+}
+
+type UpgradeKeeper interface {
+	types.UpgradeKeeper
+}
@@ -12,12 +12,17 @@ package testutil
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	math "cosmossdk.io/math"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	module "github.com/cosmos/cosmos-sdk/types/module"
+	types0 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	common "github.com/ethereum/go-ethereum/common"
-	types0 "github.com/omni-network/omni/halo/attest/types"
-	types1 "github.com/omni-network/omni/halo/valsync/types"
+	types2 "github.com/omni-network/omni/halo/attest/types"
+	types3 "github.com/omni-network/omni/halo/valsync/types"
 	xchain "github.com/omni-network/omni/lib/xchain"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -86,10 +91,10 @@ func (m *MockVoter) EXPECT() *MockVoterMockRecorder {
 }
 
 // GetAvailable mocks base method.
-func (m *MockVoter) GetAvailable() []*types0.Vote {
+func (m *MockVoter) GetAvailable() []*types2.Vote {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAvailable")
-	ret0, _ := ret[0].([]*types0.Vote)
+	ret0, _ := ret[0].([]*types2.Vote)
 	return ret0
 }
 
@@ -114,7 +119,7 @@ func (mr *MockVoterMockRecorder) LocalAddress() *gomock.Call {
 }
 
 // SetCommitted mocks base method.
-func (m *MockVoter) SetCommitted(ctx context.Context, headers []*types0.AttestHeader) error {
+func (m *MockVoter) SetCommitted(ctx context.Context, headers []*types2.AttestHeader) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetCommitted", ctx, headers)
 	ret0, _ := ret[0].(error)
@@ -128,7 +133,7 @@ func (mr *MockVoterMockRecorder) SetCommitted(ctx, headers any) *gomock.Call {
 }
 
 // SetProposed mocks base method.
-func (m *MockVoter) SetProposed(ctx context.Context, headers []*types0.AttestHeader) error {
+func (m *MockVoter) SetProposed(ctx context.Context, headers []*types2.AttestHeader) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetProposed", ctx, headers)
 	ret0, _ := ret[0].(error)
@@ -156,7 +161,7 @@ func (mr *MockVoterMockRecorder) TrimBehind(minsByChain any) *gomock.Call {
 }
 
 // UpdateValidatorSet mocks base method.
-func (m *MockVoter) UpdateValidatorSet(set *types1.ValidatorSetResponse) error {
+func (m *MockVoter) UpdateValidatorSet(set *types3.ValidatorSetResponse) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateValidatorSet", set)
 	ret0, _ := ret[0].(error)
@@ -194,10 +199,10 @@ func (m *MockValProvider) EXPECT() *MockValProviderMockRecorder {
 }
 
 // ActiveSetByHeight mocks base method.
-func (m *MockValProvider) ActiveSetByHeight(ctx context.Context, height uint64) (*types1.ValidatorSetResponse, error) {
+func (m *MockValProvider) ActiveSetByHeight(ctx context.Context, height uint64) (*types3.ValidatorSetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ActiveSetByHeight", ctx, height)
-	ret0, _ := ret[0].(*types1.ValidatorSetResponse)
+	ret0, _ := ret[0].(*types3.ValidatorSetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -209,10 +214,10 @@ func (mr *MockValProviderMockRecorder) ActiveSetByHeight(ctx, height any) *gomoc
 }
 
 // ValidatorSet mocks base method.
-func (m *MockValProvider) ValidatorSet(ctx context.Context, req *types1.ValidatorSetRequest) (*types1.ValidatorSetResponse, error) {
+func (m *MockValProvider) ValidatorSet(ctx context.Context, req *types3.ValidatorSetRequest) (*types3.ValidatorSetResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorSet", ctx, req)
-	ret0, _ := ret[0].(*types1.ValidatorSetResponse)
+	ret0, _ := ret[0].(*types3.ValidatorSetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -299,3 +304,138 @@ func (mr *MockRegistryMockRecorder) ConfLevels(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfLevels", reflect.TypeOf((*MockRegistry)(nil).ConfLevels), ctx)
 }
+
+// MockSlashingKeeper is a mock of SlashingKeeper interface.
+type MockSlashingKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlashingKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockSlashingKeeperMockRecorder is the mock recorder for MockSlashingKeeper.
+type MockSlashingKeeperMockRecorder struct {
+	mock *MockSlashingKeeper
+}
+
+// NewMockSlashingKeeper creates a new mock instance.
+func NewMockSlashingKeeper(ctrl *gomock.Controller) *MockSlashingKeeper {
+	mock := &MockSlashingKeeper{ctrl: ctrl}
+	mock.recorder = &MockSlashingKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlashingKeeper) EXPECT() *MockSlashingKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetValidatorSigningInfo mocks base method.
+func (m *MockSlashingKeeper) GetValidatorSigningInfo(ctx context.Context, consAddr types.ConsAddress) (types0.ValidatorSigningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetValidatorSigningInfo", ctx, consAddr)
+	ret0, _ := ret[0].(types0.ValidatorSigningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorSigningInfo indicates an expected call of GetValidatorSigningInfo.
+func (mr *MockSlashingKeeperMockRecorder) GetValidatorSigningInfo(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorSigningInfo", reflect.TypeOf((*MockSlashingKeeper)(nil).GetValidatorSigningInfo), ctx, consAddr)
+}
+
+// Jail mocks base method.
+func (m *MockSlashingKeeper) Jail(ctx context.Context, consAddr types.ConsAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jail", ctx, consAddr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Jail indicates an expected call of Jail.
+func (mr *MockSlashingKeeperMockRecorder) Jail(ctx, consAddr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jail", reflect.TypeOf((*MockSlashingKeeper)(nil).Jail), ctx, consAddr)
+}
+
+// JailUntil mocks base method.
+func (m *MockSlashingKeeper) JailUntil(ctx context.Context, consAddr types.ConsAddress, jailTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JailUntil", ctx, consAddr, jailTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JailUntil indicates an expected call of JailUntil.
+func (mr *MockSlashingKeeperMockRecorder) JailUntil(ctx, consAddr, jailTime any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), ctx, consAddr, jailTime)
+}
+
+// SlashFractionDoubleSign mocks base method.
+func (m *MockSlashingKeeper) SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashFractionDoubleSign", ctx)
+	ret0, _ := ret[0].(math.LegacyDec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlashFractionDoubleSign indicates an expected call of SlashFractionDoubleSign.
+func (mr *MockSlashingKeeperMockRecorder) SlashFractionDoubleSign(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashFractionDoubleSign", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashFractionDoubleSign), ctx)
+}
+
+// SlashWithInfractionReason mocks base method.
+func (m *MockSlashingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction types1.Infraction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, fraction, power, distributionHeight, infraction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlashWithInfractionReason indicates an expected call of SlashWithInfractionReason.
+func (mr *MockSlashingKeeperMockRecorder) SlashWithInfractionReason(ctx, consAddr, fraction, power, distributionHeight, infraction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlashWithInfractionReason", reflect.TypeOf((*MockSlashingKeeper)(nil).SlashWithInfractionReason), ctx, consAddr, fraction, power, distributionHeight, infraction)
+}
+
+// MockUpgradeKeeper is a mock of UpgradeKeeper interface.
+type MockUpgradeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgradeKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockUpgradeKeeperMockRecorder is the mock recorder for MockUpgradeKeeper.
+type MockUpgradeKeeperMockRecorder struct {
+	mock *MockUpgradeKeeper
+}
+
+// NewMockUpgradeKeeper creates a new mock instance.
+func NewMockUpgradeKeeper(ctrl *gomock.Controller) *MockUpgradeKeeper {
+	mock := &MockUpgradeKeeper{ctrl: ctrl}
+	mock.recorder = &MockUpgradeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgradeKeeper) EXPECT() *MockUpgradeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetModuleVersionMap mocks base method.
+func (m *MockUpgradeKeeper) GetModuleVersionMap(ctx context.Context) (module.VersionMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleVersionMap", ctx)
+	ret0, _ := ret[0].(module.VersionMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModuleVersionMap indicates an expected call of GetModuleVersionMap.
+func (mr *MockUpgradeKeeperMockRecorder) GetModuleVersionMap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleVersionMap", reflect.TypeOf((*MockUpgradeKeeper)(nil).GetModuleVersionMap), ctx)
+}
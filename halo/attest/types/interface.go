@@ -2,11 +2,18 @@ package types
 
 import (
 	"context"
+	"time"
 
 	vtypes "github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/xchain"
 
 	"github.com/ethereum/go-ethereum/common"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Voter abstracts the validator duty of voting for all applicable
@@ -58,3 +65,17 @@ type ChainVerNameFunc func(xchain.ChainVersion) string
 type AttestKeeper interface {
 	ListAttestationsFrom(ctx context.Context, chainID uint64, confLevel uint32, offset uint64, max uint64) ([]*Attestation, error)
 }
+
+// SlashingKeeper abstracts the cosmos slashing module used to punish attestation double signing.
+type SlashingKeeper interface {
+	SlashFractionDoubleSign(ctx context.Context) (sdkmath.LegacyDec, error)
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction sdkmath.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) error
+	Jail(ctx context.Context, consAddr sdk.ConsAddress) error
+	JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error
+	GetValidatorSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) (slashingtypes.ValidatorSigningInfo, error)
+}
+
+// UpgradeKeeper abstracts the cosmos upgrade module used to gate network upgrade features.
+type UpgradeKeeper interface {
+	GetModuleVersionMap(ctx context.Context) (module.VersionMap, error)
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
@@ -542,6 +543,323 @@ func (m *WindowCompareResponse) GetCmp() int32 {
 	return 0
 }
 
+type AttestationsByBlockHeightRequest struct {
+	ChainId     uint64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *AttestationsByBlockHeightRequest) Reset()         { *m = AttestationsByBlockHeightRequest{} }
+func (m *AttestationsByBlockHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByBlockHeightRequest) ProtoMessage()    {}
+func (*AttestationsByBlockHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{10}
+}
+func (m *AttestationsByBlockHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByBlockHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByBlockHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationsByBlockHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByBlockHeightRequest.Merge(m, src)
+}
+func (m *AttestationsByBlockHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByBlockHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByBlockHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationsByBlockHeightRequest proto.InternalMessageInfo
+
+func (m *AttestationsByBlockHeightRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *AttestationsByBlockHeightRequest) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type AttestationsByBlockHeightResponse struct {
+	Attestations []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+}
+
+func (m *AttestationsByBlockHeightResponse) Reset()         { *m = AttestationsByBlockHeightResponse{} }
+func (m *AttestationsByBlockHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationsByBlockHeightResponse) ProtoMessage()    {}
+func (*AttestationsByBlockHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{11}
+}
+func (m *AttestationsByBlockHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationsByBlockHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationsByBlockHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationsByBlockHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationsByBlockHeightResponse.Merge(m, src)
+}
+func (m *AttestationsByBlockHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationsByBlockHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationsByBlockHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationsByBlockHeightResponse proto.InternalMessageInfo
+
+func (m *AttestationsByBlockHeightResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type DoubleSignsRequest struct {
+	ValidatorAddress []byte             `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *DoubleSignsRequest) Reset()         { *m = DoubleSignsRequest{} }
+func (m *DoubleSignsRequest) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignsRequest) ProtoMessage()    {}
+func (*DoubleSignsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{12}
+}
+func (m *DoubleSignsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignsRequest.Merge(m, src)
+}
+func (m *DoubleSignsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignsRequest proto.InternalMessageInfo
+
+func (m *DoubleSignsRequest) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *DoubleSignsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type DoubleSignsResponse struct {
+	DoubleSigns []*DoubleSign       `protobuf:"bytes,1,rep,name=double_signs,json=doubleSigns,proto3" json:"double_signs,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *DoubleSignsResponse) Reset()         { *m = DoubleSignsResponse{} }
+func (m *DoubleSignsResponse) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignsResponse) ProtoMessage()    {}
+func (*DoubleSignsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{13}
+}
+func (m *DoubleSignsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSignsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSignsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSignsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignsResponse.Merge(m, src)
+}
+func (m *DoubleSignsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSignsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignsResponse proto.InternalMessageInfo
+
+func (m *DoubleSignsResponse) GetDoubleSigns() []*DoubleSign {
+	if m != nil {
+		return m.DoubleSigns
+	}
+	return nil
+}
+
+func (m *DoubleSignsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// DoubleSign is the evidence of a validator signing conflicting attestation roots for the same attest header.
+type DoubleSign struct {
+	ValidatorAddress           []byte `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ChainId                    uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ConfLevel                  uint32 `protobuf:"varint,3,opt,name=conf_level,json=confLevel,proto3" json:"conf_level,omitempty"`
+	AttestOffset               uint64 `protobuf:"varint,4,opt,name=attest_offset,json=attestOffset,proto3" json:"attest_offset,omitempty"`
+	AttestationRoot            []byte `protobuf:"bytes,5,opt,name=attestation_root,json=attestationRoot,proto3" json:"attestation_root,omitempty"`
+	Signature                  []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	ConflictingAttestationRoot []byte `protobuf:"bytes,7,opt,name=conflicting_attestation_root,json=conflictingAttestationRoot,proto3" json:"conflicting_attestation_root,omitempty"`
+	ConflictingSignature       []byte `protobuf:"bytes,8,opt,name=conflicting_signature,json=conflictingSignature,proto3" json:"conflicting_signature,omitempty"`
+	CreatedHeight              uint64 `protobuf:"varint,9,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Slashed                    bool   `protobuf:"varint,10,opt,name=slashed,proto3" json:"slashed,omitempty"`
+}
+
+func (m *DoubleSign) Reset()         { *m = DoubleSign{} }
+func (m *DoubleSign) String() string { return proto.CompactTextString(m) }
+func (*DoubleSign) ProtoMessage()    {}
+func (*DoubleSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d3f1745081aabb, []int{14}
+}
+func (m *DoubleSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DoubleSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DoubleSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DoubleSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSign.Merge(m, src)
+}
+func (m *DoubleSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *DoubleSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSign proto.InternalMessageInfo
+
+func (m *DoubleSign) GetValidatorAddress() []byte {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *DoubleSign) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *DoubleSign) GetConfLevel() uint32 {
+	if m != nil {
+		return m.ConfLevel
+	}
+	return 0
+}
+
+func (m *DoubleSign) GetAttestOffset() uint64 {
+	if m != nil {
+		return m.AttestOffset
+	}
+	return 0
+}
+
+func (m *DoubleSign) GetAttestationRoot() []byte {
+	if m != nil {
+		return m.AttestationRoot
+	}
+	return nil
+}
+
+func (m *DoubleSign) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *DoubleSign) GetConflictingAttestationRoot() []byte {
+	if m != nil {
+		return m.ConflictingAttestationRoot
+	}
+	return nil
+}
+
+func (m *DoubleSign) GetConflictingSignature() []byte {
+	if m != nil {
+		return m.ConflictingSignature
+	}
+	return nil
+}
+
+func (m *DoubleSign) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *DoubleSign) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*AttestationsFromRequest)(nil), "halo.attest.types.AttestationsFromRequest")
 	proto.RegisterType((*AttestationsFromResponse)(nil), "halo.attest.types.AttestationsFromResponse")
@@ -553,43 +871,69 @@ func init() {
 	proto.RegisterType((*ListAllAttestationsResponse)(nil), "halo.attest.types.ListAllAttestationsResponse")
 	proto.RegisterType((*WindowCompareRequest)(nil), "halo.attest.types.WindowCompareRequest")
 	proto.RegisterType((*WindowCompareResponse)(nil), "halo.attest.types.WindowCompareResponse")
+	proto.RegisterType((*AttestationsByBlockHeightRequest)(nil), "halo.attest.types.AttestationsByBlockHeightRequest")
+	proto.RegisterType((*AttestationsByBlockHeightResponse)(nil), "halo.attest.types.AttestationsByBlockHeightResponse")
+	proto.RegisterType((*DoubleSignsRequest)(nil), "halo.attest.types.DoubleSignsRequest")
+	proto.RegisterType((*DoubleSignsResponse)(nil), "halo.attest.types.DoubleSignsResponse")
+	proto.RegisterType((*DoubleSign)(nil), "halo.attest.types.DoubleSign")
 }
 
 func init() { proto.RegisterFile("halo/attest/types/query.proto", fileDescriptor_93d3f1745081aabb) }
 
 var fileDescriptor_93d3f1745081aabb = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x8e, 0x69, 0x37, 0xe0, 0x94, 0x4a, 0xab, 0xf9, 0xcb, 0x3c, 0x2d, 0x54, 0xe1, 0x82, 0xc2,
-	0x20, 0x95, 0xc6, 0x0b, 0xb0, 0x21, 0x90, 0x90, 0x2a, 0x21, 0x22, 0x04, 0x12, 0x12, 0x54, 0xa6,
-	0x75, 0xb5, 0x48, 0x49, 0x9c, 0xc5, 0xee, 0x60, 0x4f, 0x01, 0x4f, 0xc4, 0x35, 0x97, 0xbb, 0xe4,
-	0x12, 0xb5, 0x2f, 0x82, 0xec, 0x84, 0xc9, 0x6d, 0xdc, 0xb5, 0x52, 0x7b, 0x17, 0x9f, 0x9f, 0xef,
-	0xfb, 0x8e, 0xf2, 0x1d, 0x1b, 0xf6, 0x4f, 0x68, 0xcc, 0xbb, 0x54, 0x4a, 0x26, 0x64, 0x57, 0x9e,
-	0x67, 0x4c, 0x74, 0x4f, 0xc7, 0x2c, 0x3f, 0x0f, 0xb2, 0x9c, 0x4b, 0x8e, 0x5b, 0x2a, 0x1d, 0x14,
-	0xe9, 0x40, 0xa7, 0x09, 0xa9, 0x76, 0xc8, 0xef, 0x45, 0xb9, 0x2f, 0xe1, 0xfe, 0x91, 0x4e, 0x50,
-	0x19, 0xf1, 0x54, 0xbc, 0xce, 0x79, 0x12, 0xb2, 0xd3, 0x31, 0x13, 0x12, 0xef, 0xc2, 0x8d, 0xc1,
-	0x09, 0x8d, 0xd2, 0x7e, 0x34, 0x74, 0x51, 0x1b, 0x75, 0xea, 0xe1, 0x75, 0x7d, 0x7e, 0x33, 0xc4,
-	0xfb, 0x00, 0x03, 0x9e, 0x8e, 0xfa, 0x31, 0x3b, 0x63, 0xb1, 0x7b, 0xad, 0x8d, 0x3a, 0xcd, 0xf0,
-	0xa6, 0x8a, 0xf4, 0x54, 0x00, 0x3f, 0x80, 0xc6, 0x28, 0xe7, 0x49, 0x9f, 0x8f, 0x46, 0x82, 0x49,
-	0xb7, 0xa6, 0x9b, 0x41, 0x85, 0xde, 0xea, 0x88, 0xff, 0x05, 0xdc, 0x2a, 0xab, 0xc8, 0x78, 0x2a,
-	0x18, 0x3e, 0x86, 0x5b, 0xd4, 0xc8, 0xb9, 0xa8, 0x5d, 0xeb, 0x34, 0x0e, 0xbd, 0xa0, 0x32, 0x57,
-	0x60, 0x40, 0x84, 0x33, 0x3d, 0xfe, 0x7b, 0x70, 0x7b, 0x54, 0x9d, 0xcd, 0x92, 0x75, 0xc7, 0xf2,
-	0x3f, 0xc3, 0xae, 0x05, 0xb5, 0x94, 0xfd, 0x02, 0x1a, 0x86, 0x04, 0x8d, 0xbc, 0x5c, 0xb5, 0xd9,
-	0xe2, 0x7f, 0x00, 0xf2, 0x8a, 0xe6, 0x71, 0xb4, 0x69, 0xd9, 0x7d, 0xd8, 0xb3, 0xe2, 0x6e, 0x4c,
-	0xf8, 0x0f, 0x04, 0xa4, 0x17, 0x09, 0x79, 0x14, 0xc7, 0xe6, 0x5f, 0x5d, 0xdf, 0x47, 0xf7, 0x60,
-	0x5b, 0x81, 0x8d, 0x85, 0xb6, 0x50, 0x33, 0x2c, 0x4f, 0xf3, 0xfe, 0xaa, 0x57, 0xfc, 0x45, 0x61,
-	0xcf, 0x2a, 0x68, 0x83, 0x16, 0x1b, 0xc3, 0x9d, 0x8f, 0x51, 0x3a, 0xe4, 0xdf, 0x5e, 0xf2, 0x24,
-	0xa3, 0x39, 0x5b, 0x7f, 0xda, 0x87, 0xd0, 0x2c, 0x18, 0x66, 0xf7, 0xa6, 0xa4, 0x2d, 0x27, 0x7b,
-	0x0c, 0x77, 0xe7, 0x68, 0xcb, 0x99, 0x76, 0xa0, 0x36, 0x48, 0x32, 0x4d, 0xb9, 0x15, 0xaa, 0xcf,
-	0xc3, 0x5f, 0x75, 0xd8, 0x7a, 0xa7, 0x6e, 0x06, 0x9c, 0xc0, 0xce, 0xfc, 0xba, 0xe1, 0x27, 0x57,
-	0x4f, 0x6b, 0xde, 0x04, 0xe4, 0x60, 0xa5, 0xda, 0x42, 0x88, 0xef, 0xe0, 0x0c, 0x5a, 0x95, 0x3d,
-	0xc1, 0x36, 0x8c, 0x45, 0x3b, 0x4a, 0x9e, 0xae, 0x56, 0x7c, 0xc9, 0x78, 0x06, 0xb7, 0x2d, 0x16,
-	0xc7, 0xcf, 0x2c, 0x30, 0x8b, 0x57, 0x8c, 0x04, 0xab, 0x96, 0x9b, 0xbc, 0x16, 0x9f, 0x59, 0x79,
-	0x17, 0x2f, 0x88, 0x95, 0xf7, 0x0a, 0xfb, 0xfa, 0x0e, 0x1e, 0x42, 0x73, 0xc6, 0x05, 0xf8, 0x91,
-	0x05, 0xc2, 0x66, 0x4f, 0xd2, 0x59, 0x5e, 0xf8, 0x9f, 0xe5, 0xf8, 0xe0, 0xf7, 0xc4, 0x43, 0x17,
-	0x13, 0x0f, 0xfd, 0x9d, 0x78, 0xe8, 0xe7, 0xd4, 0x73, 0x2e, 0xa6, 0x9e, 0xf3, 0x67, 0xea, 0x39,
-	0x9f, 0x5a, 0x95, 0x17, 0xe5, 0xeb, 0xb6, 0x7e, 0x4f, 0x9e, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff,
-	0x0a, 0xd8, 0xf1, 0x12, 0x9f, 0x06, 0x00, 0x00,
+	// 832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x73, 0xea, 0x44,
+	0x14, 0x27, 0x17, 0x4a, 0xdb, 0x03, 0x28, 0xec, 0xbd, 0x57, 0xd3, 0xdc, 0x5b, 0xe4, 0xc6, 0x69,
+	0x4b, 0x5b, 0x0d, 0xd3, 0xd6, 0x0f, 0x50, 0x50, 0xab, 0xce, 0x74, 0x46, 0x4d, 0x1d, 0x9d, 0x71,
+	0xc6, 0xc6, 0x85, 0x2c, 0x90, 0x31, 0xb0, 0x34, 0xbb, 0xa0, 0xfd, 0x06, 0xfa, 0xa4, 0xaf, 0xbe,
+	0xf9, 0xea, 0x37, 0xf1, 0xb1, 0x8f, 0x3e, 0x3a, 0xed, 0x17, 0x71, 0xb2, 0x81, 0x64, 0x21, 0xe1,
+	0x4f, 0xa7, 0xbc, 0x91, 0xf3, 0xe7, 0xf7, 0xfb, 0x9d, 0xdd, 0xb3, 0xe7, 0x00, 0xbb, 0x5d, 0xec,
+	0xd2, 0x1a, 0xe6, 0x9c, 0x30, 0x5e, 0xe3, 0xb7, 0x03, 0xc2, 0x6a, 0x37, 0x43, 0xe2, 0xdd, 0x1a,
+	0x03, 0x8f, 0x72, 0x8a, 0x4a, 0xbe, 0xdb, 0x08, 0xdc, 0x86, 0x70, 0x6b, 0x47, 0x2d, 0xca, 0x7a,
+	0x94, 0xd5, 0x9a, 0x98, 0x91, 0x20, 0xb6, 0x36, 0x3a, 0x69, 0x12, 0x8e, 0x4f, 0x6a, 0x03, 0xdc,
+	0x71, 0xfa, 0x98, 0x3b, 0xb4, 0x1f, 0xa4, 0x6b, 0x5a, 0x1c, 0x9d, 0xff, 0x12, 0xf8, 0x74, 0x0e,
+	0xef, 0xd6, 0x85, 0x43, 0x24, 0xb0, 0x0b, 0x8f, 0xf6, 0x4c, 0x72, 0x33, 0x24, 0x8c, 0xa3, 0x1d,
+	0xd8, 0x6a, 0x75, 0xb1, 0xd3, 0xb7, 0x1c, 0x5b, 0x55, 0x2a, 0x4a, 0x35, 0x63, 0x6e, 0x8a, 0xef,
+	0x2f, 0x6c, 0xb4, 0x0b, 0xd0, 0xa2, 0xfd, 0xb6, 0xe5, 0x92, 0x11, 0x71, 0xd5, 0x67, 0x15, 0xa5,
+	0x5a, 0x30, 0xb7, 0x7d, 0xcb, 0xa5, 0x6f, 0x40, 0xef, 0x41, 0xae, 0xed, 0xd1, 0x9e, 0x45, 0xdb,
+	0x6d, 0x46, 0xb8, 0x9a, 0x16, 0xc9, 0xe0, 0x9b, 0xbe, 0x14, 0x16, 0xfd, 0x1a, 0xd4, 0x38, 0x2b,
+	0x1b, 0xd0, 0x3e, 0x23, 0xa8, 0x01, 0x79, 0x2c, 0xf9, 0x54, 0xa5, 0x92, 0xae, 0xe6, 0x4e, 0xcb,
+	0x46, 0xec, 0x0c, 0x0c, 0x09, 0xc2, 0x9c, 0xca, 0xd1, 0xbf, 0x01, 0xf5, 0x12, 0xfb, 0xdf, 0x72,
+	0xc8, 0x53, 0xcb, 0xd2, 0x7f, 0x80, 0x9d, 0x04, 0xd4, 0xb1, 0xec, 0x73, 0xc8, 0x49, 0x12, 0x04,
+	0xf2, 0x72, 0xd5, 0x72, 0x8a, 0xfe, 0x2d, 0x68, 0x9f, 0x62, 0xcf, 0x75, 0xd6, 0x2d, 0xdb, 0x82,
+	0x57, 0x89, 0xb8, 0x6b, 0x13, 0xfe, 0xbb, 0x02, 0xda, 0xa5, 0xc3, 0x78, 0xdd, 0x75, 0xe5, 0x5b,
+	0x7d, 0x7a, 0x1f, 0xbd, 0x03, 0x59, 0x1f, 0x6c, 0xc8, 0x44, 0x0b, 0x15, 0xcc, 0xf1, 0xd7, 0x6c,
+	0x7f, 0x65, 0x62, 0xfd, 0x85, 0xe1, 0x55, 0xa2, 0xa0, 0x35, 0xb6, 0xd8, 0x10, 0x5e, 0x7c, 0xe7,
+	0xf4, 0x6d, 0xfa, 0xf3, 0xc7, 0xb4, 0x37, 0xc0, 0x1e, 0x79, 0x7a, 0xb5, 0xef, 0x43, 0x21, 0x60,
+	0x98, 0x7e, 0x37, 0x63, 0xda, 0x71, 0x65, 0x87, 0xf0, 0x72, 0x86, 0x76, 0x5c, 0x53, 0x11, 0xd2,
+	0xad, 0xde, 0x40, 0x50, 0x6e, 0x98, 0xfe, 0x4f, 0xfd, 0x47, 0xa8, 0xc8, 0xd5, 0x37, 0x6e, 0x1b,
+	0x2e, 0x6d, 0xfd, 0xf4, 0x39, 0x71, 0x3a, 0x5d, 0xbe, 0x82, 0xda, 0x37, 0x90, 0x6f, 0xfa, 0x09,
+	0x56, 0x57, 0x64, 0x08, 0xbd, 0x19, 0x33, 0xd7, 0x8c, 0x40, 0xf4, 0x0e, 0xbc, 0x59, 0xc0, 0xb0,
+	0xc6, 0xc3, 0xfe, 0x4d, 0x01, 0xf4, 0x09, 0x1d, 0x36, 0x5d, 0x72, 0xe5, 0x74, 0xa2, 0xce, 0x3a,
+	0x86, 0xd2, 0x08, 0xbb, 0x8e, 0x8d, 0x39, 0xf5, 0x2c, 0x6c, 0xdb, 0x1e, 0x61, 0x4c, 0x94, 0x91,
+	0x37, 0x8b, 0xa1, 0xa3, 0x1e, 0xd8, 0xd1, 0x05, 0x40, 0x34, 0x19, 0x45, 0x35, 0xb9, 0xd3, 0x7d,
+	0x23, 0x18, 0xa3, 0x86, 0x3f, 0x46, 0x8d, 0x60, 0xe4, 0x8e, 0xc7, 0xa8, 0xf1, 0x15, 0xee, 0x4c,
+	0x2e, 0xd5, 0x94, 0x32, 0xf5, 0xbf, 0x14, 0x78, 0x3e, 0xa5, 0x25, 0x7c, 0x47, 0x79, 0x5b, 0x98,
+	0x2d, 0xe6, 0x74, 0xc2, 0x3a, 0x77, 0x13, 0xea, 0x8c, 0xb2, 0xcd, 0x9c, 0x1d, 0x21, 0xa1, 0xcf,
+	0x12, 0x14, 0x1e, 0x2c, 0x55, 0x18, 0xd0, 0x4f, 0x49, 0xfc, 0x33, 0x0d, 0x10, 0x91, 0x3c, 0xee,
+	0x98, 0xe4, 0x8e, 0x78, 0xb6, 0xa8, 0x7f, 0xd3, 0x4b, 0xfb, 0x37, 0x13, 0xef, 0x5f, 0x74, 0x08,
+	0x45, 0xe9, 0x66, 0x2d, 0x8f, 0x52, 0xae, 0x6e, 0x08, 0x29, 0x6f, 0x4b, 0x76, 0x93, 0x52, 0x8e,
+	0x5e, 0xc3, 0xb6, 0x7f, 0x92, 0x98, 0x0f, 0x3d, 0xa2, 0x66, 0x45, 0x4c, 0x64, 0x40, 0xe7, 0xf0,
+	0xda, 0xa7, 0x76, 0x9d, 0x16, 0x77, 0xfa, 0x1d, 0x2b, 0x06, 0xba, 0x29, 0x12, 0x34, 0x29, 0xa6,
+	0x3e, 0x83, 0x7f, 0x06, 0x2f, 0x65, 0x84, 0x88, 0x6b, 0x4b, 0xa4, 0xbe, 0x90, 0x9c, 0x57, 0x21,
+	0xed, 0x1e, 0xbc, 0xd5, 0xf2, 0x08, 0xe6, 0xc4, 0x9e, 0xbc, 0x8b, 0x6d, 0x51, 0x65, 0x61, 0x6c,
+	0x0d, 0x9a, 0x1f, 0xa9, 0xb0, 0xc9, 0x5c, 0xcc, 0xba, 0xc4, 0x56, 0xa1, 0xa2, 0x54, 0xb7, 0xcc,
+	0xc9, 0xe7, 0xe9, 0xdf, 0x59, 0xd8, 0xf8, 0xda, 0xbf, 0x46, 0xd4, 0x83, 0xe2, 0xec, 0x12, 0x44,
+	0x47, 0x8b, 0x9f, 0x85, 0xbc, 0x9f, 0xb5, 0xe3, 0x95, 0x62, 0x83, 0xf6, 0xd0, 0x53, 0x68, 0x00,
+	0xa5, 0xd8, 0xf6, 0x42, 0x49, 0x18, 0xf3, 0x36, 0xa7, 0xf6, 0xc1, 0x6a, 0xc1, 0x21, 0xe3, 0x08,
+	0x9e, 0x27, 0x2c, 0x1e, 0xf4, 0x61, 0x02, 0xcc, 0xfc, 0xc5, 0xa7, 0x19, 0xab, 0x86, 0xcb, 0xbc,
+	0x09, 0xd3, 0x3f, 0x91, 0x77, 0xfe, 0xda, 0xd2, 0x8c, 0x55, 0xc3, 0x43, 0x5e, 0x1b, 0x0a, 0x53,
+	0xb3, 0x19, 0x1d, 0x24, 0x40, 0x24, 0x2d, 0x0d, 0xad, 0xba, 0x3c, 0x30, 0x64, 0xf9, 0x55, 0x81,
+	0x9d, 0xb9, 0x53, 0x17, 0x9d, 0x2d, 0x69, 0x8a, 0xa4, 0x2d, 0xa0, 0x7d, 0xf4, 0xb8, 0xa4, 0x50,
+	0xca, 0x35, 0xe4, 0xa4, 0x49, 0x88, 0xf6, 0x16, 0xce, 0xba, 0xf0, 0x60, 0xf7, 0x97, 0x85, 0x4d,
+	0xf0, 0x1b, 0xc7, 0xff, 0xdc, 0x97, 0x95, 0xbb, 0xfb, 0xb2, 0xf2, 0xdf, 0x7d, 0x59, 0xf9, 0xe3,
+	0xa1, 0x9c, 0xba, 0x7b, 0x28, 0xa7, 0xfe, 0x7d, 0x28, 0xa7, 0xbe, 0x2f, 0xc5, 0xfe, 0xd2, 0x36,
+	0xb3, 0xe2, 0x0f, 0xed, 0xd9, 0xff, 0x03, 0x00, 0x6f, 0x04, 0xfa, 0x1f, 0x4c, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -618,6 +962,11 @@ type QueryClient interface {
 	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
 	// The vote window is a configured number of blocks around the latest approved attestation.
 	WindowCompare(ctx context.Context, in *WindowCompareRequest, opts ...grpc.CallOption) (*WindowCompareResponse, error)
+	// AttestationsByBlockHeight queries halo for the approved attestations of the given source chain block height.
+	// The response contains one attestation per confirmation level still in consensus chain state.
+	AttestationsByBlockHeight(ctx context.Context, in *AttestationsByBlockHeightRequest, opts ...grpc.CallOption) (*AttestationsByBlockHeightResponse, error)
+	// DoubleSigns queries halo for a page of attestation double sign evidence, optionally filtered by validator.
+	DoubleSigns(ctx context.Context, in *DoubleSignsRequest, opts ...grpc.CallOption) (*DoubleSignsResponse, error)
 }
 
 type queryClient struct {
@@ -673,6 +1022,24 @@ func (c *queryClient) WindowCompare(ctx context.Context, in *WindowCompareReques
 	return out, nil
 }
 
+func (c *queryClient) AttestationsByBlockHeight(ctx context.Context, in *AttestationsByBlockHeightRequest, opts ...grpc.CallOption) (*AttestationsByBlockHeightResponse, error) {
+	out := new(AttestationsByBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/AttestationsByBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DoubleSigns(ctx context.Context, in *DoubleSignsRequest, opts ...grpc.CallOption) (*DoubleSignsResponse, error) {
+	out := new(DoubleSignsResponse)
+	err := c.cc.Invoke(ctx, "/halo.attest.types.Query/DoubleSigns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// AttestationsFrom queries halo for approved attestations for the given chain_id
@@ -689,6 +1056,11 @@ type QueryServer interface {
 	// It returns whether the request is behind (-1), or in (0), or after (1) the vote window.
 	// The vote window is a configured number of blocks around the latest approved attestation.
 	WindowCompare(context.Context, *WindowCompareRequest) (*WindowCompareResponse, error)
+	// AttestationsByBlockHeight queries halo for the approved attestations of the given source chain block height.
+	// The response contains one attestation per confirmation level still in consensus chain state.
+	AttestationsByBlockHeight(context.Context, *AttestationsByBlockHeightRequest) (*AttestationsByBlockHeightResponse, error)
+	// DoubleSigns queries halo for a page of attestation double sign evidence, optionally filtered by validator.
+	DoubleSigns(context.Context, *DoubleSignsRequest) (*DoubleSignsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -710,6 +1082,12 @@ func (*UnimplementedQueryServer) ListAllAttestations(ctx context.Context, req *L
 func (*UnimplementedQueryServer) WindowCompare(ctx context.Context, req *WindowCompareRequest) (*WindowCompareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WindowCompare not implemented")
 }
+func (*UnimplementedQueryServer) AttestationsByBlockHeight(ctx context.Context, req *AttestationsByBlockHeightRequest) (*AttestationsByBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) DoubleSigns(ctx context.Context, req *DoubleSignsRequest) (*DoubleSignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoubleSigns not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -805,6 +1183,42 @@ func _Query_WindowCompare_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttestationsByBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationsByBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/AttestationsByBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByBlockHeight(ctx, req.(*AttestationsByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DoubleSigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoubleSignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DoubleSigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/halo.attest.types.Query/DoubleSigns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DoubleSigns(ctx, req.(*DoubleSignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "halo.attest.types.Query",
@@ -830,6 +1244,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WindowCompare",
 			Handler:    _Query_WindowCompare_Handler,
 		},
+		{
+			MethodName: "AttestationsByBlockHeight",
+			Handler:    _Query_AttestationsByBlockHeight_Handler,
+		},
+		{
+			MethodName: "DoubleSigns",
+			Handler:    _Query_DoubleSigns_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "halo/attest/types/query.proto",
@@ -1192,19 +1614,268 @@ func (m *WindowCompareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *AttestationsByBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AttestationsFromRequest) Size() (n int) {
-	if m == nil {
+
+func (m *AttestationsByBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationsByBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationsByBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationsByBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSignsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSignsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSignsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSignsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DoubleSigns) > 0 {
+		for iNdEx := len(m.DoubleSigns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DoubleSigns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DoubleSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DoubleSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DoubleSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Slashed {
+		i--
+		if m.Slashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConflictingSignature) > 0 {
+		i -= len(m.ConflictingSignature)
+		copy(dAtA[i:], m.ConflictingSignature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConflictingSignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ConflictingAttestationRoot) > 0 {
+		i -= len(m.ConflictingAttestationRoot)
+		copy(dAtA[i:], m.ConflictingAttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConflictingAttestationRoot)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AttestationRoot) > 0 {
+		i -= len(m.AttestationRoot)
+		copy(dAtA[i:], m.AttestationRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AttestOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ConfLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttestationsFromRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1325,46 +1996,905 @@ func (m *ListAllAttestationsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *WindowCompareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	return n
+}
+
+func (m *WindowCompareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cmp != 0 {
+		n += 1 + sovQuery(uint64(m.Cmp))
+	}
+	return n
+}
+
+func (m *AttestationsByBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *AttestationsByBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DoubleSignsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DoubleSignsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DoubleSigns) > 0 {
+		for _, e := range m.DoubleSigns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DoubleSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.ConfLevel != 0 {
+		n += 1 + sovQuery(uint64(m.ConfLevel))
+	}
+	if m.AttestOffset != 0 {
+		n += 1 + sovQuery(uint64(m.AttestOffset))
+	}
+	l = len(m.AttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConflictingAttestationRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConflictingSignature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedHeight))
+	}
+	if m.Slashed {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsFromRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			}
+			m.FromOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationsFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationsFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EarliestAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EarliestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAllAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAllAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			}
+			m.ConfLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfLevel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			}
+			m.FromOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromOffset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *WindowCompareRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.ConfLevel != 0 {
-		n += 1 + sovQuery(uint64(m.ConfLevel))
-	}
-	if m.AttestOffset != 0 {
-		n += 1 + sovQuery(uint64(m.AttestOffset))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *WindowCompareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Cmp != 0 {
-		n += 1 + sovQuery(uint64(m.Cmp))
+func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAllAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAllAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
+func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1387,10 +2917,10 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationsFromRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowCompareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationsFromRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1433,9 +2963,9 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
 			}
-			m.FromOffset = 0
+			m.AttestOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1445,7 +2975,7 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromOffset |= uint64(b&0x7F) << shift
+				m.AttestOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1471,7 +3001,7 @@ func (m *AttestationsFromRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
+func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1494,17 +3024,17 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationsFromResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WindowCompareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationsFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WindowCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
 			}
-			var msglen int
+			m.Cmp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1514,26 +3044,11 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Cmp |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1555,7 +3070,7 @@ func (m *AttestationsFromResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *AttestationsByBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,10 +3093,10 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestAttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationsByBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationsByBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1605,9 +3120,9 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.ConfLevel = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1617,7 +3132,7 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1643,7 +3158,7 @@ func (m *LatestAttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
+func (m *AttestationsByBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1666,15 +3181,15 @@ func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LatestAttestationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationsByBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LatestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationsByBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1701,10 +3216,8 @@ func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &Attestation{}
-			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1729,7 +3242,7 @@ func (m *LatestAttestationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *DoubleSignsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,17 +3265,17 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EarliestAttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSignsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EarliestAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSignsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.ChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1772,16 +3285,31 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ConfLevel = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1791,11 +3319,28 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1817,7 +3362,7 @@ func (m *EarliestAttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
+func (m *DoubleSignsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1840,15 +3385,49 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EarliestAttestationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSignsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EarliestAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSignsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSigns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DoubleSigns = append(m.DoubleSigns, &DoubleSign{})
+			if err := m.DoubleSigns[len(m.DoubleSigns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1875,10 +3454,10 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Attestation == nil {
-				m.Attestation = &Attestation{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1903,7 +3482,7 @@ func (m *EarliestAttestationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
+func (m *DoubleSign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1926,13 +3505,47 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAllAttestationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DoubleSign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAllAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DoubleSign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -1951,7 +3564,7 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
 			}
@@ -1970,11 +3583,11 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
 			}
-			m.Status = 0
+			m.AttestOffset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1984,16 +3597,16 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= uint32(b&0x7F) << shift
+				m.AttestOffset |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRoot", wireType)
 			}
-			m.FromOffset = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2003,66 +3616,31 @@ func (m *ListAllAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromOffset |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AttestationRoot = append(m.AttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationRoot == nil {
+				m.AttestationRoot = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListAllAttestationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAllAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2072,81 +3650,31 @@ func (m *ListAllAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowCompareRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowCompareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingAttestationRoot", wireType)
 			}
-			m.ChainId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2156,16 +3684,31 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfLevel", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ConfLevel = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingAttestationRoot = append(m.ConflictingAttestationRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingAttestationRoot == nil {
+				m.ConflictingAttestationRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingSignature", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2175,16 +3718,31 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfLevel |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConflictingSignature = append(m.ConflictingSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConflictingSignature == nil {
+				m.ConflictingSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			m.AttestOffset = 0
+			m.CreatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2194,66 +3752,16 @@ func (m *WindowCompareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttestOffset |= uint64(b&0x7F) << shift
+				m.CreatedHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowCompareResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowCompareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			m.Cmp = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2263,11 +3771,12 @@ func (m *WindowCompareResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Cmp |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Slashed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ import (
 
 	"github.com/omni-network/omni/halo/app"
 	halocfg "github.com/omni-network/omni/halo/config"
+	"github.com/omni-network/omni/halo/genutil"
 	"github.com/omni-network/omni/lib/buildinfo"
 	libcmd "github.com/omni-network/omni/lib/cmd"
 	"github.com/omni-network/omni/lib/errors"
@@ -25,6 +26,7 @@ func New() *cobra.Command {
 		newRunCmd("run", app.Run),
 		newInitCmd(),
 		newRollbackCmd(),
+		newExportCmd(),
 		buildinfo.NewVersionCmd(),
 		newConsKeyCmd(),
 		newStatusCmd(),
@@ -114,6 +116,67 @@ different block N cannot be re-built/re-proposed since that would result in vali
 	return cmd
 }
 
+func newExportCmd() *cobra.Command {
+	logCfg := log.DefaultConfig()
+	haloCfg := halocfg.DefaultConfig()
+	exportCfg := app.DefaultExportConfig()
+	output := "exported_genesis.json"
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export Cosmos SDK application state as a consensus genesis file",
+		Long: `
+Export the application state of all modules and the validator set as a consensus genesis file.
+The exported genesis resumes the chain at the height after the exported state, which is useful
+for hard-fork restarts, auditing or seeding new networks. Halo must be stopped while exporting.
+Note that the evmengine execution block hash must match the execution chain the new network starts from.
+Exporting only reads the application database, neither the execution client nor the validator key is required.
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx, err := log.Init(cmd.Context(), logCfg)
+			if err != nil {
+				return err
+			}
+			if err := libcmd.LogFlags(ctx, cmd.Flags()); err != nil {
+				return err
+			}
+
+			cmtCfg, err := parseCometConfig(ctx, haloCfg.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			appCfg := app.Config{
+				Config: haloCfg,
+				Comet:  cmtCfg,
+			}
+
+			appGen, err := app.Export(ctx, appCfg, exportCfg)
+			if err != nil {
+				return err
+			}
+
+			if err := genutil.ValidateGenesis(ctx, haloCfg.Network, appGen); err != nil {
+				return errors.Wrap(err, "validate exported genesis")
+			}
+
+			if err := appGen.SaveAs(output); err != nil {
+				return errors.Wrap(err, "save exported genesis")
+			}
+
+			log.Info(ctx, "Exported genesis file", "file", output, "initial_height", appGen.InitialHeight)
+
+			return nil
+		},
+	}
+
+	bindRunFlags(cmd, &haloCfg)
+	bindExportFlags(cmd.Flags(), &exportCfg, &output)
+	log.BindFlags(cmd.Flags(), &logCfg)
+
+	return cmd
+}
+
 func newConsKeyCmd() *cobra.Command {
 	home := halocfg.DefaultConfig().HomeDir
 
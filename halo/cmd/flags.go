@@ -36,6 +36,8 @@ func bindRunFlags(cmd *cobra.Command, cfg *halocfg.Config) {
 	flags.StringVar(&cfg.PruningOption, "pruning", cfg.PruningOption, "Pruning strategy (default|nothing|everything)")
 	flags.DurationVar(&cfg.EVMBuildDelay, "evm-build-delay", cfg.EVMBuildDelay, "Minimum delay between triggering and fetching a EVM payload build")
 	flags.BoolVar(&cfg.EVMBuildOptimistic, "evm-build-optimistic", cfg.EVMBuildOptimistic, "Enables optimistic building of EVM payloads on previous block finalize")
+	flags.Uint64Var(&cfg.XBlockCacheSize, "xblock-cache-size", cfg.XBlockCacheSize, "Maximum number of finalized xblocks cached per chain by the voter. Zero disables the cache")
+	flags.IntVar(&cfg.AttestIndexSize, "attest-index-size", cfg.AttestIndexSize, "Maximum number of approved attestations indexed off-chain and served via gRPC. Zero disables the index")
 	flags.IntSliceVar(&cfg.UnsafeSkipUpgrades, sdkserver.FlagUnsafeSkipUpgrades, cfg.UnsafeSkipUpgrades, "Skip a set of upgrade heights to continue the old binary")
 }
 
@@ -43,6 +45,12 @@ func bindRollbackFlags(flags *pflag.FlagSet, cfg *app.RollbackConfig) {
 	flags.BoolVar(&cfg.RemoveCometBlock, "hard", cfg.RemoveCometBlock, "Remove last block as well as state")
 }
 
+func bindExportFlags(flags *pflag.FlagSet, cfg *app.ExportConfig, output *string) {
+	flags.Int64Var(&cfg.Height, "height", cfg.Height, "Height to export the state of (0 means latest height)")
+	flags.StringSliceVar(&cfg.Modules, "modules", cfg.Modules, "Comma-separated list of modules to export (empty means all modules)")
+	flags.StringVar(output, "output", *output, "Exported genesis file path")
+}
+
 func bindInitFlags(flags *pflag.FlagSet, cfg *InitConfig) {
 	libcmd.BindHomeFlag(flags, &cfg.HomeDir)
 	netconf.BindFlag(flags, &cfg.Network)
@@ -84,6 +84,8 @@ type Config struct {
 	PruningOption      string // See cosmossdk.io/store/pruning/types/options.go
 	EVMBuildDelay      time.Duration
 	EVMBuildOptimistic bool
+	XBlockCacheSize    uint64 // Maximum number of finalized xblocks cached per chain by the voter, zero disables the cache.
+	AttestIndexSize    int    // Maximum number of approved attestations indexed off-chain and served via gRPC, zero disables the index.
 	Tracer             tracer.Config
 	UnsafeSkipUpgrades []int
 	SDKAPI             RPCConfig `mapstructure:"api"`
@@ -4,6 +4,7 @@ package evmslashing
 
 import (
 	"context"
+	"time"
 
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
@@ -22,6 +23,19 @@ import (
 
 const ModuleName = "evmslashing"
 
+// Validator jail and unjail events, keyed by the validator's EVM address like the Slashing predeploy's Unjail event.
+// These are consensus chain (ABCI) events, only observable off-chain via CometBFT block results, e.g. by indexers
+// mapping jailed validators to their EVM addresses. They are not EVM logs: EVM contracts cannot observe them,
+// and no EVM-visible jail signal is provided (out of scope, since the consensus chain cannot emit EVM logs).
+const (
+	EventTypeJail   = "evmslashing_jail"
+	EventTypeUnjail = "evmslashing_unjail"
+
+	AttributeKeyValidator   = "validator"
+	AttributeKeyReason      = "reason"
+	AttributeKeyJailedUntil = "jailed_until"
+)
+
 var _ evmenginetypes.EvmEventProcessor = EventProcessor{}
 
 var (
@@ -94,9 +108,25 @@ func (p EventProcessor) deliverUnjail(ctx context.Context, unjail *bindings.Slas
 		return errors.Wrap(err, "unjail validator")
 	}
 
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeUnjail,
+		sdk.NewAttribute(AttributeKeyValidator, unjail.Validator.Hex()),
+	))
+
 	return nil
 }
 
+// EmitJail emits a consensus chain (ABCI) jail event of the validator (EVM address) for the provided reason.
+// Note that it is not visible to the EVM, see EventTypeJail.
+func EmitJail(ctx context.Context, validator common.Address, reason string, jailedUntil time.Time) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeJail,
+		sdk.NewAttribute(AttributeKeyValidator, validator.Hex()),
+		sdk.NewAttribute(AttributeKeyReason, reason),
+		sdk.NewAttribute(AttributeKeyJailedUntil, jailedUntil.UTC().Format(time.RFC3339)),
+	))
+}
+
 // mustGetABI returns the metadata's ABI as an abi.ABI type.
 // It panics on error.
 func mustGetABI(metadata *bind.MetaData) *abi.ABI {
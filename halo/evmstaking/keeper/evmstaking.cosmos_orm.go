@@ -133,20 +133,163 @@ func NewEVMEventTable(db ormtable.Schema) (EVMEventTable, error) {
 	return evmeventTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type FailedDepositTable interface {
+	Insert(ctx context.Context, failedDeposit *FailedDeposit) error
+	InsertReturningId(ctx context.Context, failedDeposit *FailedDeposit) (uint64, error)
+	LastInsertedSequence(ctx context.Context) (uint64, error)
+	Update(ctx context.Context, failedDeposit *FailedDeposit) error
+	Save(ctx context.Context, failedDeposit *FailedDeposit) error
+	Delete(ctx context.Context, failedDeposit *FailedDeposit) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*FailedDeposit, error)
+	List(ctx context.Context, prefixKey FailedDepositIndexKey, opts ...ormlist.Option) (FailedDepositIterator, error)
+	ListRange(ctx context.Context, from, to FailedDepositIndexKey, opts ...ormlist.Option) (FailedDepositIterator, error)
+	DeleteBy(ctx context.Context, prefixKey FailedDepositIndexKey) error
+	DeleteRange(ctx context.Context, from, to FailedDepositIndexKey) error
+
+	doNotImplement()
+}
+
+type FailedDepositIterator struct {
+	ormtable.Iterator
+}
+
+func (i FailedDepositIterator) Value() (*FailedDeposit, error) {
+	var failedDeposit FailedDeposit
+	err := i.UnmarshalMessage(&failedDeposit)
+	return &failedDeposit, err
+}
+
+type FailedDepositIndexKey interface {
+	id() uint32
+	values() []interface{}
+	failedDepositIndexKey()
+}
+
+// primary key starting index..
+type FailedDepositPrimaryKey = FailedDepositIdIndexKey
+
+type FailedDepositIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x FailedDepositIdIndexKey) id() uint32             { return 0 }
+func (x FailedDepositIdIndexKey) values() []interface{}  { return x.vs }
+func (x FailedDepositIdIndexKey) failedDepositIndexKey() {}
+
+func (this FailedDepositIdIndexKey) WithId(id uint64) FailedDepositIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type FailedDepositDepositorIndexKey struct {
+	vs []interface{}
+}
+
+func (x FailedDepositDepositorIndexKey) id() uint32             { return 1 }
+func (x FailedDepositDepositorIndexKey) values() []interface{}  { return x.vs }
+func (x FailedDepositDepositorIndexKey) failedDepositIndexKey() {}
+
+func (this FailedDepositDepositorIndexKey) WithDepositor(depositor []byte) FailedDepositDepositorIndexKey {
+	this.vs = []interface{}{depositor}
+	return this
+}
+
+type failedDepositTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this failedDepositTable) Insert(ctx context.Context, failedDeposit *FailedDeposit) error {
+	return this.table.Insert(ctx, failedDeposit)
+}
+
+func (this failedDepositTable) Update(ctx context.Context, failedDeposit *FailedDeposit) error {
+	return this.table.Update(ctx, failedDeposit)
+}
+
+func (this failedDepositTable) Save(ctx context.Context, failedDeposit *FailedDeposit) error {
+	return this.table.Save(ctx, failedDeposit)
+}
+
+func (this failedDepositTable) Delete(ctx context.Context, failedDeposit *FailedDeposit) error {
+	return this.table.Delete(ctx, failedDeposit)
+}
+
+func (this failedDepositTable) InsertReturningId(ctx context.Context, failedDeposit *FailedDeposit) (uint64, error) {
+	return this.table.InsertReturningPKey(ctx, failedDeposit)
+}
+
+func (this failedDepositTable) LastInsertedSequence(ctx context.Context) (uint64, error) {
+	return this.table.LastInsertedSequence(ctx)
+}
+
+func (this failedDepositTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this failedDepositTable) Get(ctx context.Context, id uint64) (*FailedDeposit, error) {
+	var failedDeposit FailedDeposit
+	found, err := this.table.PrimaryKey().Get(ctx, &failedDeposit, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &failedDeposit, nil
+}
+
+func (this failedDepositTable) List(ctx context.Context, prefixKey FailedDepositIndexKey, opts ...ormlist.Option) (FailedDepositIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return FailedDepositIterator{it}, err
+}
+
+func (this failedDepositTable) ListRange(ctx context.Context, from, to FailedDepositIndexKey, opts ...ormlist.Option) (FailedDepositIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return FailedDepositIterator{it}, err
+}
+
+func (this failedDepositTable) DeleteBy(ctx context.Context, prefixKey FailedDepositIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this failedDepositTable) DeleteRange(ctx context.Context, from, to FailedDepositIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this failedDepositTable) doNotImplement() {}
+
+var _ FailedDepositTable = failedDepositTable{}
+
+func NewFailedDepositTable(db ormtable.Schema) (FailedDepositTable, error) {
+	table := db.GetTable(&FailedDeposit{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&FailedDeposit{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return failedDepositTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type EvmstakingStore interface {
 	EVMEventTable() EVMEventTable
+	FailedDepositTable() FailedDepositTable
 
 	doNotImplement()
 }
 
 type evmstakingStore struct {
-	evmevent EVMEventTable
+	evmevent      EVMEventTable
+	failedDeposit FailedDepositTable
 }
 
 func (x evmstakingStore) EVMEventTable() EVMEventTable {
 	return x.evmevent
 }
 
+func (x evmstakingStore) FailedDepositTable() FailedDepositTable {
+	return x.failedDeposit
+}
+
 func (evmstakingStore) doNotImplement() {}
 
 var _ EvmstakingStore = evmstakingStore{}
@@ -157,7 +300,13 @@ func NewEvmstakingStore(db ormtable.Schema) (EvmstakingStore, error) {
 		return nil, err
 	}
 
+	failedDepositTable, err := NewFailedDepositTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return evmstakingStore{
 		evmeventTable,
+		failedDepositTable,
 	}, nil
 }
@@ -76,6 +76,116 @@ func (x *EVMEvent) GetEvent() *types.EVMEvent {
 	return nil
 }
 
+// FailedDeposit is an EVM staking deposit (CreateValidator or Delegate event) that failed delivery.
+// Failed deposits are refunded to the depositor's EVM address via a withdrawal.
+type FailedDeposit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Depositor     []byte                 `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`                  // EVM address of the depositor, i.e., the refund recipient (20 bytes).
+	Validator     []byte                 `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`                  // EVM address of the validator (20 bytes).
+	EventName     string                 `protobuf:"bytes,4,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"` // Name of the EVM event; CreateValidator or Delegate.
+	AmountWei     string                 `protobuf:"bytes,5,opt,name=amount_wei,json=amountWei,proto3" json:"amount_wei,omitempty"` // Deposited amount in wei (decimal).
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                        // Failure reason category.
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`                          // Failure error message.
+	Height        int64                  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`                       // Consensus chain height the delivery failed at.
+	Refunded      bool                   `protobuf:"varint,9,opt,name=refunded,proto3" json:"refunded,omitempty"`                   // True if the deposit was refunded.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailedDeposit) Reset() {
+	*x = FailedDeposit{}
+	mi := &file_halo_evmstaking_keeper_evmstaking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedDeposit) ProtoMessage() {}
+
+func (x *FailedDeposit) ProtoReflect() protoreflect.Message {
+	mi := &file_halo_evmstaking_keeper_evmstaking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedDeposit.ProtoReflect.Descriptor instead.
+func (*FailedDeposit) Descriptor() ([]byte, []int) {
+	return file_halo_evmstaking_keeper_evmstaking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FailedDeposit) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FailedDeposit) GetDepositor() []byte {
+	if x != nil {
+		return x.Depositor
+	}
+	return nil
+}
+
+func (x *FailedDeposit) GetValidator() []byte {
+	if x != nil {
+		return x.Validator
+	}
+	return nil
+}
+
+func (x *FailedDeposit) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *FailedDeposit) GetAmountWei() string {
+	if x != nil {
+		return x.AmountWei
+	}
+	return ""
+}
+
+func (x *FailedDeposit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FailedDeposit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FailedDeposit) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *FailedDeposit) GetRefunded() bool {
+	if x != nil {
+		return x.Refunded
+	}
+	return false
+}
+
 var File_halo_evmstaking_keeper_evmstaking_proto protoreflect.FileDescriptor
 
 var file_halo_evmstaking_keeper_evmstaking_proto_rawDesc = string([]byte{
@@ -93,21 +203,39 @@ var file_halo_evmstaking_keeper_evmstaking_proto_rawDesc = string([]byte{
 	0x65, 0x76, 0x6d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x45, 0x56, 0x4d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a,
 	0x10, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x0a, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x18,
-	0x01, 0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x65,
-	0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x42, 0x0f, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e,
-	0x69, 0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x65, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x4b, 0xaa, 0x02,
-	0x16, 0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xca, 0x02, 0x16, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x45,
-	0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0xe2, 0x02, 0x22, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69,
-	0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x45, 0x76,
-	0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x22, 0x9c, 0x02, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x65, 0x69, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x3a,
+	0x1f, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x19, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12,
+	0x0d, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x10, 0x01, 0x18, 0x02,
+	0x42, 0xdc, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x61, 0x6c, 0x6f, 0x2e, 0x65, 0x76,
+	0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x42,
+	0x0f, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f,
+	0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69,
+	0x2f, 0x68, 0x61, 0x6c, 0x6f, 0x2f, 0x65, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67,
+	0x2f, 0x6b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x4b, 0xaa, 0x02, 0x16,
+	0x48, 0x61, 0x6c, 0x6f, 0x2e, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xca, 0x02, 0x16, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x45, 0x76,
+	0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0xe2,
+	0x02, 0x22, 0x48, 0x61, 0x6c, 0x6f, 0x5c, 0x45, 0x76, 0x6d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
+	0x67, 0x5c, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x48, 0x61, 0x6c, 0x6f, 0x3a, 0x3a, 0x45, 0x76, 0x6d,
+	0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x4b, 0x65, 0x65, 0x70, 0x65, 0x72, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -122,13 +250,14 @@ func file_halo_evmstaking_keeper_evmstaking_proto_rawDescGZIP() []byte {
 	return file_halo_evmstaking_keeper_evmstaking_proto_rawDescData
 }
 
-var file_halo_evmstaking_keeper_evmstaking_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_halo_evmstaking_keeper_evmstaking_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_halo_evmstaking_keeper_evmstaking_proto_goTypes = []any{
 	(*EVMEvent)(nil),       // 0: halo.evmstaking.keeper.EVMEvent
-	(*types.EVMEvent)(nil), // 1: octane.evmengine.types.EVMEvent
+	(*FailedDeposit)(nil),  // 1: halo.evmstaking.keeper.FailedDeposit
+	(*types.EVMEvent)(nil), // 2: octane.evmengine.types.EVMEvent
 }
 var file_halo_evmstaking_keeper_evmstaking_proto_depIdxs = []int32{
-	1, // 0: halo.evmstaking.keeper.EVMEvent.event:type_name -> octane.evmengine.types.EVMEvent
+	2, // 0: halo.evmstaking.keeper.EVMEvent.event:type_name -> octane.evmengine.types.EVMEvent
 	1, // [1:1] is the sub-list for method output_type
 	1, // [1:1] is the sub-list for method input_type
 	1, // [1:1] is the sub-list for extension type_name
@@ -147,7 +276,7 @@ func file_halo_evmstaking_keeper_evmstaking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_halo_evmstaking_keeper_evmstaking_proto_rawDesc), len(file_halo_evmstaking_keeper_evmstaking_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
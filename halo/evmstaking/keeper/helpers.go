@@ -46,7 +46,7 @@ func omniToBondCoin(amount *big.Int) (sdk.Coin, sdk.Coins) {
 func catch(fn func() error) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			err = errors.New("recovered", "panic", r)
+			err = errors.Wrap(errPanic, "recovered", "panic", r)
 		}
 	}()
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
 	"cosmossdk.io/orm/model/ormdb"
@@ -28,24 +29,31 @@ var (
 	createValidatorEvent = mustGetEvent(stakingABI, "CreateValidator")
 	delegateEvent        = mustGetEvent(stakingABI, "Delegate")
 	editValidatorEvent   = mustGetEvent(stakingABI, "EditValidator")
+	undelegateEvent      = mustGetEvent(stakingABI, "Undelegate")
+	redelegateEvent      = mustGetEvent(stakingABI, "Redelegate")
 
 	eventsByID = map[common.Hash]abi.Event{
 		createValidatorEvent.ID: createValidatorEvent,
 		delegateEvent.ID:        delegateEvent,
 		editValidatorEvent.ID:   editValidatorEvent,
+		undelegateEvent.ID:      undelegateEvent,
+		redelegateEvent.ID:      redelegateEvent,
 	}
 )
 
 // Keeper also implements the evmenginetypes.EvmEventProcessor interface.
 type Keeper struct {
-	eventsTable     EVMEventTable
-	address         common.Address
-	contract        *bindings.Staking
-	aKeeper         types.AuthKeeper
-	bKeeper         types.WrappedBankKeeper
-	sKeeper         types.StakingKeeper
-	sServer         types.StakingMsgServer
-	deliverInterval int64
+	eventsTable        EVMEventTable
+	failedDepositTable FailedDepositTable
+	genesis            appmodule.HasGenesis
+	address            common.Address
+	contract           *bindings.Staking
+	aKeeper            types.AuthKeeper
+	bKeeper            types.WrappedBankKeeper
+	sKeeper            types.StakingKeeper
+	sServer            types.StakingMsgServer
+	uKeeper            types.UpgradeKeeper
+	deliverInterval    int64
 }
 
 func NewKeeper(
@@ -54,6 +62,7 @@ func NewKeeper(
 	bKeeper types.WrappedBankKeeper,
 	sKeeper types.StakingKeeper,
 	sServer types.StakingMsgServer,
+	uKeeper types.UpgradeKeeper,
 	deliverInterval int64,
 ) (*Keeper, error) {
 	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
@@ -77,14 +86,17 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		eventsTable:     evmstakingStore.EVMEventTable(),
-		aKeeper:         aKeeper,
-		bKeeper:         bKeeper,
-		sKeeper:         sKeeper,
-		sServer:         sServer,
-		address:         address,
-		contract:        contract,
-		deliverInterval: deliverInterval,
+		eventsTable:        evmstakingStore.EVMEventTable(),
+		failedDepositTable: evmstakingStore.FailedDepositTable(),
+		genesis:            modDB.GenesisHandler(),
+		aKeeper:            aKeeper,
+		bKeeper:            bKeeper,
+		sKeeper:            sKeeper,
+		sServer:            sServer,
+		uKeeper:            uKeeper,
+		address:            address,
+		contract:           contract,
+		deliverInterval:    deliverInterval,
 	}, nil
 }
 
@@ -146,13 +158,25 @@ func (Keeper) Name() string {
 }
 
 // FilterParams defines the matching EVM log events, see github.com/ethereum/go-ethereum#FilterQuery.
-func (k Keeper) FilterParams(_ context.Context) ([]common.Address, [][]common.Hash) {
-	return []common.Address{k.address}, [][]common.Hash{{createValidatorEvent.ID, delegateEvent.ID, editValidatorEvent.ID}}
+// Undelegate and Redelegate events are only included once the drake network upgrade is active.
+func (k Keeper) FilterParams(ctx context.Context) ([]common.Address, [][]common.Hash) {
+	topics := []common.Hash{createValidatorEvent.ID, delegateEvent.ID, editValidatorEvent.ID}
+
+	if drake, err := types.IsDrake(ctx, k.uKeeper); err != nil {
+		log.Error(ctx, "Failed checking drake upgrade [BUG]", err)
+	} else if drake {
+		topics = append(topics, undelegateEvent.ID, redelegateEvent.ID)
+	}
+
+	return []common.Address{k.address}, [][]common.Hash{topics}
 }
 
-// Deliver processes a omni deposit log event, which must be one of:
+// Deliver processes a omni staking log event, which must be one of:
 // - CreateValidator
-// - Delegate.
+// - Delegate
+// - EditValidator
+// - Undelegate
+// - Redelegate.
 // Note that the event delivery is not immediate. Instead, every event is
 // first stored in keeper's state. Then all stored events are periodically delivered
 // from `EndBlock` at once.
@@ -175,8 +199,8 @@ func (k Keeper) Deliver(ctx context.Context, _ common.Hash, elog evmenginetypes.
 }
 
 // processBufferedEvent branches the multi-store, parses the EVM event and tries to deliver it.
-// If the delivery succeeds, the multi store branch is committed; if it fails, the corresponding error is logged.
-// Panics are intercepted and logged.
+// If the delivery succeeds, the multi store branch is committed; if it fails, the corresponding error is logged
+// and deposits are refunded, see refundDeposit. Panics are intercepted and logged.
 func (k Keeper) processBufferedEvent(ctx context.Context, elog *evmenginetypes.EVMEvent) {
 	// Branch the store in case processing fails.
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -192,6 +216,10 @@ func (k Keeper) processBufferedEvent(ctx context.Context, elog *evmenginetypes.E
 		)
 		failedEvents.Inc()
 
+		if err := k.refundDeposit(ctx, elog, err); err != nil {
+			log.Error(ctx, "Recording failed EVM staking deposit failed", err, "name", eventName(elog))
+		}
+
 		return
 	}
 
@@ -234,6 +262,32 @@ func (k Keeper) parseAndDeliver(ctx context.Context, elog *evmenginetypes.EVMEve
 		if err := k.deliverEditValidator(ctx, editVal); err != nil {
 			return errors.Wrap(err, "edit validator")
 		}
+	case undelegateEvent.ID:
+		if err := k.requireDrake(ctx); err != nil {
+			return err
+		}
+
+		undelegate, err := k.contract.ParseUndelegate(ethlog)
+		if err != nil {
+			return errors.Wrap(err, "parse undelegate")
+		}
+
+		if err := k.deliverUndelegate(ctx, undelegate); err != nil {
+			return errors.Wrap(err, "undelegate")
+		}
+	case redelegateEvent.ID:
+		if err := k.requireDrake(ctx); err != nil {
+			return err
+		}
+
+		redelegate, err := k.contract.ParseRedelegate(ethlog)
+		if err != nil {
+			return errors.Wrap(err, "parse redelegate")
+		}
+
+		if err := k.deliverRedelegate(ctx, redelegate); err != nil {
+			return errors.Wrap(err, "redelegate")
+		}
 	default:
 		return errors.New("unknown event")
 	}
@@ -241,12 +295,24 @@ func (k Keeper) parseAndDeliver(ctx context.Context, elog *evmenginetypes.EVMEve
 	return nil
 }
 
+// requireDrake returns an error if the drake network upgrade is not active.
+func (k Keeper) requireDrake(ctx context.Context) error {
+	drake, err := types.IsDrake(ctx, k.uKeeper)
+	if err != nil {
+		return err
+	} else if !drake {
+		return errors.New("event not supported before drake upgrade")
+	}
+
+	return nil
+}
+
 // deliverDelegate processes a Delegate event, and delegates to an existing validator.
 // - Mint the corresponding amount of $STAKE coins.
 // - Send the minted coins to the delegator's account.
 // - Delegate the minted coins to the validator.
 //
-// NOTE: if we error, the deposit is lost (on EVM). consider recovery methods.
+// If we error, the deposit is refunded to the delegator, see refundDeposit.
 func (k Keeper) deliverDelegate(ctx context.Context, ev *bindings.StakingDelegate) error {
 	if err := verifyStakingDelegate(ev); err != nil {
 		return err
@@ -256,7 +322,7 @@ func (k Keeper) deliverDelegate(ctx context.Context, ev *bindings.StakingDelegat
 	valAddr := sdk.ValAddress(ev.Validator.Bytes())
 
 	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err != nil {
-		return errors.New("validator does not exist", "validator", valAddr.String())
+		return errors.Wrap(errValidatorNotFound, "get validator", "validator", valAddr.String())
 	}
 
 	amountCoin, amountCoins := omniToBondCoin(ev.Amount)
@@ -285,6 +351,68 @@ func (k Keeper) deliverDelegate(ctx context.Context, ev *bindings.StakingDelegat
 	return nil
 }
 
+// deliverUndelegate processes an Undelegate event, and undelegates from an existing validator.
+// The undelegated tokens are withdrawn to the delegator's EVM account once the unbonding period completes,
+// see withdraw.BankWrapper.UndelegateCoinsFromModuleToAccount.
+func (k Keeper) deliverUndelegate(ctx context.Context, ev *bindings.StakingUndelegate) error {
+	if ev.Amount == nil {
+		return errors.New("undelegate amount missing")
+	}
+
+	delAddr := sdk.AccAddress(ev.Delegator.Bytes())
+	valAddr := sdk.ValAddress(ev.Validator.Bytes())
+
+	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err != nil {
+		return errors.Wrap(errValidatorNotFound, "get validator", "validator", valAddr.String())
+	}
+
+	amountCoin, _ := omniToBondCoin(ev.Amount)
+
+	log.Info(ctx, "EVM staking undelegation detected, undelegating",
+		"delegator", ev.Delegator.Hex(),
+		"validator", ev.Validator.Hex(),
+		"amount", ev.Amount.String())
+
+	msg := stypes.NewMsgUndelegate(delAddr.String(), valAddr.String(), amountCoin)
+	if _, err := k.sServer.Undelegate(ctx, msg); err != nil {
+		return errors.Wrap(err, "undelegate")
+	}
+
+	return nil
+}
+
+// deliverRedelegate processes a Redelegate event, and moves stake from one existing validator to another.
+func (k Keeper) deliverRedelegate(ctx context.Context, ev *bindings.StakingRedelegate) error {
+	if ev.Amount == nil {
+		return errors.New("redelegate amount missing")
+	}
+
+	delAddr := sdk.AccAddress(ev.Delegator.Bytes())
+	srcAddr := sdk.ValAddress(ev.SrcValidator.Bytes())
+	dstAddr := sdk.ValAddress(ev.DstValidator.Bytes())
+
+	if _, err := k.sKeeper.GetValidator(ctx, srcAddr); err != nil {
+		return errors.Wrap(errValidatorNotFound, "get source validator", "validator", srcAddr.String())
+	} else if _, err := k.sKeeper.GetValidator(ctx, dstAddr); err != nil {
+		return errors.Wrap(errValidatorNotFound, "get destination validator", "validator", dstAddr.String())
+	}
+
+	amountCoin, _ := omniToBondCoin(ev.Amount)
+
+	log.Info(ctx, "EVM staking redelegation detected, redelegating",
+		"delegator", ev.Delegator.Hex(),
+		"src_validator", ev.SrcValidator.Hex(),
+		"dst_validator", ev.DstValidator.Hex(),
+		"amount", ev.Amount.String())
+
+	msg := stypes.NewMsgBeginRedelegate(delAddr.String(), srcAddr.String(), dstAddr.String(), amountCoin)
+	if _, err := k.sServer.BeginRedelegate(ctx, msg); err != nil {
+		return errors.Wrap(err, "begin redelegate")
+	}
+
+	return nil
+}
+
 func (k Keeper) deliverEditValidator(ctx context.Context, ev *bindings.StakingEditValidator) error {
 	valAddr := sdk.ValAddress(ev.Validator.Bytes())
 
@@ -342,11 +470,11 @@ func (k Keeper) createAccIfNone(ctx context.Context, addr sdk.AccAddress) {
 // - Send the minted coins to the depositor's account.
 // - Create a new validator with the depositor's account.
 //
-// NOTE: if we error, the deposit is lost (on EVM). consider recovery methods.
+// If we error, the deposit is refunded to the depositor, see refundDeposit.
 func (k Keeper) deliverCreateValidator(ctx context.Context, createValidator *bindings.StakingCreateValidator) error {
 	pubkey, err := k1util.PubKeyBytesToCosmos(createValidator.Pubkey)
 	if err != nil {
-		return errors.Wrap(err, "pubkey to cosmos")
+		return errors.Wrap(errInvalidPubkey, "pubkey to cosmos", "err", err)
 	}
 
 	accAddr := sdk.AccAddress(createValidator.Validator.Bytes())
@@ -355,7 +483,7 @@ func (k Keeper) deliverCreateValidator(ctx context.Context, createValidator *bin
 	amountCoin, amountCoins := omniToBondCoin(createValidator.Deposit)
 
 	if _, err := k.sKeeper.GetValidator(ctx, valAddr); err == nil {
-		return errors.New("validator already exists")
+		return errors.Wrap(errValidatorExists, "create validator", "validator", valAddr.String())
 	}
 
 	k.createAccIfNone(ctx, accAddr)
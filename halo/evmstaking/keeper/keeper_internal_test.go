@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 	"strings"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/k1util"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
 	"github.com/omni-network/omni/lib/umath"
 	evmengkeeper "github.com/omni-network/omni/octane/evmengine/keeper"
 	etypes "github.com/omni-network/omni/octane/evmengine/types"
@@ -19,11 +21,14 @@ import (
 	k1 "github.com/cometbft/cometbft/crypto/secp256k1"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
 
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -359,6 +364,275 @@ func TestNonSelfDelegationEventDelivery(t *testing.T) {
 	require.Equal(t, msg.Amount, stake)
 }
 
+func TestUndelegateAndRedelegate(t *testing.T) {
+	t.Parallel()
+
+	srcKey := k1.GenPrivKey()
+	dstKey := k1.GenPrivKey()
+	delegator := tutil.RandomAddress()
+
+	ethClientMock, err := ethclient.NewEngineMock(
+		ethclient.WithMockValidatorCreation(srcKey.PubKey()),
+		ethclient.WithMockValidatorCreation(dstKey.PubKey()),
+		ethclient.WithMockUndelegation(srcKey.PubKey(), delegator, 3),
+		ethclient.WithMockRedelegation(srcKey.PubKey(), dstKey.PubKey(), delegator, 5),
+	)
+	require.NoError(t, err)
+
+	var undelegateMsgs []*stypes.MsgUndelegate
+	var redelegateMsgs []*stypes.MsgBeginRedelegate
+
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	sServerMock.EXPECT().CreateValidator(gomock.Any(), gomock.Any()).Times(2).Return(new(stypes.MsgCreateValidatorResponse), nil)
+	sServerMock.EXPECT().
+		Undelegate(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, msg *stypes.MsgUndelegate) (*stypes.MsgUndelegateResponse, error) {
+			undelegateMsgs = append(undelegateMsgs, msg)
+			return new(stypes.MsgUndelegateResponse), nil
+		})
+	sServerMock.EXPECT().
+		BeginRedelegate(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, msg *stypes.MsgBeginRedelegate) (*stypes.MsgBeginRedelegateResponse, error) {
+			redelegateMsgs = append(redelegateMsgs, msg)
+			return new(stypes.MsgBeginRedelegateResponse), nil
+		})
+
+	keeper, ctx := setupKeeper(t, 1, sServerMock)
+
+	events, err := getStakingEvents(ctx, ethClientMock, keeper)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	for _, event := range events {
+		err := keeper.Deliver(ctx, common.Hash{}, event)
+		require.NoError(t, err)
+	}
+
+	err = keeper.EndBlock(ctx)
+	require.NoError(t, err)
+
+	srcAddr, err := k1util.PubKeyToAddress(srcKey.PubKey())
+	require.NoError(t, err)
+	dstAddr, err := k1util.PubKeyToAddress(dstKey.PubKey())
+	require.NoError(t, err)
+
+	require.Len(t, undelegateMsgs, 1)
+	require.Equal(t, sdk.AccAddress(delegator.Bytes()).String(), undelegateMsgs[0].DelegatorAddress)
+	require.Equal(t, sdk.ValAddress(srcAddr.Bytes()).String(), undelegateMsgs[0].ValidatorAddress)
+	require.Equal(t, sdk.NewInt64Coin("stake", 3*params.Ether), undelegateMsgs[0].Amount)
+
+	require.Len(t, redelegateMsgs, 1)
+	require.Equal(t, sdk.AccAddress(delegator.Bytes()).String(), redelegateMsgs[0].DelegatorAddress)
+	require.Equal(t, sdk.ValAddress(srcAddr.Bytes()).String(), redelegateMsgs[0].ValidatorSrcAddress)
+	require.Equal(t, sdk.ValAddress(dstAddr.Bytes()).String(), redelegateMsgs[0].ValidatorDstAddress)
+	require.Equal(t, sdk.NewInt64Coin("stake", 5*params.Ether), redelegateMsgs[0].Amount)
+}
+
+func TestUndelegateBeforeDrake(t *testing.T) {
+	t.Parallel()
+
+	valKey := k1.GenPrivKey()
+	delegator := tutil.RandomAddress()
+
+	ethClientMock, err := ethclient.NewEngineMock(
+		ethclient.WithMockValidatorCreation(valKey.PubKey()),
+		ethclient.WithMockUndelegation(valKey.PubKey(), delegator, 3),
+	)
+	require.NoError(t, err)
+
+	// Undelegations are never delivered before drake.
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	sServerMock.EXPECT().CreateValidator(gomock.Any(), gomock.Any()).Times(1).Return(new(stypes.MsgCreateValidatorResponse), nil)
+
+	keeper, ctx := setupKeeper(t, 1, sServerMock)
+	keeper.uKeeper = newUpgradeKeeperMock(ctrl, types.DrakeVersion-1)
+
+	// Undelegate and redelegate events are excluded from the filter before drake.
+	_, topics := keeper.FilterParams(ctx)
+	require.Len(t, topics, 1)
+	require.NotContains(t, topics[0], undelegateEvent.ID)
+	require.NotContains(t, topics[0], redelegateEvent.ID)
+
+	events, err := getStakingEvents(ctx, ethClientMock, keeper)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	for _, event := range events {
+		require.NoError(t, keeper.Deliver(ctx, common.Hash{}, event))
+	}
+	require.NoError(t, keeper.EndBlock(ctx))
+}
+
+func TestRefundFailedDeposits(t *testing.T) {
+	t.Parallel()
+
+	ethStake := int64(7)
+	privKey := k1.GenPrivKey()
+
+	ethClientMock, err := ethclient.NewEngineMock(
+		ethclient.WithMockValidatorCreation(privKey.PubKey()),
+		ethclient.WithMockSelfDelegation(privKey.PubKey(), ethStake),
+	)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	err = errors.New("unconditional error")
+	sServerMock.EXPECT().CreateValidator(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, err)
+	sServerMock.EXPECT().Delegate(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, err)
+
+	keeper, ctx := setupKeeper(t, 1, sServerMock)
+
+	events, err := getStakingEvents(ctx, ethClientMock, keeper)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	for _, event := range events {
+		err := keeper.Deliver(ctx, common.Hash{}, event)
+		require.NoError(t, err)
+	}
+
+	err = keeper.EndBlock(ctx)
+	require.NoError(t, err)
+
+	valAddr, err := k1util.PubKeyToAddress(privKey.PubKey())
+	require.NoError(t, err)
+
+	// Both the rejected validator creation and delegation are refunded.
+	resp, err := keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{Depositor: valAddr.Bytes()})
+	require.NoError(t, err)
+	require.Len(t, resp.Deposits, 2)
+
+	require.Equal(t, "CreateValidator", resp.Deposits[0].EventName)
+	require.Equal(t, "Delegate", resp.Deposits[1].EventName)
+
+	for _, deposit := range resp.Deposits {
+		require.True(t, deposit.Refunded)
+		require.Equal(t, reasonRejected, deposit.Reason)
+		require.Equal(t, valAddr.Bytes(), deposit.Validator)
+		require.EqualValues(t, 1, deposit.Height)
+	}
+	require.Equal(t, big.NewInt(ethStake*params.Ether).String(), resp.Deposits[1].AmountWei)
+
+	// Other depositors have no failed deposits.
+	resp, err = keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{Depositor: tutil.RandomAddress().Bytes()})
+	require.NoError(t, err)
+	require.Empty(t, resp.Deposits)
+
+	// Failed deposits are paginated.
+	resp, err = keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, resp.Deposits, 1)
+	require.Equal(t, "CreateValidator", resp.Deposits[0].EventName)
+	require.NotEmpty(t, resp.Pagination.NextKey)
+
+	resp, err = keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, resp.Deposits, 1)
+	require.Equal(t, "Delegate", resp.Deposits[0].EventName)
+
+	_, err = keeper.FailedDeposits(ctx, nil)
+	require.Error(t, err)
+
+	_, err = keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{Pagination: &query.PageRequest{Limit: maxFailedDepositsLimit + 1}})
+	require.Error(t, err)
+}
+
+func TestRefundBeforeDrake(t *testing.T) {
+	t.Parallel()
+
+	privKey := k1.GenPrivKey()
+
+	ethClientMock, err := ethclient.NewEngineMock(
+		ethclient.WithMockValidatorCreation(privKey.PubKey()),
+	)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	sServerMock := testutil.NewMockStakingMsgServer(ctrl)
+	sServerMock.EXPECT().CreateValidator(gomock.Any(), gomock.Any()).Times(1).Return(nil, errors.New("unconditional error"))
+
+	keeper, ctx := setupKeeper(t, 1, sServerMock)
+	keeper.uKeeper = newUpgradeKeeperMock(ctrl, types.DrakeVersion-1)
+
+	events, err := getStakingEvents(ctx, ethClientMock, keeper)
+	require.NoError(t, err)
+
+	for _, event := range events {
+		require.NoError(t, keeper.Deliver(ctx, common.Hash{}, event))
+	}
+	require.NoError(t, keeper.EndBlock(ctx))
+
+	// Failed deposits are neither recorded nor refunded before drake.
+	resp, err := keeper.FailedDeposits(ctx, &types.FailedDepositsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Deposits)
+}
+
+func TestFailureReason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		err    error
+		reason string
+	}{
+		{catch(func() error { panic("boom") }), reasonPanic},
+		{errors.Wrap(errInvalidPubkey, "pubkey to cosmos"), reasonInvalidPubkey},
+		{errors.Wrap(errors.Wrap(errValidatorExists, "create validator"), "create validator"), reasonValidatorExists},
+		{errors.Wrap(errValidatorNotFound, "get source validator"), reasonValidatorNotFound},
+		{errors.New("validator does not exist"), reasonRejected},
+		{errors.New("invalid pubkey"), reasonRejected},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.reason, failureReason(test.err), test.err.Error())
+	}
+}
+
+func TestRefundableAmount(t *testing.T) {
+	t.Parallel()
+
+	require.Zero(t, refundableAmount(big.NewInt(params.GWei-1)).Sign())
+	require.Equal(t, big.NewInt(params.GWei), refundableAmount(big.NewInt(params.GWei)))
+	require.Equal(t, big.NewInt(2*params.GWei), refundableAmount(big.NewInt(3*params.GWei-1)))
+}
+
+func TestGenesis(t *testing.T) {
+	t.Parallel()
+
+	keeper, ctx := setupKeeper(t, 5, nil)
+
+	for _, addr := range [][]byte{{1, 2, 3}, {2, 3, 4}} {
+		err := keeper.Deliver(ctx, common.Hash{}, etypes.EVMEvent{Address: addr})
+		require.NoError(t, err)
+	}
+
+	exported, err := keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+
+	// Import into a new keeper
+	imported, importedCtx := setupKeeper(t, 5, nil)
+	require.NoError(t, imported.InitGenesis(importedCtx, exported))
+
+	reexported, err := imported.ExportGenesis(importedCtx)
+	require.NoError(t, err)
+	require.JSONEq(t, string(exported), string(reexported))
+
+	assertContains(t, importedCtx, imported, 1)
+	assertContains(t, importedCtx, imported, 2)
+
+	// Auto-increment sequences are imported as well
+	err = imported.Deliver(importedCtx, common.Hash{}, etypes.EVMEvent{Address: []byte{3, 4, 5}})
+	require.NoError(t, err)
+	assertContains(t, importedCtx, imported, 3)
+
+	// Empty genesis is a noop
+	require.NoError(t, imported.InitGenesis(importedCtx, []byte("{}")))
+}
+
 func TestEditValidator(t *testing.T) {
 	t.Parallel()
 
@@ -458,6 +732,7 @@ func setupKeeper(
 	bKeeperMock := testutil.NewMockBankKeeper(ctrl)
 	bKeeperMock.EXPECT().MintCoins(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 	bKeeperMock.EXPECT().SendCoinsFromModuleToAccountNoWithdrawal(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+	bKeeperMock.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 
 	sKeeperMock := testutil.NewMockStakingKeeper(ctrl)
 	var seenValidators map[string]bool
@@ -483,6 +758,7 @@ func setupKeeper(
 		bKeeperMock,
 		sKeeperMock,
 		sServer,
+		newUpgradeKeeperMock(ctrl, types.DrakeVersion),
 		deliverInterval,
 	)
 	require.NoError(t, err, "new keeper")
@@ -490,6 +766,15 @@ func setupKeeper(
 	return k, ctx
 }
 
+// newUpgradeKeeperMock returns an upgrade keeper mock with the provided evmstaking module consensus version.
+func newUpgradeKeeperMock(ctrl *gomock.Controller, version uint64) *testutil.MockUpgradeKeeper {
+	uKeeperMock := testutil.NewMockUpgradeKeeper(ctrl)
+	uKeeperMock.EXPECT().GetModuleVersionMap(gomock.Any()).AnyTimes().
+		Return(module.VersionMap{types.ModuleName: version}, nil)
+
+	return uKeeperMock
+}
+
 // getStakingEvents returns the staking events from the mock engine client.
 func getStakingEvents(ctx context.Context, cl ethclient.EngineClient, keeper *Keeper) ([]etypes.EVMEvent, error) {
 	return evmengkeeper.FetchProcEvents(ctx, cl, common.Hash{}, keeper)
@@ -26,4 +26,11 @@ var (
 		Name:      "failed_events_total",
 		Help:      "The number of staking events that could not be delivered",
 	})
+
+	failedDeposits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "halo",
+		Subsystem: "evmstaking",
+		Name:      "failed_deposits_total",
+		Help:      "The number of failed staking deposits by event, failure reason and whether it was refunded",
+	}, []string{"event", "reason", "refunded"})
 )
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/halo/evmstaking/types"
+
+	queryv1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	"cosmossdk.io/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFailedDepositsLimit = 100
+	maxFailedDepositsLimit     = 1000
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// FailedDeposits returns a page of failed EVM staking deposits, optionally filtered by depositor.
+func (k Keeper) FailedDeposits(ctx context.Context, req *types.FailedDepositsRequest) (*types.FailedDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	} else if req.Pagination.GetLimit() > maxFailedDepositsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination limit exceeds %d", maxFailedDepositsLimit)
+	}
+
+	var key FailedDepositIndexKey = FailedDepositIdIndexKey{}
+	if len(req.Depositor) > 0 {
+		key = FailedDepositDepositorIndexKey{}.WithDepositor(req.Depositor)
+	}
+
+	iter, err := k.failedDepositTable.List(ctx, key,
+		ormlist.Paginate(toPageRequest(req.Pagination)),
+		ormlist.DefaultLimit(defaultFailedDepositsLimit),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer iter.Close()
+
+	var deposits []types.FailedDeposit
+	for iter.Next() {
+		deposit, err := iter.Value()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		deposits = append(deposits, types.FailedDeposit{
+			Id:        deposit.GetId(),
+			Depositor: deposit.GetDepositor(),
+			Validator: deposit.GetValidator(),
+			EventName: deposit.GetEventName(),
+			AmountWei: deposit.GetAmountWei(),
+			Reason:    deposit.GetReason(),
+			Error:     deposit.GetError(),
+			Height:    deposit.GetHeight(),
+			Refunded:  deposit.GetRefunded(),
+		})
+	}
+
+	return &types.FailedDepositsResponse{
+		Deposits:   deposits,
+		Pagination: fromPageResponse(iter.PageResponse()),
+	}, nil
+}
+
+// toPageRequest converts the gogoproto page request to the ORM (pulsar) page request.
+func toPageRequest(req *query.PageRequest) *queryv1beta1.PageRequest {
+	if req == nil {
+		return nil
+	}
+
+	return &queryv1beta1.PageRequest{
+		Key:        req.Key,
+		Offset:     req.Offset,
+		Limit:      req.Limit,
+		CountTotal: req.CountTotal,
+		Reverse:    req.Reverse,
+	}
+}
+
+// fromPageResponse converts the ORM (pulsar) page response to the gogoproto page response.
+func fromPageResponse(resp *queryv1beta1.PageResponse) *query.PageResponse {
+	if resp == nil {
+		return nil
+	}
+
+	return &query.PageResponse{
+		NextKey: resp.NextKey,
+		Total:   resp.Total,
+	}
+}
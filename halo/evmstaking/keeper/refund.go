@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+
+	"github.com/omni-network/omni/halo/evmstaking/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Failed deposit reasons, used as metric labels.
+const (
+	reasonPanic             = "panic"
+	reasonInvalidPubkey     = "invalid_pubkey"
+	reasonValidatorExists   = "validator_exists"
+	reasonValidatorNotFound = "validator_not_found"
+	reasonRejected          = "rejected" // Rejected by x/staking
+)
+
+// Failed deposit sentinel errors, used to categorize failure reasons.
+var (
+	errPanic             = errors.NewSentinel("panic")
+	errInvalidPubkey     = errors.NewSentinel("invalid pubkey")
+	errValidatorExists   = errors.NewSentinel("validator already exists")
+	errValidatorNotFound = errors.NewSentinel("validator does not exist")
+)
+
+// refundDeposit records a failed CreateValidator or Delegate event delivery and refunds
+// the deposit to the depositor's EVM address via a withdrawal.
+// Other events are ignored since they do not carry deposits.
+// Failed deposits are only refunded after the drake network upgrade.
+//
+// Only whole gwei are refunded since EVM withdrawals are denominated in gwei,
+// so all-dust deposits (less than 1 gwei) are recorded as not refunded.
+func (k Keeper) refundDeposit(ctx context.Context, elog *evmenginetypes.EVMEvent, deliverErr error) error {
+	if drake, err := types.IsDrake(ctx, k.uKeeper); err != nil {
+		return err
+	} else if !drake {
+		return nil
+	}
+
+	depositor, validator, amount, ok := k.parseDeposit(elog)
+	if !ok {
+		return nil
+	}
+
+	reason := failureReason(deliverErr)
+	refundable := refundableAmount(amount)
+	refunded := refundable.Sign() > 0
+	if !refunded {
+		log.Warn(ctx, "Not refunding all-dust EVM staking deposit", nil,
+			"depositor", depositor.Hex(),
+			"amount", amount.String(),
+		)
+	} else if err := k.refund(ctx, depositor, refundable); err != nil {
+		log.Warn(ctx, "Refunding failed EVM staking deposit failed", err,
+			"depositor", depositor.Hex(),
+			"amount", refundable.String(),
+		)
+		refunded = false
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err := k.failedDepositTable.Insert(ctx, &FailedDeposit{
+		Depositor: depositor.Bytes(),
+		Validator: validator.Bytes(),
+		EventName: eventName(elog),
+		AmountWei: amount.String(),
+		Reason:    reason,
+		Error:     deliverErr.Error(),
+		Height:    sdkCtx.BlockHeight(),
+		Refunded:  refunded,
+	})
+	if err != nil {
+		return errors.Wrap(err, "insert failed deposit")
+	}
+
+	failedDeposits.WithLabelValues(eventName(elog), reason, strconv.FormatBool(refunded)).Inc()
+
+	if refunded {
+		log.Info(ctx, "Refunded failed EVM staking deposit",
+			"depositor", depositor.Hex(),
+			"amount", refundable.String(),
+			"reason", reason,
+		)
+	}
+
+	return nil
+}
+
+// refund mints the amount and withdraws it to the depositor's EVM address on a state branch.
+// The branch is only committed if the refund succeeds.
+func (k Keeper) refund(ctx context.Context, depositor common.Address, amount *big.Int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	branchMS := sdkCtx.MultiStore().CacheMultiStore()
+	branchCtx := sdkCtx.WithMultiStore(branchMS)
+
+	_, coins := omniToBondCoin(amount)
+
+	err := catch(func() error { //nolint:contextcheck // False positive wrt ctx
+		if err := k.bKeeper.MintCoins(branchCtx, k.Name(), coins); err != nil {
+			return errors.Wrap(err, "mint coins")
+		}
+
+		// SendCoinsFromModuleToAccount inserts an EVM withdrawal and burns the coins.
+		if err := k.bKeeper.SendCoinsFromModuleToAccount(branchCtx, k.Name(), sdk.AccAddress(depositor.Bytes()), coins); err != nil {
+			return errors.Wrap(err, "withdraw coins")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	branchMS.Write()
+
+	return nil
+}
+
+// refundableAmount returns the amount rounded down to whole gwei.
+func refundableAmount(amount *big.Int) *big.Int {
+	dust := new(big.Int).Mod(amount, big.NewInt(params.GWei))
+	return new(big.Int).Sub(amount, dust)
+}
+
+// parseDeposit returns the depositor, validator and amount of CreateValidator and Delegate events.
+// It returns false for other events or deposits without a positive amount.
+func (k Keeper) parseDeposit(elog *evmenginetypes.EVMEvent) (common.Address, common.Address, *big.Int, bool) {
+	ethlog, err := elog.ToEthLog()
+	if err != nil {
+		return common.Address{}, common.Address{}, nil, false
+	}
+
+	var depositor, validator common.Address
+	var amount *big.Int
+	switch ethlog.Topics[0] {
+	case createValidatorEvent.ID:
+		ev, err := k.contract.ParseCreateValidator(ethlog)
+		if err != nil {
+			return common.Address{}, common.Address{}, nil, false
+		}
+		depositor, validator, amount = ev.Validator, ev.Validator, ev.Deposit
+	case delegateEvent.ID:
+		ev, err := k.contract.ParseDelegate(ethlog)
+		if err != nil {
+			return common.Address{}, common.Address{}, nil, false
+		}
+		depositor, validator, amount = ev.Delegator, ev.Validator, ev.Amount
+	default:
+		return common.Address{}, common.Address{}, nil, false
+	}
+
+	if amount == nil || amount.Sign() <= 0 {
+		return common.Address{}, common.Address{}, nil, false
+	}
+
+	return depositor, validator, amount, true
+}
+
+// failureReason returns the failure reason category of the delivery error.
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, errPanic):
+		return reasonPanic
+	case errors.Is(err, errInvalidPubkey):
+		return reasonInvalidPubkey
+	case errors.Is(err, errValidatorExists):
+		return reasonValidatorExists
+	case errors.Is(err, errValidatorNotFound):
+		return reasonValidatorNotFound
+	default:
+		return reasonRejected
+	}
+}
@@ -2,30 +2,37 @@ package module
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/omni-network/omni/halo/evmstaking/keeper"
 	"github.com/omni-network/omni/halo/evmstaking/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
 	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
 
 const (
-	ConsensusVersion = 1
+	// ConsensusVersion is the drake consensus version, migrated to by the 3_drake network upgrade.
+	ConsensusVersion = types.DrakeVersion
 )
 
 var (
 	_ module.AppModuleBasic   = (*AppModule)(nil)
 	_ appmodule.AppModule     = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker = (*AppModule)(nil)
+	_ module.HasGenesis       = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -86,8 +93,41 @@ func NewAppModule(
 	}
 }
 
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	raw, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return raw
+}
+
+// DefaultGenesis returns empty default genesis state, module tables are only populated from exported state.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the evmstaking module.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
-func (AppModule) RegisterServices() {}
+func (m AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
+
+	// 3_drake doesn't include any store migrations, it only activates undelegations and redelegations.
+	noopMigration := func(sdk.Context) error { return nil }
+	if err := cfg.RegisterMigration(types.ModuleName, 1, noopMigration); err != nil {
+		panic(errors.Wrap(err, "register migration"))
+	}
+}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (AppModule) IsOnePerModuleType() {}
@@ -116,6 +156,7 @@ type ModuleInputs struct {
 	AKeeper      types.AuthKeeper
 	BKeeper      types.WrappedBankKeeper
 	SKeeper      *stakingkeeper.Keeper
+	UKeeper      *upgradekeeper.Keeper
 	Cdc          codec.Codec
 	Config       *Module
 }
@@ -135,6 +176,7 @@ func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 		in.BKeeper,
 		in.SKeeper,
 		stakingkeeper.NewMsgServerImpl(in.SKeeper),
+		in.UKeeper,
 		in.Config.GetDeliverInterval(),
 	)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	types "github.com/cosmos/cosmos-sdk/types"
+	module "github.com/cosmos/cosmos-sdk/types/module"
 	types0 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -120,6 +121,20 @@ func (mr *MockBankKeeperMockRecorder) MintCoins(ctx, moduleName, amt any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintCoins", reflect.TypeOf((*MockBankKeeper)(nil).MintCoins), ctx, moduleName, amt)
 }
 
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // SendCoinsFromModuleToAccountNoWithdrawal mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToAccountNoWithdrawal(ctx context.Context, senderModule string, recipientAddr types.AccAddress, amt types.Coins) error {
 	m.ctrl.T.Helper()
@@ -197,6 +212,21 @@ func (m *MockStakingMsgServer) EXPECT() *MockStakingMsgServerMockRecorder {
 	return m.recorder
 }
 
+// BeginRedelegate mocks base method.
+func (m *MockStakingMsgServer) BeginRedelegate(ctx context.Context, msg *types0.MsgBeginRedelegate) (*types0.MsgBeginRedelegateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeginRedelegate", ctx, msg)
+	ret0, _ := ret[0].(*types0.MsgBeginRedelegateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeginRedelegate indicates an expected call of BeginRedelegate.
+func (mr *MockStakingMsgServerMockRecorder) BeginRedelegate(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeginRedelegate", reflect.TypeOf((*MockStakingMsgServer)(nil).BeginRedelegate), ctx, msg)
+}
+
 // CreateValidator mocks base method.
 func (m *MockStakingMsgServer) CreateValidator(ctx context.Context, msg *types0.MsgCreateValidator) (*types0.MsgCreateValidatorResponse, error) {
 	m.ctrl.T.Helper()
@@ -241,3 +271,57 @@ func (mr *MockStakingMsgServerMockRecorder) EditValidator(ctx, msg any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditValidator", reflect.TypeOf((*MockStakingMsgServer)(nil).EditValidator), ctx, msg)
 }
+
+// Undelegate mocks base method.
+func (m *MockStakingMsgServer) Undelegate(ctx context.Context, msg *types0.MsgUndelegate) (*types0.MsgUndelegateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Undelegate", ctx, msg)
+	ret0, _ := ret[0].(*types0.MsgUndelegateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Undelegate indicates an expected call of Undelegate.
+func (mr *MockStakingMsgServerMockRecorder) Undelegate(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Undelegate", reflect.TypeOf((*MockStakingMsgServer)(nil).Undelegate), ctx, msg)
+}
+
+// MockUpgradeKeeper is a mock of UpgradeKeeper interface.
+type MockUpgradeKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgradeKeeperMockRecorder
+	isgomock struct{}
+}
+
+// MockUpgradeKeeperMockRecorder is the mock recorder for MockUpgradeKeeper.
+type MockUpgradeKeeperMockRecorder struct {
+	mock *MockUpgradeKeeper
+}
+
+// NewMockUpgradeKeeper creates a new mock instance.
+func NewMockUpgradeKeeper(ctrl *gomock.Controller) *MockUpgradeKeeper {
+	mock := &MockUpgradeKeeper{ctrl: ctrl}
+	mock.recorder = &MockUpgradeKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgradeKeeper) EXPECT() *MockUpgradeKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetModuleVersionMap mocks base method.
+func (m *MockUpgradeKeeper) GetModuleVersionMap(ctx context.Context) (module.VersionMap, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModuleVersionMap", ctx)
+	ret0, _ := ret[0].(module.VersionMap)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModuleVersionMap indicates an expected call of GetModuleVersionMap.
+func (mr *MockUpgradeKeeperMockRecorder) GetModuleVersionMap(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModuleVersionMap", reflect.TypeOf((*MockUpgradeKeeper)(nil).GetModuleVersionMap), ctx)
+}
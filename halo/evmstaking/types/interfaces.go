@@ -5,6 +5,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	stypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -17,6 +18,7 @@ type AuthKeeper interface {
 type WrappedBankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccountNoWithdrawal(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 type StakingKeeper interface {
@@ -27,4 +29,10 @@ type StakingMsgServer interface {
 	CreateValidator(ctx context.Context, msg *stypes.MsgCreateValidator) (*stypes.MsgCreateValidatorResponse, error)
 	Delegate(ctx context.Context, msg *stypes.MsgDelegate) (*stypes.MsgDelegateResponse, error)
 	EditValidator(ctx context.Context, msg *stypes.MsgEditValidator) (*stypes.MsgEditValidatorResponse, error)
+	Undelegate(ctx context.Context, msg *stypes.MsgUndelegate) (*stypes.MsgUndelegateResponse, error)
+	BeginRedelegate(ctx context.Context, msg *stypes.MsgBeginRedelegate) (*stypes.MsgBeginRedelegateResponse, error)
+}
+
+type UpgradeKeeper interface {
+	GetModuleVersionMap(ctx context.Context) (module.VersionMap, error)
 }
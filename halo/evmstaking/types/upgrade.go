@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/errors"
+)
+
+// DrakeVersion is the evmstaking module consensus version introduced by the 3_drake network upgrade.
+// It enables EVM undelegations and redelegations and EVM withdrawals of completed unbondings.
+const DrakeVersion = 2
+
+// IsDrake returns true if the 3_drake network upgrade is active, i.e., if the evmstaking module
+// consensus version was migrated to DrakeVersion (or the chain started from genesis with it).
+func IsDrake(ctx context.Context, upgrades UpgradeKeeper) (bool, error) {
+	versions, err := upgrades.GetModuleVersionMap(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "get module version map")
+	}
+
+	return versions[ModuleName] >= DrakeVersion, nil
+}
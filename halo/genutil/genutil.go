@@ -132,6 +132,26 @@ func MakeGenesis(
 	return appGen, validateGenesis(encConf.Codec, appState2)
 }
 
+// ValidateGenesis validates the app genesis, e.g. exported application state,
+// using the same validation as MakeGenesis.
+func ValidateGenesis(ctx context.Context, network netconf.ID, appGen *gtypes.AppGenesis) error {
+	encConf, err := haloapp.ClientEncodingConfig(ctx, network)
+	if err != nil {
+		return errors.Wrap(err, "encoding config")
+	}
+
+	if err := appGen.ValidateAndComplete(); err != nil {
+		return errors.Wrap(err, "validate and complete genesis")
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGen.AppState, &appState); err != nil {
+		return errors.Wrap(err, "unmarshal app state")
+	}
+
+	return validateGenesis(encConf.Codec, appState)
+}
+
 // maybeAddUpgradeGenesisState adds the genesis state for all upgrades up to upToUpgrade.
 // This is useful for ephemeral chains that want to skip actual upgrades, instead starting
 // from the provided upToUpgrade from genesis itself.
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/lib/ormgenesis"
+)
+
+// ExportGenesis returns the portal module genesis state; the JSON encoded rows of all its tables.
+func (k Keeper) ExportGenesis(ctx context.Context) (json.RawMessage, error) {
+	return ormgenesis.Export(ctx, k.genesis)
+}
+
+// InitGenesis initializes the portal module tables from the exported genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, raw json.RawMessage) error {
+	return ormgenesis.Import(ctx, k.genesis, raw)
+}
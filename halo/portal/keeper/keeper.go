@@ -8,6 +8,7 @@ import (
 	"github.com/omni-network/omni/lib/xchain"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/orm/model/ormdb"
 	"cosmossdk.io/orm/types/ormerrors"
@@ -18,6 +19,7 @@ type Keeper struct {
 	blockTable  BlockTable
 	msgTable    MsgTable
 	offsetTable OffsetTable
+	genesis     appmodule.HasGenesis
 }
 
 func NewKeeper(storeService store.KVStoreService) (Keeper, error) {
@@ -39,6 +41,7 @@ func NewKeeper(storeService store.KVStoreService) (Keeper, error) {
 		blockTable:  portalStore.BlockTable(),
 		msgTable:    portalStore.MsgTable(),
 		offsetTable: portalStore.OffsetTable(),
+		genesis:     modDB.GenesisHandler(),
 	}, nil
 }
 
@@ -1,8 +1,12 @@
 package module
 
 import (
+	"encoding/json"
+
 	"github.com/omni-network/omni/halo/portal/keeper"
 	"github.com/omni-network/omni/halo/portal/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -10,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -19,6 +24,7 @@ const ConsensusVersion = 1
 var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ module.HasGenesis     = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -75,6 +81,31 @@ func NewAppModule(
 	}
 }
 
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	raw, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return raw
+}
+
+// DefaultGenesis returns empty default genesis state, module tables are only populated from exported state.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the portal module.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/orm/model/ormdb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +22,7 @@ import (
 type Keeper struct {
 	emitPortal       ptypes.EmitPortal
 	networkTable     NetworkTable
+	genesis          appmodule.HasGenesis
 	portalRegAddress common.Address
 	portalRegistry   *bindings.PortalRegistryFilterer
 	chainNamer       types.ChainNameFunc
@@ -56,6 +58,7 @@ func NewKeeper(
 	return Keeper{
 		emitPortal:       emitPortal,
 		networkTable:     registryStore.NetworkTable(),
+		genesis:          modDB.GenesisHandler(),
 		portalRegAddress: address,
 		portalRegistry:   portalReg,
 		chainNamer:       namer,
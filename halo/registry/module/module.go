@@ -1,9 +1,13 @@
 package module
 
 import (
+	"encoding/json"
+
 	ptypes "github.com/omni-network/omni/halo/portal/types"
 	"github.com/omni-network/omni/halo/registry/keeper"
 	"github.com/omni-network/omni/halo/registry/types"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ormgenesis"
 	evmenginetypes "github.com/omni-network/omni/octane/evmengine/types"
 
 	"cosmossdk.io/core/appmodule"
@@ -12,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 )
@@ -21,6 +26,7 @@ const ConsensusVersion = 1
 var (
 	_ module.AppModuleBasic = (*AppModule)(nil)
 	_ appmodule.AppModule   = (*AppModule)(nil)
+	_ module.HasGenesis     = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -77,6 +83,31 @@ func NewAppModule(
 	}
 }
 
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
+	}
+}
+
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	raw, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return raw
+}
+
+// DefaultGenesis returns empty default genesis state, module tables are only populated from exported state.
+func (AppModuleBasic) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the registry module.
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
+}
+
 // RegisterServices registers a gRPC query service to respond to the module-specific gRPC queries.
 func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
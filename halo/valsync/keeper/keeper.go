@@ -20,6 +20,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 
 	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/orm/model/ormdb"
 	"cosmossdk.io/orm/model/ormlist"
@@ -37,6 +38,7 @@ type Keeper struct {
 	aKeeper           atypes.AttestKeeper
 	valsetTable       ValidatorSetTable
 	valTable          ValidatorTable
+	genesis           appmodule.HasGenesis
 	subscriber        types.ValSetSubscriber
 	emitPortal        ptypes.EmitPortal
 	subscriberInitted bool
@@ -77,6 +79,7 @@ func NewKeeper(
 	return &Keeper{
 		valsetTable:      valSyncStore.ValidatorSetTable(),
 		valTable:         valSyncStore.ValidatorTable(),
+		genesis:          modDB.GenesisHandler(),
 		sKeeper:          sKeeper,
 		aKeeper:          aKeeper,
 		subscriber:       subscriber,
@@ -10,6 +10,7 @@ import (
 	"github.com/omni-network/omni/halo/valsync/types"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/ormgenesis"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -83,14 +84,29 @@ func (m AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error)
 	return m.keeper.EndBlock(ctx)
 }
 
-func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) {
+// InitGenesis inserts the genesis validator set from the staking module for new chains,
+// or imports the validator sets of exported genesis state.
+func (m AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, raw json.RawMessage) {
+	if !ormgenesis.IsEmpty(raw) {
+		if err := m.keeper.InitGenesis(ctx, raw); err != nil {
+			panic(errors.Wrap(err, "init genesis"))
+		}
+
+		return
+	}
+
 	if err := m.keeper.InsertGenesisSet(ctx); err != nil {
 		panic(errors.Wrap(err, "insert genesis valset"))
 	}
 }
 
-func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
-	return nil
+func (m AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMessage {
+	raw, err := m.keeper.ExportGenesis(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "export genesis"))
+	}
+
+	return raw
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the bank
@@ -100,13 +116,8 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 }
 
 // ValidateGenesis performs genesis state validation for the bank module.
-func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
-	var data types.GenesisState
-	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
-		return errors.Wrap(err, "unmarshal genesis state")
-	}
-
-	return nil
+func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	return ormgenesis.Validate(bz)
 }
 
 func NewAppModule(
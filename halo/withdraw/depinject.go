@@ -2,13 +2,15 @@ package withdraw
 
 import (
 	"cosmossdk.io/depinject"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 )
 
 type DIInputs struct {
 	depinject.In
 
-	BankKeeper bankkeeper.BaseKeeper
+	BankKeeper    bankkeeper.BaseKeeper
+	UpgradeKeeper *upgradekeeper.Keeper
 }
 
 type DIOutputs struct {
@@ -19,7 +21,7 @@ type DIOutputs struct {
 
 func DIProvide(input DIInputs) (DIOutputs, error) {
 	return DIOutputs{
-		BankWrapper: NewBankWrapper(input.BankKeeper),
+		BankWrapper: NewBankWrapper(input.BankKeeper, input.UpgradeKeeper),
 	}, nil
 }
 
@@ -5,6 +5,7 @@ package withdraw
 import (
 	"context"
 
+	evmstakingtypes "github.com/omni-network/omni/halo/evmstaking/types"
 	"github.com/omni-network/omni/lib/cast"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/log"
@@ -22,10 +23,11 @@ type BankWrapper struct {
 	bankkeeper.Keeper
 
 	EVMEngineKeeper EVMEngineKeeper
+	UpgradeKeeper   evmstakingtypes.UpgradeKeeper
 }
 
-func NewBankWrapper(k bankkeeper.Keeper) *BankWrapper {
-	return &BankWrapper{Keeper: k}
+func NewBankWrapper(k bankkeeper.Keeper, upgrades evmstakingtypes.UpgradeKeeper) *BankWrapper {
+	return &BankWrapper{Keeper: k, UpgradeKeeper: upgrades}
 }
 
 func (w *BankWrapper) SetEVMEngineKeeper(keeper EVMEngineKeeper) {
@@ -43,11 +45,22 @@ func (w *BankWrapper) SendCoinsFromModuleToAccountNoWithdrawal(ctx context.Conte
 	return nil
 }
 
-// UndelegateCoinsFromModuleToAccount intercepts all principal undelegations and
-// creates EVM withdrawal to the user account.
-// TODO(corver): This is unexpected in magellan upgrade, must be implemented in drake.
+// UndelegateCoinsFromModuleToAccount intercepts all principal undelegations (on unbonding completion)
+// and creates EVM withdrawal to the user account and burns the funds from the module.
+// Undelegations are unexpected before the drake network upgrade, so those are not intercepted.
 func (w *BankWrapper) UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
-	log.Error(ctx, "Unexpected call to DelegateCoinsFromAccountToModule [BUG]", nil, "sender", senderModule, "recipient", recipientAddr, "amt", amt)
+	if w.UpgradeKeeper == nil {
+		return errors.New("nil UpgradeKeeper [BUG]")
+	}
+
+	drake, err := evmstakingtypes.IsDrake(ctx, w.UpgradeKeeper)
+	if err != nil {
+		return err
+	} else if drake {
+		return w.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	}
+
+	log.Error(ctx, "Unexpected call to UndelegateCoinsFromModuleToAccount before drake [BUG]", nil, "sender", senderModule, "recipient", recipientAddr, "amt", amt)
 
 	if err := w.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
 		return errors.Wrap(err, "undelegate coins from module to account")
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	evmstakingtypes "github.com/omni-network/omni/halo/evmstaking/types"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/tutil"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,6 +13,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/stretchr/testify/require"
 )
@@ -51,20 +54,58 @@ func TestWrapper(t *testing.T) {
 				return nil
 			})
 
-			w := NewBankWrapper(keeper)
+			w := NewBankWrapper(keeper, testUpgradeKeeper(evmstakingtypes.DrakeVersion))
 			w.SetEVMEngineKeeper(engKeeper)
-			err := w.SendCoinsFromModuleToAccount(context.Background(), module, address.Bytes(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tt.arg)))
-			require.NoError(t, err)
-			require.True(t, burnt)
-			if tt.gwei > 0 {
-				require.True(t, withdrawn)
-			} else {
-				require.False(t, withdrawn)
+			coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, tt.arg))
+
+			// Both normal transfers and undelegations create withdrawals.
+			for _, send := range []func(context.Context, string, sdk.AccAddress, sdk.Coins) error{
+				w.SendCoinsFromModuleToAccount,
+				w.UndelegateCoinsFromModuleToAccount,
+			} {
+				burnt, withdrawn = false, false
+				err := send(log.WithNoopLogger(context.Background()), module, address.Bytes(), coins)
+				require.NoError(t, err)
+				require.True(t, burnt)
+				if tt.gwei > 0 {
+					require.True(t, withdrawn)
+				} else {
+					require.False(t, withdrawn)
+				}
 			}
 		})
 	}
 }
 
+func TestWrapperPreDrake(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	var undelegated bool
+	keeper := testBankKeeper{
+		BurnFunc: func(context.Context, string, sdk.Coins) error {
+			require.Fail(t, "unexpected burn")
+			return nil
+		},
+		UndelegateFunc: func(context.Context, string, sdk.AccAddress, sdk.Coins) error {
+			undelegated = true
+			return nil
+		},
+	}
+
+	w := NewBankWrapper(keeper, testUpgradeKeeper(evmstakingtypes.DrakeVersion-1))
+	w.SetEVMEngineKeeper(testEVMEngKeeper(func(context.Context, common.Address, uint64) error {
+		require.Fail(t, "unexpected withdrawal")
+		return nil
+	}))
+
+	// Undelegations are not intercepted before drake.
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(params.Ether)))
+	err := w.UndelegateCoinsFromModuleToAccount(ctx, "module", tutil.RandomAddress().Bytes(), coins)
+	require.NoError(t, err)
+	require.True(t, undelegated)
+}
+
 func TestToGwei(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -97,9 +138,21 @@ func (t testEVMEngKeeper) InsertWithdrawal(ctx context.Context, withdrawalAddr c
 
 type testBankKeeper struct {
 	bankkeeper.Keeper
-	BurnFunc func(ctx context.Context, moduleName string, amt sdk.Coins) error
+	BurnFunc       func(ctx context.Context, moduleName string, amt sdk.Coins) error
+	UndelegateFunc func(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 func (k testBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
 	return k.BurnFunc(ctx, moduleName, amt)
 }
+
+func (k testBankKeeper) UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.UndelegateFunc(ctx, senderModule, recipientAddr, amt)
+}
+
+// testUpgradeKeeper returns the evmstaking module consensus version.
+type testUpgradeKeeper uint64
+
+func (v testUpgradeKeeper) GetModuleVersionMap(context.Context) (module.VersionMap, error) {
+	return module.VersionMap{evmstakingtypes.ModuleName: uint64(v)}, nil
+}
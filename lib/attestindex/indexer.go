@@ -0,0 +1,220 @@
+// Package attestindex provides an off-chain index of approved attestations by cross-chain message ID
+// and source transaction hash. It is fed by the consensus chain provider and fetches the attested
+// xblocks from source chains, since consensus chain state only contains attestation message roots.
+//
+// It allows explorers and bridge backends to answer "which attestation covers this xmsg" and to
+// obtain the merkle proof required to submit it.
+package attestindex
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Indexer indexes approved attestations and the messages of their xblocks in memory.
+// It retains the most recent maxAttestations, evicting the oldest first.
+type Indexer struct {
+	cprov           cchain.Provider
+	xprov           xchain.Provider
+	maxAttestations int
+
+	mu       sync.RWMutex
+	entries  map[xchain.AttestHeader]entry
+	order    []xchain.AttestHeader // Insertion order used for eviction
+	byMsgID  map[xchain.MsgID]xchain.AttestHeader
+	byTxHash map[common.Hash][]xchain.MsgID
+}
+
+// entry is an indexed attestation and the message tree of its xblock.
+type entry struct {
+	Attestation xchain.Attestation
+	Msgs        []xchain.Msg
+	Tree        xchain.MsgTree // Zero if the xblock doesn't contain messages
+}
+
+// New returns a new indexer retaining at most maxAttestations.
+func New(cprov cchain.Provider, xprov xchain.Provider, maxAttestations int) (*Indexer, error) {
+	if maxAttestations <= 0 {
+		return nil, errors.New("invalid max attestations")
+	}
+
+	return &Indexer{
+		cprov:           cprov,
+		xprov:           xprov,
+		maxAttestations: maxAttestations,
+		entries:         make(map[xchain.AttestHeader]entry),
+		byMsgID:         make(map[xchain.MsgID]xchain.AttestHeader),
+		byTxHash:        make(map[common.Hash][]xchain.MsgID),
+	}, nil
+}
+
+// Start starts goroutines that index approved attestations of the provided chain version
+// from the attest offset (inclusive) forever. It returns immediately.
+func (i *Indexer) Start(ctx context.Context, chainVer xchain.ChainVersion, fromOffset uint64) {
+	i.cprov.StreamAsync(ctx, chainVer, fromOffset, "attestindex", i.index)
+}
+
+// SubmissionByMsgID returns the submission of the message with the provided ID,
+// including the attestation that covers it and the merkle proof required to submit it,
+// or false if the message isn't indexed.
+//
+// Note the submission contains all attestation signatures, use attestverify.QuorumSigs to reduce gas costs.
+func (i *Indexer) SubmissionByMsgID(msgID xchain.MsgID) (xchain.Submission, bool, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.submissionUnsafe(msgID)
+}
+
+// SubmissionsByTxHash returns the submissions of all indexed messages emitted by the provided source chain transaction.
+func (i *Indexer) SubmissionsByTxHash(txHash common.Hash) ([]xchain.Submission, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var resp []xchain.Submission
+	for _, msgID := range i.byTxHash[txHash] {
+		sub, ok, err := i.submissionUnsafe(msgID)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, errors.New("indexed msg not found [BUG]")
+		}
+
+		resp = append(resp, sub)
+	}
+
+	return resp, nil
+}
+
+// submissionUnsafe returns the submission of the message with the provided ID.
+// It assumes the read lock is held.
+func (i *Indexer) submissionUnsafe(msgID xchain.MsgID) (xchain.Submission, bool, error) {
+	header, ok := i.byMsgID[msgID]
+	if !ok {
+		return xchain.Submission{}, false, nil
+	}
+
+	e, ok := i.entries[header]
+	if !ok {
+		return xchain.Submission{}, false, errors.New("indexed attestation not found [BUG]")
+	}
+
+	var msg xchain.Msg
+	for _, m := range e.Msgs {
+		if m.MsgID == msgID {
+			msg = m
+			break
+		}
+	}
+
+	multi, err := e.Tree.Proof([]xchain.Msg{msg})
+	if err != nil {
+		return xchain.Submission{}, false, errors.Wrap(err, "msg proof")
+	}
+
+	attRoot, err := e.Attestation.AttestationRoot()
+	if err != nil {
+		return xchain.Submission{}, false, err
+	}
+
+	return xchain.Submission{
+		AttestationRoot: attRoot,
+		ValidatorSetID:  e.Attestation.ValidatorSetID,
+		AttHeader:       e.Attestation.AttestHeader,
+		BlockHeader:     e.Attestation.BlockHeader,
+		Msgs:            []xchain.Msg{msg},
+		Proof:           multi.Proof,
+		ProofFlags:      multi.ProofFlags,
+		Signatures:      e.Attestation.Signatures,
+		DestChainID:     msg.DestChainID,
+	}, true, nil
+}
+
+// index fetches the attested xblock and indexes its messages.
+// It is the cchain.ProviderCallback, so it is retried on error.
+func (i *Indexer) index(ctx context.Context, att xchain.Attestation) error {
+	block, ok, err := i.xprov.GetBlock(ctx, xchain.ProviderRequest{
+		ChainID:   att.ChainID,
+		Height:    att.BlockHeight,
+		ConfLevel: att.ChainVersion.ConfLevel,
+	})
+	if err != nil {
+		return errors.Wrap(err, "get block")
+	} else if !ok {
+		return errors.New("attested block not available", "chain", att.ChainID, "height", att.BlockHeight)
+	} else if block.BlockHash != att.BlockHash {
+		return errors.New("attested block hash mismatch", "chain", att.ChainID, "height", att.BlockHeight)
+	}
+
+	var tree xchain.MsgTree
+	if len(block.Msgs) > 0 {
+		tree, err = xchain.NewMsgTree(block.Msgs)
+		if err != nil {
+			return errors.Wrap(err, "msg tree")
+		}
+
+		if tree.MsgRoot() != att.MsgRoot {
+			return errors.New("attested msg root mismatch", "chain", att.ChainID, "height", att.BlockHeight)
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if _, ok := i.entries[att.AttestHeader]; ok {
+		return nil // Already indexed, e.g. finalized override streamed as fuzzy attestation.
+	}
+
+	i.entries[att.AttestHeader] = entry{
+		Attestation: att,
+		Msgs:        block.Msgs,
+		Tree:        tree,
+	}
+	i.order = append(i.order, att.AttestHeader)
+
+	for _, msg := range block.Msgs {
+		if _, ok := i.byMsgID[msg.MsgID]; ok {
+			continue // Already indexed by another attestation.
+		}
+		i.byMsgID[msg.MsgID] = att.AttestHeader
+		i.byTxHash[msg.TxHash] = append(i.byTxHash[msg.TxHash], msg.MsgID)
+	}
+
+	for len(i.order) > i.maxAttestations {
+		i.evictUnsafe(i.order[0])
+		i.order = i.order[1:]
+	}
+
+	return nil
+}
+
+// evictUnsafe removes the attestation and its messages from the index.
+// It assumes the write lock is held.
+func (i *Indexer) evictUnsafe(header xchain.AttestHeader) {
+	e, ok := i.entries[header]
+	if !ok {
+		return
+	}
+	delete(i.entries, header)
+
+	for _, msg := range e.Msgs {
+		if i.byMsgID[msg.MsgID] != header {
+			continue // Indexed by another attestation.
+		}
+		delete(i.byMsgID, msg.MsgID)
+
+		msgIDs := slices.DeleteFunc(i.byTxHash[msg.TxHash], func(id xchain.MsgID) bool { return id == msg.MsgID })
+		if len(msgIDs) == 0 {
+			delete(i.byTxHash, msg.TxHash)
+		} else {
+			i.byTxHash[msg.TxHash] = msgIDs
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package attestindex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexer(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const chainID = 100
+	txHash := tutil.RandomHash()
+
+	// Block 1 contains two msgs emitted by the same tx, block 2 contains a single msg, block 3 is empty.
+	block1 := newBlock(chainID, 1, newMsg(chainID, 1, txHash, 1), newMsg(chainID, 2, txHash, 2))
+	block2 := newBlock(chainID, 2, newMsg(chainID, 3, tutil.RandomHash(), 1))
+	block3 := newBlock(chainID, 3)
+
+	xprov := &testXProvider{blocks: make(map[uint64]xchain.Block)}
+	for _, block := range []xchain.Block{block1, block2, block3} {
+		xprov.blocks[block.BlockHeight] = block
+	}
+
+	idx, err := New(nil, xprov, 2)
+	require.NoError(t, err)
+
+	for i, block := range []xchain.Block{block1, block2} {
+		require.NoError(t, idx.index(ctx, newAttestation(t, block, uint64(i+1))))
+	}
+
+	// Msgs are found with valid proofs
+	for _, msg := range append(block1.Msgs, block2.Msgs...) {
+		sub, ok, err := idx.SubmissionByMsgID(msg.MsgID)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []xchain.Msg{msg}, sub.Msgs)
+		require.Equal(t, msg.DestChainID, sub.DestChainID)
+		require.NoError(t, xchain.VerifySubmission(sub))
+	}
+
+	subs, err := idx.SubmissionsByTxHash(txHash)
+	require.NoError(t, err)
+	require.Len(t, subs, 2)
+
+	// Mismatching block hash or msg root is an error
+	att := newAttestation(t, block3, 3)
+	att.BlockHash = tutil.RandomHash()
+	require.ErrorContains(t, idx.index(ctx, att), "block hash mismatch")
+
+	att = newAttestation(t, block2, 3)
+	att.MsgRoot = tutil.RandomHash()
+	require.ErrorContains(t, idx.index(ctx, att), "msg root mismatch")
+
+	// Indexing the empty block evicts block1
+	require.NoError(t, idx.index(ctx, newAttestation(t, block3, 3)))
+
+	_, ok, err := idx.SubmissionByMsgID(block1.Msgs[0].MsgID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	subs, err = idx.SubmissionsByTxHash(txHash)
+	require.NoError(t, err)
+	require.Empty(t, subs)
+
+	_, ok, err = idx.SubmissionByMsgID(block2.Msgs[0].MsgID)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func newMsg(chainID uint64, offset uint64, txHash [32]byte, logIndex uint64) xchain.Msg {
+	return xchain.Msg{
+		MsgID: xchain.MsgID{
+			StreamID: xchain.StreamID{
+				SourceChainID: chainID,
+				DestChainID:   chainID + 1,
+				ShardID:       xchain.ShardFinalized0,
+			},
+			StreamOffset: offset,
+		},
+		SourceMsgSender: tutil.RandomAddress(),
+		DestAddress:     tutil.RandomAddress(),
+		Data:            tutil.RandomBytes(32),
+		DestGasLimit:    100_000,
+		TxHash:          txHash,
+		LogIndex:        logIndex,
+	}
+}
+
+func newBlock(chainID uint64, height uint64, msgs ...xchain.Msg) xchain.Block {
+	return xchain.Block{
+		BlockHeader: xchain.BlockHeader{
+			ChainID:     chainID,
+			BlockHeight: height,
+			BlockHash:   tutil.RandomHash(),
+		},
+		Msgs: msgs,
+	}
+}
+
+func newAttestation(t *testing.T, block xchain.Block, offset uint64) xchain.Attestation {
+	t.Helper()
+
+	att := xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ConsensusChainID: 1,
+			ChainVersion:     xchain.ChainVersion{ID: block.ChainID, ConfLevel: xchain.ConfFinalized},
+			AttestOffset:     offset,
+		},
+		BlockHeader:    block.BlockHeader,
+		ValidatorSetID: 1,
+	}
+
+	if len(block.Msgs) > 0 {
+		tree, err := xchain.NewMsgTree(block.Msgs)
+		require.NoError(t, err)
+		att.MsgRoot = tree.MsgRoot()
+	}
+
+	return att
+}
+
+type testXProvider struct {
+	xchain.Provider
+	blocks map[uint64]xchain.Block
+}
+
+func (p *testXProvider) GetBlock(_ context.Context, req xchain.ProviderRequest) (xchain.Block, bool, error) {
+	block, ok := p.blocks[req.Height]
+	return block, ok, nil
+}
@@ -0,0 +1,104 @@
+// Package attestverify provides light-client style verification of approved attestations.
+// It allows off-chain consumers (monitors, bridges, explorers) to verify attestations independently
+// instead of trusting the consensus chain endpoint they query.
+package attestverify
+
+import (
+	"sort"
+
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// quorum returns the power that signatures must exceed to reach quorum, i.e., 2/3 of the total power.
+// This matches the portal contract's quorum check.
+func quorum(valSet []cchain.PortalValidator) int64 {
+	var totalPower int64
+	for _, val := range valSet {
+		totalPower += val.Power
+	}
+
+	return totalPower * 2 / 3
+}
+
+// VerifyQuorum returns an error if the attestation isn't signed by a quorum of the provided validator set.
+// All signatures must be valid, unique and by members of the validator set.
+func VerifyQuorum(att xchain.Attestation, valSet []cchain.PortalValidator) error {
+	attRoot, err := att.AttestationRoot()
+	if err != nil {
+		return errors.Wrap(err, "attestation root")
+	}
+
+	powers := make(map[common.Address]int64)
+	for _, val := range valSet {
+		powers[val.Address] = val.Power
+	}
+
+	var sum int64
+	duplicates := make(map[common.Address]bool)
+	for _, sig := range att.Signatures {
+		power, ok := powers[sig.ValidatorAddress]
+		if !ok {
+			return errors.New("signature not in validator set", "val", sig.ValidatorAddress)
+		} else if duplicates[sig.ValidatorAddress] {
+			return errors.New("duplicate validator signature", "val", sig.ValidatorAddress)
+		}
+		duplicates[sig.ValidatorAddress] = true
+
+		ok, err := k1util.Verify(sig.ValidatorAddress, attRoot, sig.Signature)
+		if err != nil {
+			return errors.Wrap(err, "verify signature", "val", sig.ValidatorAddress)
+		} else if !ok {
+			return errors.New("invalid signature", "val", sig.ValidatorAddress)
+		}
+
+		sum += power
+	}
+
+	if need := quorum(valSet); sum <= need {
+		return errors.New("quorum not reached", "got", sum, "need", need)
+	}
+
+	return nil
+}
+
+// QuorumSigs returns the minimum number of signatures required to reach quorum.
+// Note it doesn't verify the signatures, see VerifyQuorum.
+func QuorumSigs(valSet []cchain.PortalValidator, signatures []xchain.SigTuple) ([]xchain.SigTuple, error) {
+	powers := make(map[common.Address]int64)
+	for _, val := range valSet {
+		powers[val.Address] = val.Power
+	}
+
+	quorum := quorum(valSet)
+
+	// Order signatures by power decreasing
+	sort.Slice(signatures, func(i, j int) bool {
+		return powers[signatures[i].ValidatorAddress] > powers[signatures[j].ValidatorAddress]
+	})
+
+	// Select minimum signatures to reach quorum
+	var resp []xchain.SigTuple
+	var sum int64
+	for _, sig := range signatures {
+		resp = append(resp, sig)
+		power, ok := powers[sig.ValidatorAddress]
+		if !ok {
+			return nil, errors.New("signature not in validator set [BUG]", "val", sig.ValidatorAddress)
+		}
+		sum += power
+		if sum > quorum {
+			break
+		}
+	}
+
+	if sum <= quorum {
+		return nil, errors.New("quorum not reached", "got", sum, "need", quorum)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,81 @@
+package attestverify_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/omni-network/omni/lib/attestverify"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuorumSigs(t *testing.T) {
+	t.Parallel()
+
+	const total = 10
+	var vals []cchain.PortalValidator
+	for i := range total {
+		val := cchain.PortalValidator{
+			Address: common.BytesToAddress([]byte{byte(i)}),
+			Power:   int64(i), // Power from 0 and 9
+		}
+		vals = append(vals, val)
+	}
+
+	// totalPower := 0 + 1 + 2 + 3 + 4 + 5 + 6 + 7 + 8 + 9 // 45
+	// quorum := totalPower * 2 / 3                        // +30
+
+	tests := []struct {
+		input  []int
+		output []int
+	}{
+		{
+			input:  []int{},
+			output: []int{},
+		},
+		{
+			input:  []int{0},
+			output: []int{},
+		},
+		{
+			input:  []int{9, 8, 7, 6, 5, 4, 3, 2, 1, 0}, // 45
+			output: []int{9, 8, 7, 6, 5},                // 35
+		},
+		{
+			input:  []int{9, 8, 7, 6, 1, 0}, // 31
+			output: []int{9, 8, 7, 6, 1},    // 31
+		},
+		{
+			input:  []int{1, 2, 3, 4, 5, 6, 7, 8}, // 36
+			output: []int{8, 7, 6, 5, 4, 3},       // 33
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			t.Parallel()
+
+			var sigs []xchain.SigTuple
+			for _, i := range test.input {
+				sigs = append(sigs, xchain.SigTuple{ValidatorAddress: vals[i].Address})
+			}
+
+			actual, err := attestverify.QuorumSigs(vals, sigs)
+			if len(test.output) == 0 {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []int
+			for _, sig := range actual {
+				got = append(got, int(sig.ValidatorAddress[19]))
+			}
+			require.EqualValues(t, test.output, got)
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package attestverify
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+//nolint:gochecknoglobals // Static ABI types
+var portalABI = mustGetABI(bindings.OmniPortalMetaData)
+
+// Verifier verifies approved attestations against validator sets it trusts.
+//
+// Starting from a trusted validator set, it follows validator set transitions by verifying
+// consensus chain attestations: a subsequent validator set is only trusted once the consensus chain
+// xblock containing its addValidatorSet message is attested to by a quorum of an already trusted set.
+// This is the same trust model as the portal contracts.
+type Verifier struct {
+	cprov     cchain.Provider
+	cChainVer xchain.ChainVersion
+	trustedID uint64
+
+	mu       sync.Mutex
+	valSets  map[uint64][]cchain.PortalValidator // Trusted validator sets by ID
+	latestID uint64                              // Latest trusted validator set ID
+	offset   uint64                              // Next consensus chain attest offset to follow
+}
+
+// New returns a new verifier trusting the provided validator set ID which is fetched from the provider.
+// Consensus chain attestations are followed from the first offset, skipping those signed
+// by validator sets before the trusted set.
+func New(ctx context.Context, cprov cchain.Provider, cChainID uint64, trustedID uint64) (*Verifier, error) {
+	vals, ok, err := cprov.PortalValidatorSet(ctx, trustedID)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch trusted validator set")
+	} else if !ok {
+		return nil, errors.New("trusted validator set not found", "id", trustedID)
+	}
+
+	return NewFromTrusted(cprov, cChainID, trustedID, vals, 1)
+}
+
+// NewFromTrusted returns a new verifier trusting the provided validator set, following consensus chain
+// attestations from the provided offset.
+func NewFromTrusted(cprov cchain.Provider, cChainID uint64, trustedID uint64, trusted []cchain.PortalValidator, fromOffset uint64) (*Verifier, error) {
+	if len(trusted) == 0 {
+		return nil, errors.New("empty trusted validator set")
+	} else if fromOffset == 0 {
+		return nil, errors.New("invalid zero offset")
+	}
+
+	for _, val := range trusted {
+		if err := val.Verify(); err != nil {
+			return nil, errors.Wrap(err, "verify trusted validator")
+		}
+	}
+
+	return &Verifier{
+		cprov:     cprov,
+		cChainVer: xchain.ChainVersion{ID: cChainID, ConfLevel: xchain.ConfFinalized},
+		trustedID: trustedID,
+		valSets:   map[uint64][]cchain.PortalValidator{trustedID: trusted},
+		latestID:  trustedID,
+		offset:    fromOffset,
+	}, nil
+}
+
+// Verify returns an error if the attestation isn't signed by a quorum of its trusted validator set.
+// It follows validator set transitions if the attestation's validator set isn't trusted yet.
+func (v *Verifier) Verify(ctx context.Context, att xchain.Attestation) error {
+	vals, err := v.ValidatorSet(ctx, att.ValidatorSetID)
+	if err != nil {
+		return err
+	}
+
+	return VerifyQuorum(att, vals)
+}
+
+// ValidatorSet returns the trusted validator set by ID, following validator set transitions if required.
+func (v *Verifier) ValidatorSet(ctx context.Context, valSetID uint64) ([]cchain.PortalValidator, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if valSetID < v.trustedID {
+		return nil, errors.New("validator set before trusted", "id", valSetID, "trusted", v.trustedID)
+	}
+
+	for v.latestID < valSetID {
+		progress, err := v.followUnsafe(ctx, valSetID)
+		if err != nil {
+			return nil, err
+		} else if !progress {
+			return nil, errors.New("validator set not attested yet", "id", valSetID, "latest", v.latestID)
+		}
+	}
+
+	return v.valSets[valSetID], nil
+}
+
+// followUnsafe verifies the next page of consensus chain attestations, trusting any subsequent validator sets,
+// until the target validator set is trusted. It returns false if no attestations are available.
+// It is unsafe since it assumes the lock is held.
+func (v *Verifier) followUnsafe(ctx context.Context, target uint64) (bool, error) {
+	atts, err := v.cprov.AttestationsFrom(ctx, v.cChainVer, v.offset)
+	if err != nil {
+		return false, errors.Wrap(err, "fetch consensus attestations")
+	} else if len(atts) == 0 {
+		return false, nil
+	}
+
+	for _, att := range atts {
+		if att.AttestOffset != v.offset {
+			return false, errors.New("unexpected consensus attest offset", "expect", v.offset, "got", att.AttestOffset)
+		}
+
+		if err := v.followAttUnsafe(ctx, att); err != nil {
+			return false, errors.Wrap(err, "follow consensus attestation", "offset", att.AttestOffset)
+		}
+
+		v.offset++
+
+		if v.latestID >= target {
+			break
+		}
+	}
+
+	return true, nil
+}
+
+// followAttUnsafe verifies the consensus chain attestation and its xblock, trusting the next validator set
+// if included. It is unsafe since it assumes the lock is held.
+func (v *Verifier) followAttUnsafe(ctx context.Context, att xchain.Attestation) error {
+	vals, ok := v.valSets[att.ValidatorSetID]
+	if !ok && att.ValidatorSetID < v.trustedID {
+		return nil // Skip attestations before trusted set.
+	} else if !ok {
+		return errors.New("consensus attestation by untrusted validator set", "id", att.ValidatorSetID)
+	}
+
+	if err := VerifyQuorum(att, vals); err != nil {
+		return err
+	}
+
+	block, ok, err := v.cprov.XBlock(ctx, att.AttestOffset, false)
+	if err != nil {
+		return errors.Wrap(err, "fetch xblock")
+	} else if !ok {
+		return errors.New("xblock not found")
+	}
+
+	tree, err := xchain.NewMsgTree(block.Msgs)
+	if err != nil {
+		return errors.Wrap(err, "msg tree")
+	} else if tree.MsgRoot() != att.MsgRoot {
+		return errors.New("xblock msg root mismatch")
+	}
+
+	for _, msg := range block.Msgs {
+		valSetID, vals, ok, err := decodeValSet(msg.Data)
+		if err != nil {
+			return err
+		} else if !ok || valSetID <= v.latestID {
+			continue
+		} else if valSetID != v.latestID+1 {
+			return errors.New("non-sequential validator set", "id", valSetID, "latest", v.latestID)
+		}
+
+		v.valSets[valSetID] = vals
+		v.latestID = valSetID
+	}
+
+	return nil
+}
+
+// decodeValSet returns the validator set ID and validators if the data is an addValidatorSet call.
+func decodeValSet(data []byte) (uint64, []cchain.PortalValidator, bool, error) {
+	method := portalABI.Methods["addValidatorSet"]
+	if len(data) < 4 || !bytes.Equal(data[:4], method.ID) {
+		return 0, nil, false, nil
+	}
+
+	var args struct {
+		ValSetId   uint64               //nolint:revive // Match ABI argument name
+		Validators []bindings.Validator //nolint:revive // Match ABI argument name
+	}
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return 0, nil, false, errors.Wrap(err, "unpack validator set")
+	} else if err := method.Inputs.Copy(&args, values); err != nil {
+		return 0, nil, false, errors.Wrap(err, "copy validator set")
+	}
+
+	resp := make([]cchain.PortalValidator, 0, len(args.Validators))
+	for _, val := range args.Validators {
+		pv := cchain.PortalValidator{
+			Address: val.Addr,
+			Power:   int64(val.Power), //nolint:gosec // Power always fits in int64
+		}
+		if err := pv.Verify(); err != nil {
+			return 0, nil, false, errors.Wrap(err, "verify validator")
+		}
+		resp = append(resp, pv)
+	}
+
+	return args.ValSetId, resp, true, nil
+}
+
+// mustGetABI returns the metadata's ABI as an abi.ABI type.
+// It panics on error.
+func mustGetABI(metadata *bind.MetaData) *abi.ABI {
+	abi, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	return abi
+}
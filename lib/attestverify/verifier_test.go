@@ -0,0 +1,219 @@
+package attestverify_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/attestverify"
+	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+
+	"github.com/stretchr/testify/require"
+)
+
+const cChainID = 1_000_001
+
+func TestVerifier(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	set1 := newValSet(t, 4)
+	set2 := newValSet(t, 3)
+	set3 := newValSet(t, 5)
+
+	cprov := &mockProvider{
+		valSets: map[uint64]valSet{1: set1},
+		blocks:  make(map[uint64]xchain.Block),
+	}
+
+	// Consensus chain offset 1 by set 1 adds set 2
+	cprov.addConsensusAtt(t, 1, set1, valSetMsg(t, 2, set2))
+	// Consensus chain offset 2 by set 2 adds set 3, but not signed by quorum.
+	cprov.addConsensusAtt(t, 2, set2.sub(1), valSetMsg(t, 3, set3))
+
+	verifier, err := attestverify.New(ctx, cprov, cChainID, 1)
+	require.NoError(t, err)
+
+	// Attestations by set 1 and set 2 are verified
+	require.NoError(t, verifier.Verify(ctx, newAtt(t, 1, set1)))
+	require.NoError(t, verifier.Verify(ctx, newAtt(t, 2, set2)))
+
+	// Attestations without quorum fail
+	require.ErrorContains(t, verifier.Verify(ctx, newAtt(t, 2, set2.sub(2))), "quorum not reached")
+
+	// Attestations by other validators fail
+	require.ErrorContains(t, verifier.Verify(ctx, newAtt(t, 2, set3)), "not in validator set")
+
+	// Set 3 isn't trusted since its transition isn't signed by quorum
+	require.ErrorContains(t, verifier.Verify(ctx, newAtt(t, 3, set3)), "quorum not reached")
+
+	// Set before trusted
+	require.ErrorContains(t, verifier.Verify(ctx, newAtt(t, 0, set1)), "before trusted")
+}
+
+func TestVerifierMsgRootMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	set1 := newValSet(t, 2)
+	set2 := newValSet(t, 2)
+
+	cprov := &mockProvider{
+		valSets: map[uint64]valSet{1: set1},
+		blocks:  make(map[uint64]xchain.Block),
+	}
+
+	cprov.addConsensusAtt(t, 1, set1, valSetMsg(t, 2, set2))
+
+	// Endpoint returns a different set 2 than the one attested to
+	forged := cprov.blocks[1]
+	forged.Msgs = []xchain.Msg{valSetMsg(t, 2, newValSet(t, 2))}
+	cprov.blocks[1] = forged
+
+	verifier, err := attestverify.New(ctx, cprov, cChainID, 1)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, verifier.Verify(ctx, newAtt(t, 2, set2)), "msg root mismatch")
+}
+
+type valSet struct {
+	keys []crypto.PrivKey
+	vals []cchain.PortalValidator
+}
+
+func newValSet(t *testing.T, n int) valSet {
+	t.Helper()
+
+	var resp valSet
+	for range n {
+		key := secp256k1.GenPrivKey()
+		addr, err := k1util.PubKeyToAddress(key.PubKey())
+		require.NoError(t, err)
+
+		resp.keys = append(resp.keys, key)
+		resp.vals = append(resp.vals, cchain.PortalValidator{Address: addr, Power: 10})
+	}
+
+	return resp
+}
+
+// sub returns a copy of the validator set with only the first n keys (for signing).
+func (s valSet) sub(n int) valSet {
+	return valSet{keys: s.keys[:n], vals: s.vals}
+}
+
+func (s valSet) sign(t *testing.T, att *xchain.Attestation) {
+	t.Helper()
+
+	attRoot, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	for i, key := range s.keys {
+		sig, err := k1util.Sign(key, attRoot)
+		require.NoError(t, err)
+
+		att.Signatures = append(att.Signatures, xchain.SigTuple{
+			ValidatorAddress: s.vals[i].Address,
+			Signature:        sig,
+		})
+	}
+}
+
+func newAtt(t *testing.T, valSetID uint64, signers valSet) xchain.Attestation {
+	t.Helper()
+
+	const chainID = 100
+	att := xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ConsensusChainID: cChainID,
+			ChainVersion:     xchain.ChainVersion{ID: chainID},
+			AttestOffset:     1,
+		},
+		BlockHeader:    xchain.BlockHeader{ChainID: chainID, BlockHeight: 1},
+		ValidatorSetID: valSetID,
+	}
+	signers.sign(t, &att)
+
+	return att
+}
+
+func valSetMsg(t *testing.T, valSetID uint64, set valSet) xchain.Msg {
+	t.Helper()
+
+	portalABI, err := bindings.OmniPortalMetaData.GetAbi()
+	require.NoError(t, err)
+
+	var vals []bindings.Validator
+	for _, val := range set.vals {
+		vals = append(vals, bindings.Validator{Addr: val.Address, Power: uint64(val.Power)})
+	}
+
+	data, err := portalABI.Pack("addValidatorSet", valSetID, vals)
+	require.NoError(t, err)
+
+	return xchain.Msg{
+		MsgID: xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: cChainID}, StreamOffset: valSetID},
+		Data:  data,
+	}
+}
+
+// mockProvider is a mock cchain.Provider only implementing the methods used by the verifier.
+type mockProvider struct {
+	cchain.Provider
+
+	valSets map[uint64]valSet
+	atts    []xchain.Attestation
+	blocks  map[uint64]xchain.Block
+}
+
+// addConsensusAtt adds a consensus chain xblock containing the msg and its attestation signed by the signers.
+func (m *mockProvider) addConsensusAtt(t *testing.T, valSetID uint64, signers valSet, msg xchain.Msg) {
+	t.Helper()
+
+	offset := uint64(len(m.atts) + 1)
+	block := xchain.Block{
+		BlockHeader: xchain.BlockHeader{ChainID: cChainID, BlockHeight: offset},
+		Msgs:        []xchain.Msg{msg},
+	}
+
+	tree, err := xchain.NewMsgTree(block.Msgs)
+	require.NoError(t, err)
+
+	att := xchain.Attestation{
+		AttestHeader: xchain.AttestHeader{
+			ConsensusChainID: cChainID,
+			ChainVersion:     xchain.ChainVersion{ID: cChainID, ConfLevel: xchain.ConfFinalized},
+			AttestOffset:     offset,
+		},
+		BlockHeader:    block.BlockHeader,
+		MsgRoot:        tree.MsgRoot(),
+		ValidatorSetID: valSetID,
+	}
+	signers.sign(t, &att)
+
+	m.atts = append(m.atts, att)
+	m.blocks[offset] = block
+}
+
+func (m *mockProvider) PortalValidatorSet(_ context.Context, valSetID uint64) ([]cchain.PortalValidator, bool, error) {
+	set, ok := m.valSets[valSetID]
+	return set.vals, ok, nil
+}
+
+func (m *mockProvider) AttestationsFrom(_ context.Context, chainVer xchain.ChainVersion, offset uint64) ([]xchain.Attestation, error) {
+	if chainVer.ID != cChainID || offset == 0 || offset > uint64(len(m.atts)) {
+		return nil, nil
+	}
+
+	return m.atts[offset-1:], nil
+}
+
+func (m *mockProvider) XBlock(_ context.Context, offset uint64, _ bool) (xchain.Block, bool, error) {
+	block, ok := m.blocks[offset]
+	return block, ok, nil
+}
@@ -164,11 +164,11 @@ func bindFlags(cmd *cobra.Command, v *viper.Viper) error {
 
 			val := v.Get(name)
 
-			// Special case handling of map[string]string flags.
-			if f.Value.Type() == "stringToString" {
+			// Special case handling of map[string]string and map[string]int64 flags.
+			if f.Value.Type() == "stringToString" || f.Value.Type() == "stringToInt64" {
 				strMap := v.GetStringMapString(name)
 				if len(strMap) == 0 {
-					// There is no way to set an empty value for Cobra's map[string]string flags.
+					// There is no way to set an empty value for Cobra's map flags.
 					// It must either not be set or be non-empty.
 					// So skip empty viper maps (as if not set) assuming the default value is empty.
 					continue
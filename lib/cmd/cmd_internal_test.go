@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBindMapFlags(t *testing.T) {
+	t.Parallel()
+
+	const config = `
+[weights]
+ethereum = 2
+base = 4
+
+[labels]
+foo = "bar"
+`
+
+	var (
+		weights map[string]int64
+		labels  map[string]string
+		empty   map[string]int64
+	)
+	cmd := &cobra.Command{}
+	cmd.Flags().StringToInt64Var(&weights, "weights", nil, "")
+	cmd.Flags().StringToStringVar(&labels, "labels", nil, "")
+	cmd.Flags().StringToInt64Var(&empty, "empty", map[string]int64{"default": 1}, "")
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(config)))
+
+	require.NoError(t, bindFlags(cmd, v))
+	require.Equal(t, map[string]int64{"ethereum": 2, "base": 4}, weights)
+	require.Equal(t, map[string]string{"foo": "bar"}, labels)
+	require.Equal(t, map[string]int64{"default": 1}, empty) // Not set in config
+}
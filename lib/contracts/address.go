@@ -37,6 +37,12 @@ const (
 	NameFeeOracleV2        = "fee-oracle-v2"
 )
 
+// Multicall3 returns the canonical Multicall3 deployment address, available on most EVM chains.
+// See https://github.com/mds1/multicall.
+func Multicall3() common.Address {
+	return common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+}
+
 type Versions struct {
 	Core      string
 	SolverNet string
@@ -46,6 +46,8 @@ var (
 	planUpgradeEvent = mustGetABI(bindings.UpgradeMetaData).Events["PlanUpgrade"]
 	createValEvent   = mustGetABI(bindings.StakingMetaData).Events["CreateValidator"]
 	editValEvent     = mustGetABI(bindings.StakingMetaData).Events["EditValidator"]
+	undelegateEvent  = mustGetABI(bindings.StakingMetaData).Events["Undelegate"]
+	redelegateEvent  = mustGetABI(bindings.StakingMetaData).Events["Redelegate"]
 )
 
 var _ EngineClient = (*engineMock)(nil)
@@ -175,6 +177,80 @@ func WithMockSelfDelegation(pubkey crypto.PubKey, ether int64) func(*engineMock)
 	return WithMockDelegation(pubkey, delegatorAddr, ether)
 }
 
+// WithMockUndelegation returns an option to add an undelegation event to the mock from the specified address.
+func WithMockUndelegation(validatorPubkey crypto.PubKey, delegatorAddr common.Address, ether int64) func(*engineMock) {
+	return func(mock *engineMock) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		wei := new(big.Int).Mul(big.NewInt(ether), big.NewInt(params.Ether))
+
+		valAddr, err := k1util.PubKeyToAddress(validatorPubkey)
+		if err != nil {
+			panic(errors.Wrap(err, "pubkey to address"))
+		}
+
+		data, err := undelegateEvent.Inputs.NonIndexed().Pack(wei)
+		if err != nil {
+			panic(errors.Wrap(err, "pack undelegate"))
+		}
+
+		contractAddr := common.HexToAddress(predeploys.Staking)
+		eventLog := types.Log{
+			Address: contractAddr,
+			Topics: []common.Hash{
+				undelegateEvent.ID,
+				common.HexToHash(delegatorAddr.Hex()), // delegator
+				common.HexToHash(valAddr.Hex()),       // validator
+			},
+			Data:  data,
+			Index: 400,
+		}
+
+		mock.pendingLogs[contractAddr] = append(mock.pendingLogs[contractAddr], eventLog)
+	}
+}
+
+// WithMockRedelegation returns an option to add a redelegation event to the mock from the specified address.
+func WithMockRedelegation(srcPubkey, dstPubkey crypto.PubKey, delegatorAddr common.Address, ether int64) func(*engineMock) {
+	return func(mock *engineMock) {
+		mock.mu.Lock()
+		defer mock.mu.Unlock()
+
+		wei := new(big.Int).Mul(big.NewInt(ether), big.NewInt(params.Ether))
+
+		srcAddr, err := k1util.PubKeyToAddress(srcPubkey)
+		if err != nil {
+			panic(errors.Wrap(err, "pubkey to address"))
+		}
+
+		dstAddr, err := k1util.PubKeyToAddress(dstPubkey)
+		if err != nil {
+			panic(errors.Wrap(err, "pubkey to address"))
+		}
+
+		data, err := redelegateEvent.Inputs.NonIndexed().Pack(wei)
+		if err != nil {
+			panic(errors.Wrap(err, "pack redelegate"))
+		}
+
+		contractAddr := common.HexToAddress(predeploys.Staking)
+		eventLog := types.Log{
+			Address: contractAddr,
+			Topics: []common.Hash{
+				redelegateEvent.ID,
+				common.HexToHash(delegatorAddr.Hex()), // delegator
+				common.HexToHash(srcAddr.Hex()),       // src validator
+				common.HexToHash(dstAddr.Hex()),       // dst validator
+			},
+			Data:  data,
+			Index: 500,
+		}
+
+		mock.pendingLogs[contractAddr] = append(mock.pendingLogs[contractAddr], eventLog)
+	}
+}
+
 func WithPortalRegister(network netconf.Network) func(*engineMock) {
 	return func(mock *engineMock) {
 		mock.mu.Lock()
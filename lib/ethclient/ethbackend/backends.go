@@ -86,12 +86,12 @@ func NewFireBackends(ctx context.Context, testnet types.Testnet, fireCl firebloc
 func BackendsFromNetwork(network netconf.Network, endpoints xchain.RPCEndpoints, privKeys ...*ecdsa.PrivateKey) (Backends, error) {
 	inner := make(map[uint64]*Backend)
 	for _, chain := range network.EVMChains() {
-		endpoint, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		urls, err := endpoints.AllByNameOrID(chain.Name, chain.ID)
 		if err != nil {
 			return Backends{}, err
 		}
 
-		ethCl, err := ethclient.Dial(chain.Name, endpoint)
+		ethCl, err := ethclient.DialMulti(chain.Name, urls)
 		if err != nil {
 			return Backends{}, errors.Wrap(err, "dial")
 		}
@@ -21,6 +21,20 @@ var (
 		Name:      "errors_total",
 		Help:      "Total number of errors returned by a Ethereum JSON-RPC by chain and endpoint",
 	}, []string{"chain", "endpoint"})
+
+	failoverCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "failovers_total",
+		Help:      "Total number of multi-endpoint failovers to another RPC endpoint by chain and endpoint",
+	}, []string{"chain", "endpoint"})
+
+	quorumMismatchCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "ethclient",
+		Name:      "quorum_mismatches_total",
+		Help:      "Total number of multi-endpoint quorum reads failing due to mismatching results by chain and endpoint",
+	}, []string{"chain", "endpoint"})
 )
 
 // latency returns a function that records the latency of an RPC call.
@@ -0,0 +1,413 @@
+package ethclient
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// defaultAttemptTimeout is the default timeout of a single endpoint attempt before failing over.
+	defaultAttemptTimeout = 10 * time.Second
+	// minBackoff and maxBackoff bound the duration an endpoint is considered unhealthy after consecutive errors.
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+	// latencyAlpha is the smoothing factor of the endpoint latency moving average.
+	latencyAlpha = 0.2
+)
+
+var _ Client = (*MultiClient)(nil)
+
+// MultiOption configures a MultiClient.
+type MultiOption func(*MultiClient)
+
+// WithQuorum returns an option requiring agreement from n endpoints for critical reads:
+// HeaderByType(finalized), HeaderByNumber (non-nil) and FilterLogs.
+// The default of 1 disables quorum reads.
+func WithQuorum(n int) MultiOption {
+	return func(m *MultiClient) {
+		m.quorum = n
+	}
+}
+
+// WithAttemptTimeout returns an option that sets the timeout of a single endpoint attempt
+// after which the next endpoint is tried. Subscriptions are not subject to this timeout.
+func WithAttemptTimeout(d time.Duration) MultiOption {
+	return func(m *MultiClient) {
+		m.timeout = d
+	}
+}
+
+// MultiClient is a Client backed by multiple RPC endpoints of the same chain.
+//
+// Endpoints are health-scored: calls are routed to healthy endpoints ordered by average latency,
+// failing over to the next endpoint on errors or attempt timeouts. Endpoints returning
+// errors are considered unhealthy for an exponential backoff period.
+// Optionally, critical reads require agreement from a quorum of endpoints.
+type MultiClient struct {
+	chain     string
+	endpoints []*multiEndpoint
+	quorum    int
+	timeout   time.Duration
+	now       func() time.Time
+}
+
+// multiEndpoint is a single RPC endpoint of a MultiClient and its health score.
+type multiEndpoint struct {
+	cl Client
+
+	mu             sync.Mutex
+	errs           int           // Consecutive errors
+	latency        time.Duration // Moving average latency of successful calls
+	unhealthyUntil time.Time
+}
+
+// NewMultiClient returns a new MultiClient of the provided clients in priority order.
+func NewMultiClient(chain string, clients []Client, opts ...MultiOption) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("no clients", "chain", chain)
+	}
+
+	m := &MultiClient{
+		chain:   chain,
+		quorum:  1,
+		timeout: defaultAttemptTimeout,
+		now:     time.Now,
+	}
+	for _, cl := range clients {
+		m.endpoints = append(m.endpoints, &multiEndpoint{cl: cl})
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.quorum < 1 || m.quorum > len(clients) {
+		return nil, errors.New("invalid quorum", "chain", chain, "quorum", m.quorum, "endpoints", len(clients))
+	}
+
+	return m, nil
+}
+
+// DialMulti connects clients to the given URLs. It returns a plain Wrapper if only a single URL is provided,
+// else a MultiClient.
+//
+// Note that similar to Dial, it doesn't return an error if it cannot connect to http(s) URLs.
+func DialMulti(chainName string, urls []string, opts ...MultiOption) (Client, error) {
+	closeAll := func(clients []Client) {
+		for _, cl := range clients {
+			cl.Close()
+		}
+	}
+
+	var clients []Client
+	for _, url := range urls {
+		cl, err := Dial(chainName, url)
+		if err != nil {
+			closeAll(clients)
+			return nil, err
+		}
+		clients = append(clients, cl)
+	}
+
+	// Validate options even if only a single URL is provided.
+	m, err := NewMultiClient(chainName, clients, opts...)
+	if err != nil {
+		closeAll(clients)
+		return nil, err
+	} else if len(clients) == 1 {
+		return clients[0], nil
+	}
+
+	return m, nil
+}
+
+// Address returns the address of the primary (first) endpoint.
+func (m *MultiClient) Address() string {
+	return m.endpoints[0].cl.Address()
+}
+
+// Close closes all endpoints.
+func (m *MultiClient) Close() {
+	for _, e := range m.endpoints {
+		e.cl.Close()
+	}
+}
+
+// HeaderByType returns the block header for the given head type.
+// Finalized headers require agreement from a quorum of endpoints.
+func (m *MultiClient) HeaderByType(ctx context.Context, typ HeadType) (*types.Header, error) {
+	const endpoint = "header_by_type"
+	fn := func(ctx context.Context, cl Client) (*types.Header, error) {
+		return cl.HeaderByType(ctx, typ)
+	}
+
+	if typ == HeadFinalized {
+		return quorumCall(ctx, m, endpoint, fn, headerKey)
+	}
+
+	return call(ctx, m, endpoint, true, fn)
+}
+
+// HeaderByNumber returns the block header for the given number, or latest if nil.
+// Headers of specific numbers require agreement from a quorum of endpoints.
+func (m *MultiClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	const endpoint = "header_by_number"
+	fn := func(ctx context.Context, cl Client) (*types.Header, error) {
+		return cl.HeaderByNumber(ctx, number)
+	}
+
+	if number != nil && number.Sign() >= 0 {
+		return quorumCall(ctx, m, endpoint, fn, headerKey)
+	}
+
+	return call(ctx, m, endpoint, true, fn)
+}
+
+// FilterLogs executes a filter query and requires agreement from a quorum of endpoints.
+func (m *MultiClient) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return quorumCall(ctx, m, "filter_logs", func(ctx context.Context, cl Client) ([]types.Log, error) {
+		return cl.FilterLogs(ctx, q)
+	}, logsKey)
+}
+
+// sorted returns the endpoints ordered by health: healthy endpoints first, then by average latency.
+func (m *MultiClient) sorted() []*multiEndpoint {
+	now := m.now()
+
+	type scored struct {
+		e       *multiEndpoint
+		healthy bool
+		latency time.Duration
+	}
+	scores := make([]scored, 0, len(m.endpoints))
+	for _, e := range m.endpoints {
+		healthy, latency := e.score(now)
+		scores = append(scores, scored{e: e, healthy: healthy, latency: latency})
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].healthy != scores[j].healthy {
+			return scores[i].healthy
+		}
+
+		return scores[i].latency < scores[j].latency
+	})
+
+	resp := make([]*multiEndpoint, 0, len(scores))
+	for _, s := range scores {
+		resp = append(resp, s.e)
+	}
+
+	return resp
+}
+
+// attempt calls fn on the endpoint, recording its health.
+func (m *MultiClient) attempt(ctx context.Context, e *multiEndpoint, timeout bool, fn func(context.Context, Client) error) error {
+	if timeout && m.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+	}
+
+	start := m.now()
+	err := fn(ctx, e.cl)
+	e.record(err, m.now().Sub(start), m.now())
+
+	return err
+}
+
+// do calls fn on each endpoint in health order until one succeeds.
+// It doesn't fail over if the parent context is canceled or the error is a deterministic node response.
+func (m *MultiClient) do(ctx context.Context, endpoint string, timeout bool, fn func(context.Context, Client) error) error {
+	var err error
+	for i, e := range m.sorted() {
+		if i > 0 {
+			failoverCount.WithLabelValues(m.chain, endpoint).Inc()
+		}
+
+		err = m.attempt(ctx, e, timeout, fn)
+		if err == nil {
+			return nil
+		} else if ctx.Err() != nil || !shouldFailover(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// call is a generic version of MultiClient.do returning a single result.
+func call[T any](ctx context.Context, m *MultiClient, endpoint string, timeout bool, fn func(context.Context, Client) (T, error)) (T, error) {
+	var resp T
+	err := m.do(ctx, endpoint, timeout, func(ctx context.Context, cl Client) error {
+		var err error
+		resp, err = fn(ctx, cl)
+
+		return err
+	})
+
+	return resp, err
+}
+
+// quorumCall calls fn on endpoints in health order until a quorum of them return results with identical keys.
+// The first quorum of endpoints is called concurrently, remaining endpoints are called one-by-one.
+func quorumCall[T any](ctx context.Context, m *MultiClient, endpoint string, fn func(context.Context, Client) (T, error), key func(T) (common.Hash, error)) (T, error) {
+	if m.quorum <= 1 {
+		return call(ctx, m, endpoint, true, fn)
+	}
+
+	type result struct {
+		Resp T
+		Key  common.Hash
+		Err  error
+	}
+
+	attempt := func(e *multiEndpoint) result {
+		var res result
+		res.Err = m.attempt(ctx, e, true, func(ctx context.Context, cl Client) error {
+			var err error
+			res.Resp, err = fn(ctx, cl)
+
+			return err
+		})
+		if res.Err == nil {
+			res.Key, res.Err = key(res.Resp)
+		}
+
+		return res
+	}
+
+	endpoints := m.sorted()
+
+	// Call the first quorum of endpoints concurrently.
+	results := make([]result, m.quorum)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = attempt(endpoints[i])
+		}()
+	}
+	wg.Wait()
+
+	// Then call remaining endpoints one-by-one until a quorum agrees.
+	next := m.quorum
+	for {
+		var lastErr error
+		counts := make(map[common.Hash]int)
+		for _, res := range results {
+			if res.Err != nil {
+				lastErr = res.Err
+				continue
+			}
+
+			counts[res.Key]++
+			if counts[res.Key] >= m.quorum {
+				return res.Resp, nil
+			}
+		}
+
+		var zero T
+		if ctx.Err() != nil {
+			return zero, errors.Wrap(ctx.Err(), "quorum call", "endpoint", endpoint)
+		} else if next >= len(endpoints) {
+			if len(counts) > 1 {
+				quorumMismatchCount.WithLabelValues(m.chain, endpoint).Inc()
+			}
+
+			return zero, errors.New("no quorum", "endpoint", endpoint, "quorum", m.quorum, "results", len(counts), "last_err", lastErr)
+		}
+
+		failoverCount.WithLabelValues(m.chain, endpoint).Inc()
+		results = append(results, attempt(endpoints[next]))
+		next++
+	}
+}
+
+// headerKey returns the header hash as its quorum key.
+func headerKey(h *types.Header) (common.Hash, error) {
+	if h == nil {
+		return common.Hash{}, errors.New("nil header")
+	}
+
+	return h.Hash(), nil
+}
+
+// logsKey returns the hash of the JSON encoded logs as their quorum key.
+func logsKey(logs []types.Log) (common.Hash, error) {
+	bz, err := json.Marshal(logs)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "marshal logs")
+	}
+
+	return crypto.Keccak256Hash(bz), nil
+}
+
+// shouldFailover returns true if the error warrants trying another endpoint.
+// JSON-RPC errors returned by the node (e.g. execution reverted, nonce too low) are deterministic
+// and therefore returned as is, except for rate limiting and internal errors.
+func shouldFailover(err error) bool {
+	if errors.Is(err, ethereum.NotFound) {
+		return true // Other endpoints might be ahead.
+	}
+
+	var rpcErr rpc.Error
+	if !errors.As(err, &rpcErr) {
+		return true // Transport error
+	}
+
+	const (
+		codeInternal      = -32603
+		codeLimitExceeded = -32005
+	)
+
+	return rpcErr.ErrorCode() == codeInternal || rpcErr.ErrorCode() == codeLimitExceeded
+}
+
+// score returns whether the endpoint is healthy and its average latency.
+func (e *multiEndpoint) score(now time.Time) (bool, time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return !now.Before(e.unhealthyUntil), e.latency
+}
+
+// record updates the endpoint health given the result of a call.
+// Errors that don't warrant failover are considered healthy responses.
+// NotFound errors are neither healthy nor unhealthy.
+func (e *multiEndpoint) record(err error, latency time.Duration, now time.Time) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if errors.Is(err, ethereum.NotFound) || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if err != nil && shouldFailover(err) {
+		e.errs++
+		backoff := minBackoff << min(e.errs-1, 16)
+		e.unhealthyUntil = now.Add(min(backoff, maxBackoff))
+
+		return
+	}
+
+	e.errs = 0
+	e.unhealthyUntil = time.Time{}
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(latencyAlpha*float64(latency) + (1-latencyAlpha)*float64(e.latency))
+	}
+}
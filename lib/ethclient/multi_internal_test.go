@@ -0,0 +1,137 @@
+package ethclient
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiFailover(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	bad := &fakeClient{err: errors.New("connection refused")}
+	good := &fakeClient{height: 2}
+
+	now := time.Unix(1, 0)
+	m, err := NewMultiClient("test", []Client{bad, good})
+	require.NoError(t, err)
+	m.now = func() time.Time { return now }
+
+	// Fails over to the second endpoint.
+	height, err := m.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, height)
+	require.Equal(t, 1, bad.calls)
+	require.Equal(t, 1, good.calls)
+
+	// Unhealthy endpoint isn't tried first.
+	_, err = m.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, bad.calls)
+	require.Equal(t, 2, good.calls)
+
+	// After the backoff, the endpoint is healthy again.
+	bad.err = nil
+	bad.height = 1
+	now = now.Add(minBackoff)
+	height, err = m.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, height)
+	require.Equal(t, 2, bad.calls)
+
+	// Deterministic node errors are returned without failover.
+	bad.err = revertErr{}
+	_, err = m.BlockNumber(ctx)
+	require.ErrorContains(t, err, "execution reverted")
+	require.Equal(t, 3, bad.calls)
+	require.Equal(t, 2, good.calls)
+
+	// Canceled contexts are returned without failover.
+	bad.err = context.Canceled
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = m.BlockNumber(cancelled)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 2, good.calls)
+}
+
+func TestMultiQuorum(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	canonical := &types.Header{Number: big.NewInt(1)}
+	forked := &types.Header{Number: big.NewInt(1), Extra: []byte("fork")}
+
+	cl1 := &fakeClient{header: canonical}
+	cl2 := &fakeClient{header: forked}
+	cl3 := &fakeClient{header: canonical}
+
+	m, err := NewMultiClient("test", []Client{cl1, cl2, cl3}, WithQuorum(2))
+	require.NoError(t, err)
+
+	// First two disagree, so the third is required.
+	header, err := m.HeaderByNumber(ctx, big.NewInt(1))
+	require.NoError(t, err)
+	require.Equal(t, canonical.Hash(), header.Hash())
+	require.Equal(t, 1, cl3.calls)
+
+	// Latest headers don't require quorum.
+	total := func() int { return cl1.calls + cl2.calls + cl3.calls }
+	before := total()
+	_, err = m.HeaderByType(ctx, HeadLatest)
+	require.NoError(t, err)
+	require.Equal(t, before+1, total())
+
+	// No quorum if all disagree.
+	cl3.header = &types.Header{Number: big.NewInt(1), Extra: []byte("other")}
+	_, err = m.HeaderByType(ctx, HeadFinalized)
+	require.ErrorContains(t, err, "no quorum")
+
+	// Invalid quorum
+	_, err = NewMultiClient("test", []Client{cl1}, WithQuorum(2))
+	require.ErrorContains(t, err, "invalid quorum")
+}
+
+// fakeClient is a fake Client only implementing the methods used by the tests.
+type fakeClient struct {
+	Client
+
+	err    error
+	height uint64
+	header *types.Header
+	calls  int
+}
+
+func (c *fakeClient) BlockNumber(context.Context) (uint64, error) {
+	c.calls++
+	return c.height, c.err
+}
+
+func (c *fakeClient) HeaderByNumber(context.Context, *big.Int) (*types.Header, error) {
+	c.calls++
+	if c.header == nil {
+		return nil, ethereum.NotFound
+	}
+
+	return c.header, c.err
+}
+
+func (c *fakeClient) HeaderByType(ctx context.Context, _ HeadType) (*types.Header, error) {
+	return c.HeaderByNumber(ctx, nil)
+}
+
+var _ rpc.Error = revertErr{}
+
+type revertErr struct{}
+
+func (revertErr) Error() string  { return "execution reverted" }
+func (revertErr) ErrorCode() int { return 3 }
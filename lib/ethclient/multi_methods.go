@@ -0,0 +1,217 @@
+package ethclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// This file contains the MultiClient methods that simply fail over between endpoints.
+// Quorum reads are implemented in multi.go.
+
+func (m *MultiClient) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return call(ctx, m, "block_by_hash", true, func(ctx context.Context, cl Client) (*types.Block, error) {
+		return cl.BlockByHash(ctx, hash)
+	})
+}
+
+func (m *MultiClient) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return call(ctx, m, "block_by_number", true, func(ctx context.Context, cl Client) (*types.Block, error) {
+		return cl.BlockByNumber(ctx, number)
+	})
+}
+
+func (m *MultiClient) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return call(ctx, m, "header_by_hash", true, func(ctx context.Context, cl Client) (*types.Header, error) {
+		return cl.HeaderByHash(ctx, hash)
+	})
+}
+
+func (m *MultiClient) TransactionCount(ctx context.Context, blockHash common.Hash) (uint, error) {
+	return call(ctx, m, "transaction_count", true, func(ctx context.Context, cl Client) (uint, error) {
+		return cl.TransactionCount(ctx, blockHash)
+	})
+}
+
+func (m *MultiClient) TransactionInBlock(ctx context.Context, blockHash common.Hash, index uint) (*types.Transaction, error) {
+	return call(ctx, m, "transaction_in_block", true, func(ctx context.Context, cl Client) (*types.Transaction, error) {
+		return cl.TransactionInBlock(ctx, blockHash, index)
+	})
+}
+
+func (m *MultiClient) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	return call(ctx, m, "subscribe_new_head", false, func(ctx context.Context, cl Client) (ethereum.Subscription, error) {
+		return cl.SubscribeNewHead(ctx, ch)
+	})
+}
+
+func (m *MultiClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return call(ctx, m, "transaction_receipt", true, func(ctx context.Context, cl Client) (*types.Receipt, error) {
+		return cl.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (m *MultiClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return call(ctx, m, "balance_at", true, func(ctx context.Context, cl Client) (*big.Int, error) {
+		return cl.BalanceAt(ctx, account, blockNumber)
+	})
+}
+
+func (m *MultiClient) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, m, "storage_at", true, func(ctx context.Context, cl Client) ([]byte, error) {
+		return cl.StorageAt(ctx, account, key, blockNumber)
+	})
+}
+
+func (m *MultiClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, m, "code_at", true, func(ctx context.Context, cl Client) ([]byte, error) {
+		return cl.CodeAt(ctx, account, blockNumber)
+	})
+}
+
+func (m *MultiClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return call(ctx, m, "nonce_at", true, func(ctx context.Context, cl Client) (uint64, error) {
+		return cl.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+func (m *MultiClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return call(ctx, m, "call_contract", true, func(ctx context.Context, cl Client) ([]byte, error) {
+		return cl.CallContract(ctx, msg, blockNumber)
+	})
+}
+
+func (m *MultiClient) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return call(ctx, m, "subscribe_filter_logs", false, func(ctx context.Context, cl Client) (ethereum.Subscription, error) {
+		return cl.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+func (m *MultiClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return call(ctx, m, "suggest_gas_price", true, func(ctx context.Context, cl Client) (*big.Int, error) {
+		return cl.SuggestGasPrice(ctx)
+	})
+}
+
+func (m *MultiClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return call(ctx, m, "suggest_gas_tip_cap", true, func(ctx context.Context, cl Client) (*big.Int, error) {
+		return cl.SuggestGasTipCap(ctx)
+	})
+}
+
+func (m *MultiClient) PendingBalanceAt(ctx context.Context, account common.Address) (*big.Int, error) {
+	return call(ctx, m, "pending_balance_at", true, func(ctx context.Context, cl Client) (*big.Int, error) {
+		return cl.PendingBalanceAt(ctx, account)
+	})
+}
+
+func (m *MultiClient) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	return call(ctx, m, "pending_storage_at", true, func(ctx context.Context, cl Client) ([]byte, error) {
+		return cl.PendingStorageAt(ctx, account, key)
+	})
+}
+
+func (m *MultiClient) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return call(ctx, m, "pending_code_at", true, func(ctx context.Context, cl Client) ([]byte, error) {
+		return cl.PendingCodeAt(ctx, account)
+	})
+}
+
+func (m *MultiClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return call(ctx, m, "pending_nonce_at", true, func(ctx context.Context, cl Client) (uint64, error) {
+		return cl.PendingNonceAt(ctx, account)
+	})
+}
+
+func (m *MultiClient) PendingTransactionCount(ctx context.Context) (uint, error) {
+	return call(ctx, m, "pending_transaction_count", true, func(ctx context.Context, cl Client) (uint, error) {
+		return cl.PendingTransactionCount(ctx)
+	})
+}
+
+func (m *MultiClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return call(ctx, m, "estimate_gas", true, func(ctx context.Context, cl Client) (uint64, error) {
+		return cl.EstimateGas(ctx, msg)
+	})
+}
+
+func (m *MultiClient) BlockNumber(ctx context.Context) (uint64, error) {
+	return call(ctx, m, "block_number", true, func(ctx context.Context, cl Client) (uint64, error) {
+		return cl.BlockNumber(ctx)
+	})
+}
+
+func (m *MultiClient) ChainID(ctx context.Context) (*big.Int, error) {
+	return call(ctx, m, "chain_id", true, func(ctx context.Context, cl Client) (*big.Int, error) {
+		return cl.ChainID(ctx)
+	})
+}
+
+func (m *MultiClient) TxReceipt(ctx context.Context, hash common.Hash) (*Receipt, error) {
+	return call(ctx, m, "tx_receipt", true, func(ctx context.Context, cl Client) (*Receipt, error) {
+		return cl.TxReceipt(ctx, hash)
+	})
+}
+
+func (m *MultiClient) EtherBalanceAt(ctx context.Context, addr common.Address) (float64, error) {
+	return call(ctx, m, "ether_balance_at", true, func(ctx context.Context, cl Client) (float64, error) {
+		return cl.EtherBalanceAt(ctx, addr)
+	})
+}
+
+func (m *MultiClient) PeerCount(ctx context.Context) (uint64, error) {
+	return call(ctx, m, "peer_count", true, func(ctx context.Context, cl Client) (uint64, error) {
+		return cl.PeerCount(ctx)
+	})
+}
+
+func (m *MultiClient) TransactionByHash(ctx context.Context, txHash common.Hash) (*types.Transaction, bool, error) {
+	var isPending bool
+	tx, err := call(ctx, m, "transaction_by_hash", true, func(ctx context.Context, cl Client) (*types.Transaction, error) {
+		var err error
+		var tx *types.Transaction
+		tx, isPending, err = cl.TransactionByHash(ctx, txHash)
+
+		return tx, err
+	})
+
+	return tx, isPending, err
+}
+
+// SendTransaction sends the transaction, failing over to other endpoints on transport errors.
+// Note that resending a transaction to another endpoint is safe since it is identified by its hash.
+func (m *MultiClient) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	return m.do(ctx, "send_transaction", true, func(ctx context.Context, cl Client) error {
+		return cl.SendTransaction(ctx, tx)
+	})
+}
+
+func (m *MultiClient) ProgressIfSyncing(ctx context.Context) (*ethereum.SyncProgress, bool, error) {
+	var syncing bool
+	progress, err := call(ctx, m, "sync_progress", true, func(ctx context.Context, cl Client) (*ethereum.SyncProgress, error) {
+		var err error
+		var progress *ethereum.SyncProgress
+		progress, syncing, err = cl.ProgressIfSyncing(ctx)
+
+		return progress, err
+	})
+
+	return progress, syncing, err
+}
+
+// SetHead sets the current head of the primary endpoint only.
+// Note, this is a destructive action and may severely damage your chain.
+// Use with extreme caution.
+func (m *MultiClient) SetHead(ctx context.Context, height uint64) error {
+	return m.endpoints[0].cl.SetHead(ctx, height)
+}
+
+//nolint:revive // interface{} required by upstream.
+func (m *MultiClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return m.do(ctx, "raw_call", true, func(ctx context.Context, cl Client) error {
+		return cl.CallContext(ctx, result, method, args...)
+	})
+}
@@ -35,6 +35,8 @@ func TestLeaveProvable(t *testing.T) {
 	leaf := leaves[leafIndex]
 	root := merkle.ProcessProof(leaf, proof)
 	require.Equal(t, tree[0], root)
+	require.True(t, merkle.VerifyProof(tree[0], leaf, proof))
+	require.False(t, merkle.VerifyProof(tree[0], crypto.Keccak256Hash(leaf[:]), proof))
 }
 
 // TestLeavesProvable tests that multiple leaves can be proven.
@@ -70,6 +72,21 @@ func TestLeavesProvable(t *testing.T) {
 	root, err := merkle.ProcessMultiProof(multi)
 	require.NoError(t, err)
 	require.Equal(t, tree[0], root)
+
+	ok, err := merkle.VerifyMultiProof(tree[0], multi)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Check that a tampered leaf is invalid
+	multi.Leaves[0] = crypto.Keccak256Hash(multi.Leaves[0][:])
+	ok, err = merkle.VerifyMultiProof(tree[0], multi)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Check that malformed proofs return an error
+	multi.ProofFlags = append(multi.ProofFlags, true)
+	_, err = merkle.VerifyMultiProof(tree[0], multi)
+	require.Error(t, err)
 }
 
 func TestEvenTree(t *testing.T) {
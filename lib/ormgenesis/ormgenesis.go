@@ -0,0 +1,82 @@
+// Package ormgenesis provides genesis import and export of cosmos ORM module databases
+// using module genesis JSON: a JSON object of table names to rows.
+package ormgenesis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/genesis"
+)
+
+// Export returns the JSON encoded rows of all tables of the ORM module database.
+func Export(ctx context.Context, handler appmodule.HasGenesis) (json.RawMessage, error) {
+	target := &genesis.RawJSONTarget{}
+	if err := handler.ExportGenesis(ctx, target.Target()); err != nil {
+		return nil, errors.Wrap(err, "export genesis")
+	}
+
+	raw, err := target.JSON()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal genesis")
+	}
+
+	return raw, nil
+}
+
+// Import inserts the JSON encoded table rows into the ORM module database.
+// It is a noop if the genesis state is empty.
+func Import(ctx context.Context, handler appmodule.HasGenesis, raw json.RawMessage) error {
+	if IsEmpty(raw) {
+		return nil
+	}
+
+	source, err := genesis.SourceFromRawJSON(raw)
+	if err != nil {
+		return errors.Wrap(err, "genesis source")
+	}
+
+	if err := handler.InitGenesis(ctx, source); err != nil {
+		return errors.Wrap(err, "init genesis")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the genesis state isn't empty or a JSON object of table names to rows.
+func Validate(raw json.RawMessage) error {
+	if IsEmpty(raw) {
+		return nil
+	}
+
+	var tables map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &tables); err != nil {
+		return errors.Wrap(err, "unmarshal genesis tables")
+	}
+
+	for name, rows := range tables {
+		var list []json.RawMessage
+		if err := json.Unmarshal(rows, &list); err != nil {
+			return errors.Wrap(err, "unmarshal genesis table rows", "table", name)
+		}
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the genesis state is empty or contains no tables.
+func IsEmpty(raw json.RawMessage) bool {
+	if len(raw) == 0 {
+		return true
+	}
+
+	var tables map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &tables); err != nil {
+		return false // Not empty, but invalid.
+	}
+
+	return len(tables) == 0
+}
@@ -0,0 +1,44 @@
+package ormgenesis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/omni-network/omni/lib/ormgenesis"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Name  string
+		Raw   string
+		Empty bool
+		Error bool
+	}{
+		{Name: "nil", Raw: "", Empty: true},
+		{Name: "null", Raw: "null", Empty: true},
+		{Name: "empty", Raw: "{}", Empty: true},
+		{Name: "tables", Raw: `{"halo.foo.Bar": [1, {"id": "1"}]}`},
+		{Name: "not object", Raw: "[]", Error: true},
+		{Name: "not rows", Raw: `{"halo.foo.Bar": {}}`, Error: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+
+			raw := json.RawMessage(test.Raw)
+			require.Equal(t, test.Empty, ormgenesis.IsEmpty(raw))
+
+			err := ormgenesis.Validate(raw)
+			if test.Error {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package txmgr
+
+import (
+	context "context"
+	ormlist "cosmossdk.io/orm/model/ormlist"
+	ormtable "cosmossdk.io/orm/model/ormtable"
+	ormerrors "cosmossdk.io/orm/types/ormerrors"
+)
+
+type JournalTxTable interface {
+	Insert(ctx context.Context, journalTx *JournalTx) error
+	Update(ctx context.Context, journalTx *JournalTx) error
+	Save(ctx context.Context, journalTx *JournalTx) error
+	Delete(ctx context.Context, journalTx *JournalTx) error
+	Has(ctx context.Context, chain_id uint64, from []byte, nonce uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, chain_id uint64, from []byte, nonce uint64) (*JournalTx, error)
+	List(ctx context.Context, prefixKey JournalTxIndexKey, opts ...ormlist.Option) (JournalTxIterator, error)
+	ListRange(ctx context.Context, from, to JournalTxIndexKey, opts ...ormlist.Option) (JournalTxIterator, error)
+	DeleteBy(ctx context.Context, prefixKey JournalTxIndexKey) error
+	DeleteRange(ctx context.Context, from, to JournalTxIndexKey) error
+
+	doNotImplement()
+}
+
+type JournalTxIterator struct {
+	ormtable.Iterator
+}
+
+func (i JournalTxIterator) Value() (*JournalTx, error) {
+	var journalTx JournalTx
+	err := i.UnmarshalMessage(&journalTx)
+	return &journalTx, err
+}
+
+type JournalTxIndexKey interface {
+	id() uint32
+	values() []interface{}
+	journalTxIndexKey()
+}
+
+// primary key starting index..
+type JournalTxPrimaryKey = JournalTxChainIdFromNonceIndexKey
+
+type JournalTxChainIdFromNonceIndexKey struct {
+	vs []interface{}
+}
+
+func (x JournalTxChainIdFromNonceIndexKey) id() uint32            { return 0 }
+func (x JournalTxChainIdFromNonceIndexKey) values() []interface{} { return x.vs }
+func (x JournalTxChainIdFromNonceIndexKey) journalTxIndexKey()    {}
+
+func (this JournalTxChainIdFromNonceIndexKey) WithChainId(chain_id uint64) JournalTxChainIdFromNonceIndexKey {
+	this.vs = []interface{}{chain_id}
+	return this
+}
+
+func (this JournalTxChainIdFromNonceIndexKey) WithChainIdFrom(chain_id uint64, from []byte) JournalTxChainIdFromNonceIndexKey {
+	this.vs = []interface{}{chain_id, from}
+	return this
+}
+
+func (this JournalTxChainIdFromNonceIndexKey) WithChainIdFromNonce(chain_id uint64, from []byte, nonce uint64) JournalTxChainIdFromNonceIndexKey {
+	this.vs = []interface{}{chain_id, from, nonce}
+	return this
+}
+
+type journalTxTable struct {
+	table ormtable.Table
+}
+
+func (this journalTxTable) Insert(ctx context.Context, journalTx *JournalTx) error {
+	return this.table.Insert(ctx, journalTx)
+}
+
+func (this journalTxTable) Update(ctx context.Context, journalTx *JournalTx) error {
+	return this.table.Update(ctx, journalTx)
+}
+
+func (this journalTxTable) Save(ctx context.Context, journalTx *JournalTx) error {
+	return this.table.Save(ctx, journalTx)
+}
+
+func (this journalTxTable) Delete(ctx context.Context, journalTx *JournalTx) error {
+	return this.table.Delete(ctx, journalTx)
+}
+
+func (this journalTxTable) Has(ctx context.Context, chain_id uint64, from []byte, nonce uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, chain_id, from, nonce)
+}
+
+func (this journalTxTable) Get(ctx context.Context, chain_id uint64, from []byte, nonce uint64) (*JournalTx, error) {
+	var journalTx JournalTx
+	found, err := this.table.PrimaryKey().Get(ctx, &journalTx, chain_id, from, nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &journalTx, nil
+}
+
+func (this journalTxTable) List(ctx context.Context, prefixKey JournalTxIndexKey, opts ...ormlist.Option) (JournalTxIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return JournalTxIterator{it}, err
+}
+
+func (this journalTxTable) ListRange(ctx context.Context, from, to JournalTxIndexKey, opts ...ormlist.Option) (JournalTxIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return JournalTxIterator{it}, err
+}
+
+func (this journalTxTable) DeleteBy(ctx context.Context, prefixKey JournalTxIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this journalTxTable) DeleteRange(ctx context.Context, from, to JournalTxIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this journalTxTable) doNotImplement() {}
+
+var _ JournalTxTable = journalTxTable{}
+
+func NewJournalTxTable(db ormtable.Schema) (JournalTxTable, error) {
+	table := db.GetTable(&JournalTx{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&JournalTx{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return journalTxTable{table}, nil
+}
+
+type JournalStore interface {
+	JournalTxTable() JournalTxTable
+
+	doNotImplement()
+}
+
+type journalStore struct {
+	journalTx JournalTxTable
+}
+
+func (x journalStore) JournalTxTable() JournalTxTable {
+	return x.journalTx
+}
+
+func (journalStore) doNotImplement() {}
+
+var _ JournalStore = journalStore{}
+
+func NewJournalStore(db ormtable.Schema) (JournalStore, error) {
+	journalTxTable, err := NewJournalTxTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return journalStore{
+		journalTxTable,
+	}, nil
+}
@@ -0,0 +1,172 @@
+package txmgr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/types/ormerrors"
+	db "github.com/cosmos/cosmos-db"
+)
+
+// Journal is a persisted, crash-safe record of nonces and the signed
+// transactions (including fee bumps) sent with them. It allows a [TxManager]
+// to resume watching and bumping in-flight transactions after a restart.
+//
+// A single journal may be shared by multiple transaction managers,
+// entries are keyed by chain ID and sender address.
+type Journal struct {
+	table JournalTxTable
+
+	mu     sync.Mutex
+	active map[journalKey]bool // Entries being sent by a transaction manager of this process
+}
+
+// journalKey identifies a journal entry.
+type journalKey struct {
+	ChainID uint64
+	From    common.Address
+	Nonce   uint64
+}
+
+// NewJournal returns a new journal backed by the provided DB.
+func NewJournal(db db.DB) (*Journal, error) {
+	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{Id: 1, ProtoFileName: File_lib_txmgr_journal_proto.Path()},
+	}}
+
+	modDB, err := ormdb.NewModuleDB(schema, ormdb.ModuleDBOptions{KVStoreService: dbStoreService{DB: db}})
+	if err != nil {
+		return nil, errors.Wrap(err, "create ormdb module db")
+	}
+
+	dbStore, err := NewJournalStore(modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "create store")
+	}
+
+	return &Journal{
+		table:  dbStore.JournalTxTable(),
+		active: make(map[journalKey]bool),
+	}, nil
+}
+
+// append adds the signed transaction to the fee-bump history of its nonce,
+// creating the entry with the provided reservation time if it doesn't exist.
+func (j *Journal) append(ctx context.Context, chainID uint64, from common.Address, reservedAt time.Time, tx *types.Transaction) error {
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal tx")
+	}
+
+	entry, err := j.table.Get(ctx, chainID, from.Bytes(), tx.Nonce())
+	if ormerrors.IsNotFound(err) {
+		// First tx signed with the nonce, create the entry now.
+		entry = &JournalTx{
+			ChainId:    chainID,
+			From:       from.Bytes(),
+			Nonce:      tx.Nonce(),
+			ReservedAt: reservedAt.Unix(),
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "get journal tx")
+	}
+
+	entry.RawTxs = append(entry.RawTxs, raw)
+
+	if err := j.table.Save(ctx, entry); err != nil {
+		return errors.Wrap(err, "save journal tx")
+	}
+
+	return nil
+}
+
+// setActive marks the entry as being sent (or not) by a transaction manager of this process.
+// It returns false if the entry was already active.
+func (j *Journal) setActive(chainID uint64, from common.Address, nonce uint64, active bool) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	key := journalKey{ChainID: chainID, From: from, Nonce: nonce}
+	if !active {
+		delete(j.active, key)
+		return true
+	} else if j.active[key] {
+		return false
+	}
+
+	j.active[key] = true
+
+	return true
+}
+
+// remove deletes the entry of the provided nonce, if it exists.
+func (j *Journal) remove(ctx context.Context, chainID uint64, from common.Address, nonce uint64) error {
+	err := j.table.Delete(ctx, &JournalTx{ChainId: chainID, From: from.Bytes(), Nonce: nonce})
+	if err != nil && !ormerrors.IsNotFound(err) {
+		return errors.Wrap(err, "delete journal tx")
+	}
+
+	return nil
+}
+
+// list returns all entries of the provided sender ordered by nonce ascending.
+func (j *Journal) list(ctx context.Context, chainID uint64, from common.Address) ([]PendingTx, error) {
+	iterator, err := j.table.List(ctx, JournalTxPrimaryKey{}.WithChainIdFrom(chainID, from.Bytes()))
+	if err != nil {
+		return nil, errors.Wrap(err, "list journal txs")
+	}
+	defer iterator.Close()
+
+	var resp []PendingTx
+	for iterator.Next() {
+		entry, err := iterator.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "journal tx value")
+		}
+
+		pending, err := pendingFromJournal(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		resp = append(resp, pending)
+	}
+
+	return resp, nil
+}
+
+// pendingFromJournal converts a persisted journal entry to a PendingTx.
+func pendingFromJournal(entry *JournalTx) (PendingTx, error) {
+	txs := make([]*types.Transaction, 0, len(entry.GetRawTxs()))
+	for _, raw := range entry.GetRawTxs() {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return PendingTx{}, errors.Wrap(err, "unmarshal journal tx", "nonce", entry.GetNonce())
+		}
+		txs = append(txs, tx)
+	}
+
+	return PendingTx{
+		Nonce:      entry.GetNonce(),
+		ReservedAt: time.Unix(entry.GetReservedAt(), 0),
+		Txs:        txs,
+	}, nil
+}
+
+// dbStoreService wraps a cosmos-db instance and provides it via OpenKVStore.
+type dbStoreService struct {
+	db.DB
+}
+
+func (db dbStoreService) OpenKVStore(context.Context) store.KVStore {
+	return db.DB
+}
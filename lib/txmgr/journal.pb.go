@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: lib/txmgr/journal.proto
+
+package txmgr
+
+import (
+	_ "cosmossdk.io/api/cosmos/orm/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JournalTx struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       uint64                 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`          // Chain ID as per https://chainlist.org
+	From          []byte                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                // Sender address
+	Nonce         uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`                             // Reserved nonce
+	ReservedAt    int64                  `protobuf:"varint,4,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"` // Unix timestamp (seconds) when the nonce was reserved
+	RawTxs        [][]byte               `protobuf:"bytes,5,rep,name=raw_txs,json=rawTxs,proto3" json:"raw_txs,omitempty"`              // RLP encoded signed txs in fee-bump order, latest last
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalTx) Reset() {
+	*x = JournalTx{}
+	mi := &file_lib_txmgr_journal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalTx) ProtoMessage() {}
+
+func (x *JournalTx) ProtoReflect() protoreflect.Message {
+	mi := &file_lib_txmgr_journal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalTx.ProtoReflect.Descriptor instead.
+func (*JournalTx) Descriptor() ([]byte, []int) {
+	return file_lib_txmgr_journal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JournalTx) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *JournalTx) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *JournalTx) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *JournalTx) GetReservedAt() int64 {
+	if x != nil {
+		return x.ReservedAt
+	}
+	return 0
+}
+
+func (x *JournalTx) GetRawTxs() [][]byte {
+	if x != nil {
+		return x.RawTxs
+	}
+	return nil
+}
+
+var File_lib_txmgr_journal_proto protoreflect.FileDescriptor
+
+var file_lib_txmgr_journal_proto_rawDesc = string([]byte{
+	0x0a, 0x17, 0x6c, 0x69, 0x62, 0x2f, 0x74, 0x78, 0x6d, 0x67, 0x72, 0x2f, 0x6a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6c, 0x69, 0x62, 0x2e, 0x74,
+	0x78, 0x6d, 0x67, 0x72, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01,
+	0x0a, 0x09, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x06, 0x72, 0x61, 0x77, 0x54, 0x78, 0x73, 0x3a, 0x1f, 0xf2, 0x9e, 0xd3, 0x8e,
+	0x03, 0x19, 0x0a, 0x15, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x2c, 0x66,
+	0x72, 0x6f, 0x6d, 0x2c, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x42, 0x8a, 0x01, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x69, 0x62, 0x2e, 0x74, 0x78, 0x6d, 0x67, 0x72, 0x42, 0x0c, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x26, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x6c, 0x69, 0x62, 0x2f,
+	0x74, 0x78, 0x6d, 0x67, 0x72, 0xa2, 0x02, 0x03, 0x4c, 0x54, 0x58, 0xaa, 0x02, 0x09, 0x4c, 0x69,
+	0x62, 0x2e, 0x54, 0x78, 0x6d, 0x67, 0x72, 0xca, 0x02, 0x09, 0x4c, 0x69, 0x62, 0x5c, 0x54, 0x78,
+	0x6d, 0x67, 0x72, 0xe2, 0x02, 0x15, 0x4c, 0x69, 0x62, 0x5c, 0x54, 0x78, 0x6d, 0x67, 0x72, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4c, 0x69,
+	0x62, 0x3a, 0x3a, 0x54, 0x78, 0x6d, 0x67, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_lib_txmgr_journal_proto_rawDescOnce sync.Once
+	file_lib_txmgr_journal_proto_rawDescData []byte
+)
+
+func file_lib_txmgr_journal_proto_rawDescGZIP() []byte {
+	file_lib_txmgr_journal_proto_rawDescOnce.Do(func() {
+		file_lib_txmgr_journal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lib_txmgr_journal_proto_rawDesc), len(file_lib_txmgr_journal_proto_rawDesc)))
+	})
+	return file_lib_txmgr_journal_proto_rawDescData
+}
+
+var file_lib_txmgr_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_lib_txmgr_journal_proto_goTypes = []any{
+	(*JournalTx)(nil), // 0: lib.txmgr.JournalTx
+}
+var file_lib_txmgr_journal_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_lib_txmgr_journal_proto_init() }
+func file_lib_txmgr_journal_proto_init() {
+	if File_lib_txmgr_journal_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lib_txmgr_journal_proto_rawDesc), len(file_lib_txmgr_journal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_lib_txmgr_journal_proto_goTypes,
+		DependencyIndexes: file_lib_txmgr_journal_proto_depIdxs,
+		MessageInfos:      file_lib_txmgr_journal_proto_msgTypes,
+	}.Build()
+	File_lib_txmgr_journal_proto = out.File
+	file_lib_txmgr_journal_proto_goTypes = nil
+	file_lib_txmgr_journal_proto_depIdxs = nil
+}
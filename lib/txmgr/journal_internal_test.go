@@ -0,0 +1,232 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	journal, err := NewJournal(db.NewMemDB())
+	require.NoError(t, err)
+
+	const chainID = 1
+	from := common.HexToAddress("0x01")
+	other := common.HexToAddress("0x02")
+
+	tx1 := testJournalTx(1, 10)
+	tx1Bumped := testJournalTx(1, 11)
+	tx2 := testJournalTx(2, 10)
+	require.NoError(t, journal.append(ctx, chainID, from, time.Unix(100, 0), tx1))
+	require.NoError(t, journal.append(ctx, chainID, from, time.Unix(999, 0), tx1Bumped)) // Reservation time of existing entry retained
+	require.NoError(t, journal.append(ctx, chainID, from, time.Unix(101, 0), tx2))
+	require.NoError(t, journal.append(ctx, chainID, other, time.Unix(102, 0), tx1))
+
+	pending, err := journal.list(ctx, chainID, from)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.EqualValues(t, 1, pending[0].Nonce)
+	require.Equal(t, time.Unix(100, 0), pending[0].ReservedAt)
+	require.Len(t, pending[0].Txs, 2)
+	require.Equal(t, tx1.Hash(), pending[0].Txs[0].Hash())
+	require.Equal(t, tx1Bumped.Hash(), pending[0].Txs[1].Hash())
+	require.EqualValues(t, 2, pending[1].Nonce)
+	require.Equal(t, time.Unix(101, 0), pending[1].ReservedAt)
+	require.Len(t, pending[1].Txs, 1)
+
+	require.NoError(t, journal.remove(ctx, chainID, from, 1))
+	require.NoError(t, journal.remove(ctx, chainID, from, 1)) // Idempotent
+
+	pending, err = journal.list(ctx, chainID, from)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.EqualValues(t, 2, pending[0].Nonce)
+
+	pending, err = journal.list(ctx, chainID, other)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+}
+
+// TestJournalResume asserts that txs persisted in the journal are resumed
+// on construction and their nonces skipped when the nonce is initialized.
+func TestJournalResume(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	journal, err := NewJournal(db.NewMemDB())
+	require.NoError(t, err)
+
+	conf := configWithNumConfs(1)
+	conf.ChainID = big.NewInt(1)
+	h := newTestHarnessWithConfig(t, conf)
+	h.mgr.journal = journal
+
+	// Populate the journal as if the previous process crashed with an in-flight tx.
+	resumed := testJournalTx(startingNonce, 10)
+	require.NoError(t, journal.append(ctx, 1, conf.From, time.Now(), resumed))
+
+	published := make(chan *types.Transaction, 1)
+	h.backend.setTxSender(func(_ context.Context, tx *types.Transaction) error {
+		published <- tx
+		return nil
+	})
+
+	// Resume as done by NewSimpleWithJournal.
+	require.NoError(t, h.mgr.resume(ctx))
+
+	pending := h.mgr.Pending()
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Resumed)
+	require.Equal(t, startingNonce, pending[0].Nonce)
+	require.Equal(t, resumed.Hash(), pending[0].Txs[0].Hash())
+
+	// Another transaction manager of this process doesn't resume the same tx.
+	other := newTestHarnessWithConfig(t, conf)
+	other.mgr.journal = journal
+	require.NoError(t, other.mgr.resume(ctx))
+	require.Empty(t, other.mgr.Pending())
+
+	// Resumed nonce is skipped.
+	nonce, err := h.mgr.ReserveNextNonce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, startingNonce+1, nonce)
+
+	pending = h.mgr.Pending()
+	require.Len(t, pending, 2)
+	require.False(t, pending[1].Resumed)
+	require.Equal(t, startingNonce+1, pending[1].Nonce)
+
+	// Mine the resumed tx once it is republished.
+	tx := <-published
+	require.Equal(t, resumed.Hash(), tx.Hash())
+	txHash := tx.Hash()
+	h.backend.mine(&txHash, tx.GasFeeCap())
+
+	require.Eventually(t, func() bool {
+		return len(h.mgr.Pending()) == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	// Reserved but unsigned nonces are never journaled.
+	entries, err := journal.list(ctx, 1, conf.From)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// TestJournalSendTimeout asserts that txs broadcast but not confirmed within the send timeout
+// are retained in the journal, while txs never broadcast are removed.
+func TestJournalSendTimeout(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	journal, err := NewJournal(db.NewMemDB())
+	require.NoError(t, err)
+
+	conf := configWithNumConfs(1)
+	conf.ChainID = big.NewInt(1)
+	conf.TxSendTimeout = 200 * time.Millisecond
+	h := newTestHarnessWithConfig(t, conf)
+	h.mgr.journal = journal
+
+	// Broadcast tx never mined.
+	h.backend.setTxSender(func(context.Context, *types.Transaction) error { return nil })
+	_, _, err = h.mgr.Send(ctx, h.createTxCandidate())
+	require.ErrorContains(t, err, "timeout")
+	require.NoError(t, ctx.Err())
+
+	entries, err := journal.list(ctx, 1, conf.From)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, startingNonce, entries[0].Nonce)
+	require.NoError(t, journal.remove(ctx, 1, conf.From, startingNonce))
+
+	// Tx never accepted by the node.
+	h.backend.setTxSender(func(context.Context, *types.Transaction) error { return errors.New("unknown error") })
+	_, _, err = h.mgr.Send(ctx, h.createTxCandidate())
+	require.Error(t, err)
+
+	entries, err = journal.list(ctx, 1, conf.From)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// TestJournalResumeSendTimeout asserts that resumed txs are retained in the journal if sending fails,
+// since they may have been broadcast before the restart.
+func TestJournalResumeSendTimeout(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	journal, err := NewJournal(db.NewMemDB())
+	require.NoError(t, err)
+
+	conf := configWithNumConfs(1)
+	conf.ChainID = big.NewInt(1)
+	conf.TxSendTimeout = 200 * time.Millisecond
+	h := newTestHarnessWithConfig(t, conf)
+	h.mgr.journal = journal
+
+	// Populate the journal as if the previous process crashed after broadcasting a tx.
+	resumed := testJournalTx(startingNonce, 10)
+	require.NoError(t, journal.append(ctx, 1, conf.From, time.Now(), resumed))
+
+	// The node doesn't accept the republished tx, and the tx is never mined.
+	h.backend.setTxSender(func(context.Context, *types.Transaction) error { return errors.New("unknown error") })
+
+	require.NoError(t, h.mgr.resume(ctx))
+	require.Eventually(t, func() bool {
+		return len(h.mgr.Pending()) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	entries, err := journal.list(ctx, 1, conf.From)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, startingNonce, entries[0].Nonce)
+}
+
+// TestReleaseReservations asserts that reserved nonces never sent are released.
+func TestReleaseReservations(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	conf := configWithNumConfs(1)
+	conf.TxSendTimeout = time.Millisecond
+	h := newTestHarnessWithConfig(t, conf)
+
+	_, err := h.mgr.ReserveNextNonce(ctx)
+	require.NoError(t, err)
+	require.Len(t, h.mgr.Pending(), 1)
+
+	// Stale reservations are released when reserving the next nonce.
+	time.Sleep(time.Millisecond * 2)
+	_, err = h.mgr.ReserveNextNonce(ctx)
+	require.NoError(t, err)
+	require.Len(t, h.mgr.Pending(), 1)
+
+	// Resetting the nonce releases all reservations.
+	h.mgr.resetNonce()
+	require.Empty(t, h.mgr.Pending())
+}
+
+func testJournalTx(nonce uint64, gasFeeCap int64) *types.Transaction {
+	to := common.HexToAddress("0x42")
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     nonce,
+		To:        &to,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(gasFeeCap),
+		Gas:       21_000,
+	})
+}
@@ -34,4 +34,18 @@ var (
 		Help:      "Gas used by transactions",
 		Buckets:   prometheus.ExponentialBucketsRange(21_000, 10_000_000, 8),
 	}, []string{"chain"})
+
+	pendingTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "txmgr",
+		Name:      "pending_txs",
+		Help:      "Number of in-flight transactions (reserved nonces) per chain",
+	}, []string{"chain"})
+
+	resumedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "txmgr",
+		Name:      "resumed_total",
+		Help:      "The total number of in-flight transactions resumed from the journal per chain",
+	}, []string{"chain"})
 )
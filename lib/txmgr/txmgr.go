@@ -1,10 +1,12 @@
 package txmgr
 
 import (
+	"cmp"
 	"context"
 	"log/slog"
 	"math/big"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -46,6 +48,10 @@ type TxManager interface {
 
 	// ReserveNextNonce returns the next available nonce and increments the available nonce.
 	ReserveNextNonce(ctx context.Context) (uint64, error)
+
+	// Pending returns all in-flight transactions ordered by nonce.
+	// This includes reserved nonces not signed yet and transactions resumed from the journal.
+	Pending() []PendingTx
 }
 
 // simple is a implementation of TxManager that performs linear fee
@@ -59,6 +65,11 @@ type simple struct {
 
 	nonce     *uint64 // nil == unset, 0 == unused account
 	nonceLock sync.Mutex
+
+	pending     map[uint64]*PendingTx // In-flight txs by nonce
+	pendingLock sync.Mutex
+
+	journal *Journal // Optional, nil disables persistence
 }
 
 // NewSimple initializes a new simple with the passed Config.
@@ -75,6 +86,31 @@ func NewSimple(chainName string, conf Config) (TxManager, error) {
 	}, nil
 }
 
+// NewSimpleWithJournal initializes a new simple with the passed Config that persists reserved
+// nonces and signed txs to the journal. In-flight txs of a previous process are resumed immediately
+// and sent asynchronously until confirmed, so the provided context must be long-lived.
+func NewSimpleWithJournal(ctx context.Context, chainName string, conf Config, journal *Journal) (TxManager, error) {
+	if journal == nil {
+		return nil, errors.New("nil journal")
+	} else if err := conf.Check(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	m := &simple{
+		chainID:   conf.ChainID,
+		chainName: chainName,
+		cfg:       conf,
+		backend:   conf.Backend,
+		journal:   journal,
+	}
+
+	if err := m.resume(ctx); err != nil {
+		return nil, errors.Wrap(err, "resume journal txs")
+	}
+
+	return m, nil
+}
+
 func (m *simple) ReserveNextNonce(ctx context.Context) (uint64, error) {
 	m.nonceLock.Lock()
 	defer m.nonceLock.Unlock()
@@ -102,6 +138,13 @@ func (m *simple) ReserveNextNonce(ctx context.Context) (uint64, error) {
 		m.nonce = &nonce
 	}
 
+	// Skip nonces of txs that are still being sent (e.g. resumed from the journal).
+	for m.isSending(*m.nonce) {
+		*m.nonce++
+	}
+
+	m.trackNonce(*m.nonce)
+
 	defer func() {
 		*m.nonce++
 	}()
@@ -109,6 +152,24 @@ func (m *simple) ReserveNextNonce(ctx context.Context) (uint64, error) {
 	return *m.nonce, nil
 }
 
+func (m *simple) Pending() []PendingTx {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	resp := make([]PendingTx, 0, len(m.pending))
+	for _, pending := range m.pending {
+		clone := *pending
+		clone.Txs = slices.Clone(pending.Txs)
+		resp = append(resp, clone)
+	}
+
+	slices.SortFunc(resp, func(a, b PendingTx) int {
+		return cmp.Compare(a.Nonce, b.Nonce)
+	})
+
+	return resp
+}
+
 func (m *simple) From() common.Address {
 	return m.cfg.From
 }
@@ -145,6 +206,22 @@ type TxCandidate struct {
 	Nonce *uint64
 }
 
+// PendingTx is an in-flight transaction tracked by a [TxManager].
+type PendingTx struct {
+	// Nonce reserved for the transaction.
+	Nonce uint64
+	// ReservedAt is the time the nonce was reserved.
+	ReservedAt time.Time
+	// Txs are the signed transactions sent with the nonce in fee-bump order, latest last.
+	// It is empty if the nonce is reserved but no transaction has been signed yet.
+	Txs []*types.Transaction
+	// Resumed is true if the transaction was resumed from the journal after a restart.
+	Resumed bool
+	// Broadcast is true if any of the transactions was accepted by the node (i.e., it may still be mined).
+	// It isn't journaled, so resumed transactions are assumed broadcast by the previous process.
+	Broadcast bool
+}
+
 // Send is used to publish a transaction with incrementally higher gas prices
 // until the transaction eventually confirms. This method blocks until an
 // invocation of sendTx returns (called with differing gas prices). The method
@@ -155,7 +232,10 @@ type TxCandidate struct {
 //
 // NOTE: Send can be called concurrently, the nonce will be managed internally.
 func (m *simple) Send(ctx context.Context, candidate TxCandidate) (*types.Transaction, *ethclient.Receipt, error) {
-	tx, rec, err := m.doSend(ctx, candidate)
+	tx, rec, err := m.doSend(ctx, &candidate)
+	if candidate.Nonce != nil {
+		m.untrack(ctx, *candidate.Nonce, err)
+	}
 	if err != nil {
 		m.resetNonce()
 		return nil, nil, err
@@ -165,7 +245,8 @@ func (m *simple) Send(ctx context.Context, candidate TxCandidate) (*types.Transa
 }
 
 // doSend performs the actual transaction creation and sending.
-func (m *simple) doSend(ctx context.Context, candidate TxCandidate) (*types.Transaction, *ethclient.Receipt, error) {
+// The candidate nonce is populated if it was not set.
+func (m *simple) doSend(ctx context.Context, candidate *TxCandidate) (*types.Transaction, *ethclient.Receipt, error) {
 	ctx, cancel := maybeSetTimeout(ctx, m.cfg.TxSendTimeout)
 	defer cancel()
 
@@ -188,11 +269,16 @@ func (m *simple) doSend(ctx context.Context, candidate TxCandidate) (*types.Tran
 		}
 
 		// Create the initial transaction
-		tx, err := m.craftTx(ctx, candidate)
+		tx, err := m.craftTx(ctx, *candidate)
 		if err != nil {
 			log.Warn(ctx, "Failed to create transaction (will retry)", err, "nonce", *candidate.Nonce)
 			backoff()
 
+			continue
+		} else if err := m.trackTx(ctx, tx); err != nil {
+			log.Warn(ctx, "Failed to track transaction (will retry)", err, "nonce", *candidate.Nonce)
+			backoff()
+
 			continue
 		}
 
@@ -271,6 +357,191 @@ func (m *simple) resetNonce() {
 	m.nonceLock.Lock()
 	defer m.nonceLock.Unlock()
 	m.nonce = nil
+
+	// Unsigned reservations are released since the nonce is refetched and will be reused.
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+	for nonce, pending := range m.pending {
+		if len(pending.Txs) == 0 {
+			delete(m.pending, nonce)
+		}
+	}
+	pendingTxs.WithLabelValues(m.chainName).Set(float64(len(m.pending)))
+}
+
+// trackNonce starts tracking the newly reserved nonce in memory.
+// It is only persisted to the journal once a tx is signed with it, see trackTx.
+// Reservations that were never signed within the send timeout are released, since Send was never called with them.
+func (m *simple) trackNonce(nonce uint64) {
+	now := time.Now()
+
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	if m.pending == nil {
+		m.pending = make(map[uint64]*PendingTx)
+	}
+
+	for n, pending := range m.pending {
+		if len(pending.Txs) == 0 && now.Sub(pending.ReservedAt) > m.cfg.TxSendTimeout {
+			delete(m.pending, n)
+		}
+	}
+
+	m.pending[nonce] = &PendingTx{Nonce: nonce, ReservedAt: now}
+	pendingTxs.WithLabelValues(m.chainName).Set(float64(len(m.pending)))
+}
+
+// trackTx adds the signed tx to the fee-bump history of its nonce, persisting it to the journal if configured.
+func (m *simple) trackTx(ctx context.Context, tx *types.Transaction) error {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	if m.pending == nil {
+		m.pending = make(map[uint64]*PendingTx)
+	}
+	pending, ok := m.pending[tx.Nonce()]
+	if !ok {
+		// Nonce provided by caller without reservation (or reservation released).
+		pending = &PendingTx{Nonce: tx.Nonce(), ReservedAt: time.Now()}
+	}
+
+	if m.journal != nil {
+		if err := m.journal.append(ctx, m.chainID.Uint64(), m.cfg.From, pending.ReservedAt, tx); err != nil {
+			return errors.Wrap(err, "journal append tx", txFields(tx, false)...)
+		}
+		m.journal.setActive(m.chainID.Uint64(), m.cfg.From, tx.Nonce(), true)
+	}
+
+	m.pending[tx.Nonce()] = pending
+	pending.Txs = append(pending.Txs, tx)
+	pendingTxs.WithLabelValues(m.chainName).Set(float64(len(m.pending)))
+
+	return nil
+}
+
+// untrack stops tracking the nonce once sending completed.
+// If sending failed (including send timeouts) after a tx was broadcast (or resumed, since it may
+// have been broadcast before the restart) and no tx with the nonce was confirmed, or if sending was interrupted by the caller (e.g. shutdown), the journal entry
+// is retained since the tx may still be mined and should be resumed after restart.
+func (m *simple) untrack(ctx context.Context, nonce uint64, sendErr error) {
+	m.pendingLock.Lock()
+	pending, ok := m.pending[nonce]
+	delete(m.pending, nonce)
+	pendingTxs.WithLabelValues(m.chainName).Set(float64(len(m.pending)))
+	m.pendingLock.Unlock()
+
+	if m.journal == nil {
+		return
+	} else if !ok || len(pending.Txs) == 0 {
+		return // Nothing was journaled.
+	}
+
+	defer m.journal.setActive(m.chainID.Uint64(), m.cfg.From, nonce, false)
+
+	if sendErr != nil && ctx.Err() != nil {
+		log.Debug(ctx, "Retaining interrupted tx in journal", "nonce", nonce)
+		return
+	} else if sendErr != nil && (pending.Broadcast || pending.Resumed) && !m.nonceConfirmed(ctx, nonce) {
+		log.Debug(ctx, "Retaining unconfirmed broadcast tx in journal", "nonce", nonce)
+		return
+	}
+
+	if err := m.journal.remove(ctx, m.chainID.Uint64(), m.cfg.From, nonce); err != nil {
+		log.Warn(ctx, "Failed to remove tx from journal (will resume after restart)", err, "nonce", nonce)
+	}
+}
+
+// nonceConfirmed returns true if a tx with the nonce was confirmed, i.e., the account's latest nonce is higher.
+// It returns false if unknown.
+func (m *simple) nonceConfirmed(ctx context.Context, nonce uint64) bool {
+	ctx, cancel := maybeSetTimeout(ctx, m.cfg.NetworkTimeout)
+	defer cancel()
+
+	latest, err := m.backend.NonceAt(ctx, m.cfg.From, nil)
+	if err != nil {
+		log.Warn(ctx, "Failed to query confirmed nonce (will retain tx in journal)", err, "nonce", nonce)
+		return false
+	}
+
+	return latest > nonce
+}
+
+// markBroadcast marks the nonce's txs as broadcast.
+func (m *simple) markBroadcast(nonce uint64) {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	if pending, ok := m.pending[nonce]; ok {
+		pending.Broadcast = true
+	}
+}
+
+// isSending returns true if a signed tx with the provided nonce is still being sent.
+func (m *simple) isSending(nonce uint64) bool {
+	m.pendingLock.Lock()
+	defer m.pendingLock.Unlock()
+
+	pending, ok := m.pending[nonce]
+
+	return ok && len(pending.Txs) > 0
+}
+
+// resume resumes sending all journal txs not being sent by this process,
+// e.g. txs that were still in the mempool when the process restarted.
+// Resumed txs are sent asynchronously using the provided context.
+func (m *simple) resume(ctx context.Context) error {
+	entries, err := m.journal.list(ctx, m.chainID.Uint64(), m.cfg.From)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if len(entry.Txs) == 0 {
+			// Nonce was reserved but no tx was signed, so nothing to resume.
+			if err := m.journal.remove(ctx, m.chainID.Uint64(), m.cfg.From, entry.Nonce); err != nil {
+				return err
+			}
+
+			continue
+		} else if !m.journal.setActive(m.chainID.Uint64(), m.cfg.From, entry.Nonce, true) {
+			continue // Still being sent by another transaction manager of this process.
+		}
+
+		entry.Resumed = true
+		m.pendingLock.Lock()
+		if m.pending == nil {
+			m.pending = make(map[uint64]*PendingTx)
+		}
+		m.pending[entry.Nonce] = &entry
+		pendingTxs.WithLabelValues(m.chainName).Set(float64(len(m.pending)))
+		m.pendingLock.Unlock()
+
+		latest := entry.Txs[len(entry.Txs)-1]
+		log.Info(ctx, "Resuming in-flight journal tx", append(txFields(latest, true), "bumps", len(entry.Txs)-1)...)
+		resumedTotal.WithLabelValues(m.chainName).Inc()
+
+		go m.sendResumed(ctx, latest)
+	}
+
+	return nil
+}
+
+// sendResumed sends the resumed tx until it is confirmed, bumping fees if required.
+func (m *simple) sendResumed(ctx context.Context, tx *types.Transaction) {
+	sendCtx, cancel := maybeSetTimeout(ctx, m.cfg.TxSendTimeout)
+	defer cancel()
+
+	_, rec, err := m.sendTx(sendCtx, tx)
+	m.untrack(ctx, tx.Nonce(), err)
+	if err != nil {
+		log.Warn(ctx, "Failed sending resumed tx", err, "nonce", tx.Nonce())
+		m.resetNonce()
+
+		return
+	}
+
+	log.Info(ctx, "Resumed tx confirmed", "nonce", tx.Nonce(), "tx", rec.TxHash, "status", rec.Status)
 }
 
 // sendTx submits the same transaction several times with increasing gas prices as necessary.
@@ -355,6 +626,9 @@ func (m *simple) publishTx(ctx context.Context, tx *types.Transaction, sendState
 			if err != nil {
 				log.Warn(ctx, "Unable to increase gas", err)
 				return tx, false
+			} else if err := m.trackTx(ctx, newTx); err != nil {
+				log.Warn(ctx, "Unable to track fee bumped tx", err)
+				return tx, false
 			}
 			tx = newTx
 			sendState.bumpCount++
@@ -373,6 +647,7 @@ func (m *simple) publishTx(ctx context.Context, tx *types.Transaction, sendState
 		sendState.ProcessSendError(err)
 
 		if err == nil {
+			m.markBroadcast(tx.Nonce())
 			return tx, true
 		}
 
@@ -384,6 +659,7 @@ func (m *simple) publishTx(ctx context.Context, tx *types.Transaction, sendState
 		case errStringMatch(err, context.Canceled) || errStringMatch(err, context.DeadlineExceeded):
 			log.Warn(ctx, "Transaction send canceled", err)
 		case errStringMatch(err, txpool.ErrAlreadyKnown):
+			m.markBroadcast(tx.Nonce())
 			log.Warn(ctx, "Resubmitted already known transaction", err)
 		case errStringMatch(err, txpool.ErrReplaceUnderpriced):
 			log.Warn(ctx, "Transaction replacement is underpriced (will retry)", err)
@@ -186,18 +186,18 @@ func New(ctx context.Context, netID netconf.ID, opts ...option) (Connector, erro
 
 	ethClients := make(map[uint64]ethclient.Client)
 	for _, chain := range network.Chains {
-		rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		rpcs, err := endpoints.AllByNameOrID(chain.Name, chain.ID)
 		if err != nil {
-			rpc = "unknown"
+			rpcs = []string{"unknown"}
 		} else {
-			ethCl, err := ethclient.Dial(chain.Name, rpc)
+			ethCl, err := ethclient.DialMulti(chain.Name, rpcs)
 			if err != nil {
 				return Connector{}, errors.Wrap(err, "dial eth client")
 			}
 			ethClients[chain.ID] = ethCl
 		}
 
-		log.Info(ctx, "Network chain", "chain", chain.Name, "id", chain.ID, "rpc", libcmd.Redact("", rpc))
+		log.Info(ctx, "Network chain", "chain", chain.Name, "id", chain.ID, "rpc", libcmd.Redact("", rpcs[0]), "endpoints", len(rpcs))
 	}
 
 	// Connect to the halo cometBFT RPC server.
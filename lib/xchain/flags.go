@@ -2,22 +2,48 @@ package xchain
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/omni-network/omni/lib/errors"
 
 	"github.com/spf13/pflag"
 )
 
+// urlSeparator separates multiple RPC endpoint URLs of a single chain.
+const urlSeparator = "|"
+
+// RPCEndpoints maps chain names or IDs to one or more RPC endpoint URLs separated by "|".
 type RPCEndpoints map[string]string
 
+// ByNameOrID returns the primary (first) RPC endpoint URL of the chain.
 func (e RPCEndpoints) ByNameOrID(name string, chainID uint64) (string, error) {
-	if val := e[name]; val != "" {
-		return val, nil
-	} else if val := e[strconv.FormatUint(chainID, 10)]; val != "" {
-		return val, nil
+	urls, err := e.AllByNameOrID(name, chainID)
+	if err != nil {
+		return "", err
+	}
+
+	return urls[0], nil
+}
+
+// AllByNameOrID returns all RPC endpoint URLs of the chain.
+func (e RPCEndpoints) AllByNameOrID(name string, chainID uint64) ([]string, error) {
+	val := e[name]
+	if val == "" {
+		val = e[strconv.FormatUint(chainID, 10)]
+	}
+
+	var urls []string
+	for _, url := range strings.Split(val, urlSeparator) {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+
+	if len(urls) == 0 {
+		return nil, errors.New("no rpc endpoint for chain", "chain_name", name, "chain_id", chainID)
 	}
 
-	return "", errors.New("no rpc endpoint for chain", "chain_name", name, "chain_id", chainID)
+	return urls, nil
 }
 
 func (e RPCEndpoints) Keys() []string {
@@ -31,5 +57,5 @@ func (e RPCEndpoints) Keys() []string {
 
 // BindFlags binds the xchain evm rpc flag.
 func BindFlags(flags *pflag.FlagSet, endpoints *RPCEndpoints) {
-	flags.StringToStringVar((*map[string]string)(endpoints), "xchain-evm-rpc-endpoints", *endpoints, "Cross-chain EVM RPC endpoints. e.g. \"ethereum=http://geth:8545,optimism=https://optimism.io\"")
+	flags.StringToStringVar((*map[string]string)(endpoints), "xchain-evm-rpc-endpoints", *endpoints, "Cross-chain EVM RPC endpoints. Multiple endpoints per chain are separated by '|'. e.g. \"ethereum=http://geth:8545|https://eth.io,optimism=https://optimism.io\"")
 }
@@ -0,0 +1,34 @@
+package xchain_test
+
+import (
+	"testing"
+
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCEndpoints(t *testing.T) {
+	t.Parallel()
+
+	endpoints := xchain.RPCEndpoints{
+		"ethereum": "http://a | http://b|",
+		"10":       "http://c",
+		"empty":    " | ",
+	}
+
+	urls, err := endpoints.AllByNameOrID("ethereum", 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://a", "http://b"}, urls)
+
+	url, err := endpoints.ByNameOrID("ethereum", 1)
+	require.NoError(t, err)
+	require.Equal(t, "http://a", url)
+
+	url, err = endpoints.ByNameOrID("optimism", 10)
+	require.NoError(t, err)
+	require.Equal(t, "http://c", url)
+
+	_, err = endpoints.AllByNameOrID("empty", 2)
+	require.ErrorContains(t, err, "no rpc endpoint")
+}
@@ -125,3 +125,54 @@ func AttestationRoot(attHeader AttestHeader, blockHeader BlockHeader, msgRoot co
 
 	return tree[0], nil
 }
+
+// VerifyMsgs returns an error if the messages and the attestation's block header are not included
+// in the attestation root given the multi proof. Messages must be ordered by log index.
+// Inclusion is verified exactly as the portal contract does, see XBlockMerkleProof.verify.
+func VerifyMsgs(att Attestation, msgs []Msg, proof [][32]byte, proofFlags []bool) error {
+	attRoot, err := att.AttestationRoot()
+	if err != nil {
+		return errors.Wrap(err, "attestation root")
+	}
+
+	return verifyInclusion(attRoot, att.AttestHeader, att.BlockHeader, msgs, proof, proofFlags)
+}
+
+// VerifySubmission returns an error if the submission's messages and block header are not included
+// in its attestation root. It allows validating submissions before spending gas.
+// Note that it doesn't verify the attestation root signatures.
+func VerifySubmission(sub Submission) error {
+	return verifyInclusion(sub.AttestationRoot, sub.AttHeader, sub.BlockHeader, sub.Msgs, sub.Proof, sub.ProofFlags)
+}
+
+// verifyInclusion returns an error if the messages and submission header are not included in the attestation root.
+func verifyInclusion(attRoot common.Hash, attHeader AttestHeader, blockHeader BlockHeader, msgs []Msg, proof [][32]byte, proofFlags []bool) error {
+	leaves := make([][32]byte, 0, len(msgs))
+	for _, msg := range msgs {
+		leaf, err := msgLeaf(msg)
+		if err != nil {
+			return err
+		}
+		leaves = append(leaves, leaf)
+	}
+
+	msgRoot, err := merkle.ProcessMultiProof(merkle.MultiProof{
+		Leaves:     leaves,
+		Proof:      proof,
+		ProofFlags: proofFlags,
+	})
+	if err != nil {
+		return errors.Wrap(err, "process msg proof")
+	}
+
+	headerLeaf, err := submissionHeaderLeaf(attHeader, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	if !merkle.VerifyProof(attRoot, headerLeaf, [][32]byte{msgRoot}) {
+		return errors.New("invalid proof", "attestation_root", attRoot)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package xchain_test
 
 import (
 	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/omni-network/omni/lib/xchain"
@@ -60,3 +61,57 @@ func TestOrderedMsgs(t *testing.T) {
 	_, err := xchain.NewMsgTree(msgs)
 	require.ErrorContains(t, err, "not ordered")
 }
+
+func TestVerifyMsgs(t *testing.T) {
+	t.Parallel()
+	fuzzer := fuzz.New().NilChance(0).NumElements(2, 64)
+
+	var msgs []xchain.Msg
+	fuzzer.Fuzz(&msgs)
+
+	// Ensure msg.LogIndex is increasing
+	for i := 1; i < len(msgs); i++ {
+		msgs[i].LogIndex = msgs[i-1].LogIndex + 1 + uint64(rand.Intn(1000))
+	}
+
+	tree, err := xchain.NewMsgTree(msgs)
+	require.NoError(t, err)
+
+	var att xchain.Attestation
+	fuzzer.Fuzz(&att)
+	att.MsgRoot = tree.MsgRoot()
+	att.ChainVersion.ID = att.ChainID
+
+	attRoot, err := att.AttestationRoot()
+	require.NoError(t, err)
+
+	start := rand.Intn(len(msgs))
+	subMsgs := msgs[start : start+1+rand.Intn(len(msgs)-start)]
+
+	multi, err := tree.Proof(subMsgs)
+	require.NoError(t, err)
+
+	require.NoError(t, xchain.VerifyMsgs(att, subMsgs, multi.Proof, multi.ProofFlags))
+
+	sub := xchain.Submission{
+		AttestationRoot: attRoot,
+		AttHeader:       att.AttestHeader,
+		BlockHeader:     att.BlockHeader,
+		Msgs:            subMsgs,
+		Proof:           multi.Proof,
+		ProofFlags:      multi.ProofFlags,
+	}
+	require.NoError(t, xchain.VerifySubmission(sub))
+
+	// Tampered message
+	tampered := slices.Clone(subMsgs)
+	tampered[0].DestGasLimit++
+	require.ErrorContains(t, xchain.VerifyMsgs(att, tampered, multi.Proof, multi.ProofFlags), "invalid proof")
+
+	// Tampered header
+	sub.BlockHeader.BlockHeight++
+	require.ErrorContains(t, xchain.VerifySubmission(sub), "invalid proof")
+
+	// Malformed proof
+	require.Error(t, xchain.VerifyMsgs(att, subMsgs, multi.Proof, append(multi.ProofFlags, true)))
+}
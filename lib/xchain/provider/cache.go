@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// BlockCache is a persistent size-bounded cache of finalized xblocks by chain version and height.
+// It avoids refetching the same finalized xblocks over RPC.
+//
+// When a chain version exceeds the maximum number of cached blocks,
+// the lowest heights are evicted first.
+type BlockCache struct {
+	db        dbm.DB
+	maxBlocks uint64 // Maximum number of cached blocks per chain version
+
+	mu     sync.Mutex
+	counts map[xchain.ChainVersion]uint64 // Lazily loaded number of cached blocks per chain version
+}
+
+// NewBlockCache returns a new xblock cache backed by the provided DB,
+// bounded to maxBlocks per chain version.
+func NewBlockCache(db dbm.DB, maxBlocks uint64) (*BlockCache, error) {
+	if maxBlocks == 0 {
+		return nil, errors.New("zero max blocks")
+	}
+
+	return &BlockCache{
+		db:        db,
+		maxBlocks: maxBlocks,
+		counts:    make(map[xchain.ChainVersion]uint64),
+	}, nil
+}
+
+// Get returns the cached block of the chain version and height, or false if not cached.
+func (c *BlockCache) Get(chainVer xchain.ChainVersion, height uint64) (xchain.Block, bool, error) {
+	bz, err := c.prefixDB(chainVer).Get(heightKey(height))
+	if err != nil {
+		return xchain.Block{}, false, errors.Wrap(err, "get block")
+	} else if bz == nil {
+		return xchain.Block{}, false, nil
+	}
+
+	var block xchain.Block
+	if err := json.Unmarshal(bz, &block); err != nil {
+		return xchain.Block{}, false, errors.Wrap(err, "unmarshal block")
+	}
+
+	return block, true, nil
+}
+
+// Add caches the finalized block of the chain version, evicting the lowest heights if the cache is full.
+func (c *BlockCache) Add(chainVer xchain.ChainVersion, block xchain.Block) error {
+	if !chainVer.ConfLevel.IsFinalized() {
+		return errors.New("only finalized blocks can be cached", "conf_level", chainVer.ConfLevel)
+	} else if block.ChainID != chainVer.ID {
+		return errors.New("block chain ID mismatch", "expected", chainVer.ID, "actual", block.ChainID)
+	}
+
+	bz, err := json.Marshal(block)
+	if err != nil {
+		return errors.Wrap(err, "marshal block")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	count, err := c.countUnsafe(chainVer)
+	if err != nil {
+		return err
+	}
+
+	db := c.prefixDB(chainVer)
+	key := heightKey(block.BlockHeight)
+
+	if ok, err := db.Has(key); err != nil {
+		return errors.Wrap(err, "has block")
+	} else if !ok {
+		count++
+	}
+
+	if err := db.Set(key, bz); err != nil {
+		return errors.Wrap(err, "set block")
+	}
+
+	// Evict lowest heights
+	for count > c.maxBlocks {
+		if err := deleteLowest(db); err != nil {
+			return err
+		}
+		count--
+	}
+
+	c.counts[chainVer] = count
+
+	return nil
+}
+
+// Blocks calls fn for each cached block of the chain version in ascending height order.
+func (c *BlockCache) Blocks(chainVer xchain.ChainVersion, fn func(xchain.Block) error) error {
+	iter, err := c.prefixDB(chainVer).Iterator(nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var block xchain.Block
+		if err := json.Unmarshal(iter.Value(), &block); err != nil {
+			return errors.Wrap(err, "unmarshal block")
+		}
+
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(err, "iterate blocks")
+	}
+
+	return nil
+}
+
+// countUnsafe returns the number of cached blocks of the chain version, loading it from the DB if required.
+// It is unsafe since it assumes the lock is held.
+func (c *BlockCache) countUnsafe(chainVer xchain.ChainVersion) (uint64, error) {
+	if count, ok := c.counts[chainVer]; ok {
+		return count, nil
+	}
+
+	iter, err := c.prefixDB(chainVer).Iterator(nil, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, errors.Wrap(err, "iterate blocks")
+	}
+
+	c.counts[chainVer] = count
+
+	return count, nil
+}
+
+func (c *BlockCache) prefixDB(chainVer xchain.ChainVersion) dbm.DB {
+	prefix := binary.BigEndian.AppendUint64(nil, chainVer.ID)
+	prefix = append(prefix, byte(chainVer.ConfLevel))
+
+	return dbm.NewPrefixDB(c.db, prefix)
+}
+
+// deleteLowest deletes the lowest height of the DB.
+func deleteLowest(db dbm.DB) error {
+	key, err := lowestKey(db)
+	if err != nil {
+		return err
+	}
+
+	// Delete after closing the iterator, since some DBs lock during iteration.
+	if err := db.Delete(key); err != nil {
+		return errors.Wrap(err, "delete block")
+	}
+
+	return nil
+}
+
+// lowestKey returns the lowest key of the DB.
+func lowestKey(db dbm.DB) ([]byte, error) {
+	iter, err := db.Iterator(nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	if err := iter.Error(); err != nil {
+		return nil, errors.Wrap(err, "iterate blocks")
+	} else if !iter.Valid() {
+		return nil, errors.New("no blocks to evict [BUG]")
+	}
+
+	return bytes.Clone(iter.Key()), nil
+}
+
+// heightKey returns the big-endian height as DB key, so iteration is in ascending height order.
+func heightKey(height uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, height)
+}
@@ -0,0 +1,117 @@
+package provider_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/lib/xchain/provider"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockCache(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+	chainVer := xchain.ChainVersion{ID: chainID, ConfLevel: xchain.ConfFinalized}
+
+	cache, err := provider.NewBlockCache(db.NewMemDB(), 3)
+	require.NoError(t, err)
+
+	for h := uint64(1); h <= 5; h++ {
+		require.NoError(t, cache.Add(chainVer, newBlock(chainID, h)))
+	}
+
+	// Lowest heights are evicted
+	for h := uint64(1); h <= 5; h++ {
+		block, ok, err := cache.Get(chainVer, h)
+		require.NoError(t, err)
+		require.Equal(t, h > 2, ok)
+		if ok {
+			require.Equal(t, h, block.BlockHeight)
+		}
+	}
+
+	// Only finalized blocks of the chain are cached
+	require.ErrorContains(t, cache.Add(xchain.ChainVersion{ID: chainID, ConfLevel: xchain.ConfLatest}, newBlock(chainID, 6)), "only finalized")
+	require.ErrorContains(t, cache.Add(chainVer, newBlock(chainID+1, 6)), "chain ID mismatch")
+
+	// Export and import into a new cache
+	var buf bytes.Buffer
+	n, err := cache.Export(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	imported, err := provider.NewBlockCache(db.NewMemDB(), 10)
+	require.NoError(t, err)
+	n, err = imported.Import(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	var heights []uint64
+	err = imported.Blocks(chainVer, func(block xchain.Block) error {
+		expect, ok, err := cache.Get(chainVer, block.BlockHeight)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, expect, block)
+		heights = append(heights, block.BlockHeight)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, heights)
+}
+
+func TestGetBlockCached(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const chainID = 100
+	chainVer := xchain.ChainVersion{ID: chainID, ConfLevel: xchain.ConfFinalized}
+
+	cache, err := provider.NewBlockCache(db.NewMemDB(), 10)
+	require.NoError(t, err)
+
+	cached := newBlock(chainID, 7)
+	require.NoError(t, cache.Add(chainVer, cached))
+
+	network := netconf.Network{ID: netconf.Simnet, Chains: []netconf.Chain{{ID: chainID, Name: "mock"}}}
+	xprov := provider.New(network, nil, nil, provider.WithBlockCache(cache))
+
+	// Cached blocks are returned without RPC clients
+	block, ok, err := xprov.GetBlock(ctx, xchain.ProviderRequest{ChainID: chainID, Height: 7, ConfLevel: xchain.ConfFinalized})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, cached, block)
+
+	// Uncached and fuzzy blocks are fetched
+	_, _, err = xprov.GetBlock(ctx, xchain.ProviderRequest{ChainID: chainID, Height: 8, ConfLevel: xchain.ConfFinalized})
+	require.ErrorContains(t, err, "no rpc client")
+	_, _, err = xprov.GetBlock(ctx, xchain.ProviderRequest{ChainID: chainID, Height: 7, ConfLevel: xchain.ConfLatest})
+	require.ErrorContains(t, err, "no rpc client")
+}
+
+func newBlock(chainID uint64, height uint64) xchain.Block {
+	return xchain.Block{
+		BlockHeader: xchain.BlockHeader{
+			ChainID:     chainID,
+			BlockHeight: height,
+			BlockHash:   tutil.RandomHash(),
+		},
+		Msgs: []xchain.Msg{{
+			MsgID: xchain.MsgID{
+				StreamID:     xchain.StreamID{SourceChainID: chainID, DestChainID: 1, ShardID: xchain.ShardFinalized0},
+				StreamOffset: height,
+			},
+			Data: []byte("data"),
+		}},
+		ParentHash: tutil.RandomHash(),
+		Timestamp:  time.Unix(int64(height), 0).UTC(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/omni-network/omni/lib/cchain"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/tracer"
 	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
@@ -164,11 +165,43 @@ func (p *Provider) GetSubmittedCursor(
 }
 
 // GetBlock returns the XBlock for the provided chain and height, or false if not available yet (not finalized),
-// or an error.
+// or an error. Finalized blocks are served from and added to the block cache if configured.
 func (p *Provider) GetBlock(ctx context.Context, req xchain.ProviderRequest) (xchain.Block, bool, error) {
 	ctx, span := tracer.Start(ctx, spanName("get_block"))
 	defer span.End()
 
+	if p.cache == nil || !req.ConfLevel.IsFinalized() {
+		return p.fetchBlock(ctx, req)
+	}
+
+	chainVer := req.ChainVersion()
+	chainVerName := p.network.ChainVersionName(chainVer)
+
+	if block, ok, err := p.cache.Get(chainVer, req.Height); err != nil {
+		return xchain.Block{}, false, errors.Wrap(err, "get cached block")
+	} else if ok {
+		blockCacheLookups.WithLabelValues(chainVerName, "hit").Inc()
+		return block, true, nil
+	}
+
+	blockCacheLookups.WithLabelValues(chainVerName, "miss").Inc()
+
+	block, ok, err := p.fetchBlock(ctx, req)
+	if err != nil || !ok {
+		return block, ok, err
+	}
+
+	// Caching is best-effort, failing to cache must not fail fetching.
+	if err := p.cache.Add(chainVer, block); err != nil {
+		log.Warn(ctx, "Failed caching xblock", err, "chain", chainVerName, "height", req.Height)
+	}
+
+	return block, true, nil
+}
+
+// fetchBlock fetches the XBlock for the provided chain and height, or false if not available yet (not finalized),
+// or an error.
+func (p *Provider) fetchBlock(ctx context.Context, req xchain.ProviderRequest) (xchain.Block, bool, error) {
 	//nolint:nestif // Not so bad
 	if req.ChainID == p.cChainID {
 		if p.cProvider == nil {
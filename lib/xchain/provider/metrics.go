@@ -34,4 +34,11 @@ var (
 		Help:      "Callback latency in seconds per source chain version and type. Alert if growing.",
 		Buckets:   []float64{.001, .002, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
 	}, []string{"chain_version", "type"})
+
+	blockCacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "lib",
+		Subsystem: "xprovider",
+		Name:      "block_cache_lookups_total",
+		Help:      "Total number of xblock cache lookups per source chain version and result (hit or miss).",
+	}, []string{"chain_version", "result"})
 )
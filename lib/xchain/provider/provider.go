@@ -52,6 +52,16 @@ func getWorkers(chain netconf.Chain) (uint64, error) {
 
 var _ xchain.Provider = (*Provider)(nil)
 
+// Option configures a Provider.
+type Option func(*Provider)
+
+// WithBlockCache returns an option that caches finalized xblocks fetched by GetBlock.
+func WithBlockCache(cache *BlockCache) Option {
+	return func(p *Provider) {
+		p.cache = cache
+	}
+}
+
 // Provider stores the source chain configuration and the global quit channel.
 type Provider struct {
 	network     netconf.Network
@@ -59,6 +69,7 @@ type Provider struct {
 	cChainID    uint64
 	cProvider   cchain.Provider
 	backoffFunc func(context.Context) func()
+	cache       *BlockCache // Optional finalized xblock cache
 
 	mu sync.Mutex
 	// confHeads caches the latest height by chain version.
@@ -71,7 +82,7 @@ type Provider struct {
 
 // New instantiates the provider instance which will be ready to accept
 // subscriptions for respective destination XBlocks.
-func New(network netconf.Network, rpcClients map[uint64]ethclient.Client, cProvider cchain.Provider) *Provider {
+func New(network netconf.Network, rpcClients map[uint64]ethclient.Client, cProvider cchain.Provider, opts ...Option) *Provider {
 	backoffFunc := func(ctx context.Context) func() {
 		// Limit backoff to 10s for all EVM chains.
 		const maxDelay = time.Second * 10
@@ -83,7 +94,7 @@ func New(network netconf.Network, rpcClients map[uint64]ethclient.Client, cProvi
 
 	cChain, _ := network.OmniConsensusChain()
 
-	return &Provider{
+	p := &Provider{
 		network:     network,
 		ethClients:  rpcClients,
 		cChainID:    cChain.ID,
@@ -91,6 +102,11 @@ func New(network netconf.Network, rpcClients map[uint64]ethclient.Client, cProvi
 		backoffFunc: backoffFunc,
 		confHeads:   make(map[xchain.ChainVersion]uint64),
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
 
 // StreamAsync starts a goroutine that streams xblocks asynchronously forever.
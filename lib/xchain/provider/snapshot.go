@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/xchain"
+)
+
+// snapshotVersion is the version of the xblock cache snapshot format.
+const snapshotVersion = 1
+
+// snapshotHeader is the first JSON value of a snapshot.
+type snapshotHeader struct {
+	Version int `json:"version"`
+}
+
+// snapshotRecord is a cached block of a snapshot.
+type snapshotRecord struct {
+	ChainID   uint64           `json:"chain_id"`
+	ConfLevel xchain.ConfLevel `json:"conf_level"`
+	Block     xchain.Block     `json:"block"`
+}
+
+// Export writes all cached blocks to the writer as a snapshot of newline-delimited JSON values.
+// It returns the number of exported blocks.
+func (c *BlockCache) Export(w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	if err := enc.Encode(snapshotHeader{Version: snapshotVersion}); err != nil {
+		return 0, errors.Wrap(err, "encode header")
+	}
+
+	iter, err := c.db.Iterator(nil, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "iterator")
+	}
+	defer iter.Close()
+
+	var count int
+	for ; iter.Valid(); iter.Next() {
+		const keyLen = 8 + 1 + 8 // chainID + confLevel + height
+		key := iter.Key()
+		if len(key) != keyLen {
+			return 0, errors.New("invalid cache key length", "len", len(key))
+		}
+
+		var block xchain.Block
+		if err := json.Unmarshal(iter.Value(), &block); err != nil {
+			return 0, errors.Wrap(err, "unmarshal block")
+		}
+
+		err := enc.Encode(snapshotRecord{
+			ChainID:   binary.BigEndian.Uint64(key[:8]),
+			ConfLevel: xchain.ConfLevel(key[8]),
+			Block:     block,
+		})
+		if err != nil {
+			return 0, errors.Wrap(err, "encode block")
+		}
+		count++
+	}
+
+	if err := iter.Error(); err != nil {
+		return 0, errors.Wrap(err, "iterate blocks")
+	}
+
+	return count, nil
+}
+
+// Import reads a snapshot from the reader, adding all its blocks to the cache.
+// It returns the number of imported blocks.
+//
+// Note that imported blocks are not verified against the source chains,
+// so only import snapshots from trusted sources.
+func (c *BlockCache) Import(r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+
+	var header snapshotHeader
+	if err := dec.Decode(&header); err != nil {
+		return 0, errors.Wrap(err, "decode header")
+	} else if header.Version != snapshotVersion {
+		return 0, errors.New("unsupported snapshot version", "version", header.Version)
+	}
+
+	var count int
+	for dec.More() {
+		var record snapshotRecord
+		if err := dec.Decode(&record); err != nil {
+			return 0, errors.Wrap(err, "decode block")
+		}
+
+		chainVer := xchain.ChainVersion{ID: record.ChainID, ConfLevel: record.ConfLevel}
+		if err := c.Add(chainVer, record.Block); err != nil {
+			return 0, errors.Wrap(err, "add block", "chain_id", record.ChainID, "height", record.Block.BlockHeight)
+		}
+		count++
+	}
+
+	return count, nil
+}
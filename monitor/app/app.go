@@ -64,7 +64,16 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	xprov := xprovider.New(network, ethClients, cprov)
+	var xprovOpts []xprovider.Option
+	if cfg.XBlockCacheSize > 0 {
+		cache, err := newBlockCache(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		xprovOpts = append(xprovOpts, xprovider.WithBlockCache(cache))
+	}
+
+	xprov := xprovider.New(network, ethClients, cprov, xprovOpts...)
 
 	account.StartMonitoring(ctx, network, ethClients)
 
@@ -152,6 +161,23 @@ func startIndexer(
 	return indexer.Start(ctx, network, xprov, db)
 }
 
+// newBlockCache returns the persistent xblock cache.
+func newBlockCache(ctx context.Context, cfg Config) (*xprovider.BlockCache, error) {
+	var db dbm.DB
+	if cfg.DBDir == "" {
+		log.Warn(ctx, "No --db-dir provided, using in-memory xblock cache", nil)
+		db = dbm.NewMemDB()
+	} else {
+		var err error
+		db, err = dbm.NewGoLevelDB("xblocks", cfg.DBDir, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "new golevel db")
+		}
+	}
+
+	return xprovider.NewBlockCache(db, cfg.XBlockCacheSize)
+}
+
 // serveMonitoring starts a goroutine that serves the monitoring API. It
 // returns a channel that will receive an error if the server fails to start.
 func serveMonitoring(address string) <-chan error {
@@ -212,13 +238,13 @@ func makePortalRegistry(network netconf.ID, endpoints xchain.RPCEndpoints) (*bin
 func initializeEthClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints) (map[uint64]ethclient.Client, error) {
 	rpcClientPerChain := make(map[uint64]ethclient.Client)
 	for _, chain := range chains {
-		rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		rpcs, err := endpoints.AllByNameOrID(chain.Name, chain.ID)
 		if err != nil {
 			return nil, err
 		}
-		c, err := ethclient.Dial(chain.Name, rpc)
+		c, err := ethclient.DialMulti(chain.Name, rpcs)
 		if err != nil {
-			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_url", rpc)
+			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID)
 		}
 		rpcClientPerChain[chain.ID] = c
 	}
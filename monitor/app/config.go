@@ -27,6 +27,7 @@ type Config struct {
 	LoadGen         loadgen.Config
 	XFeeMngr        xfeemngr.Config
 	DBDir           string
+	XBlockCacheSize uint64
 	RouteScanAPIKey string
 }
 
@@ -27,6 +27,10 @@ halo-grpc-url = "{{ .HaloGRPCURL }}"
 # The RouteScan API key used for increased rate limits on RouteScan.
 routescan-apikey = "{{ .RouteScanAPIKey }}"
 
+# Maximum number of finalized xblocks cached per chain in the database directory, avoiding refetching over RPC.
+# Zero disables the cache.
+xblock-cache-size = {{ .XBlockCacheSize }}
+
 #######################################################################
 ###                             X-Chain                             ###
 #######################################################################
@@ -35,6 +39,7 @@ routescan-apikey = "{{ .RouteScanAPIKey }}"
 
 # Cross-chain EVM RPC endpoints to use for voting; only required for validators. One per supported EVM is required.
 # It is strongly advised to operate fullnodes for each chain and NOT to use free public RPCs.
+# Multiple endpoints per chain may be separated by '|' for failover, e.g. "http://geth:8545|https://eth.io".
 [xchain.evm-rpc-endpoints]
 {{- if not .RPCEndpoints }}
 # ethereum = "http://my-ethreum-node:8545"
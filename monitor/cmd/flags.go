@@ -18,6 +18,7 @@ func bindRunFlags(flags *pflag.FlagSet, cfg *monitor.Config) {
 	flags.StringVar(&cfg.HaloCometURL, "halo-url", cfg.HaloCometURL, "The URL of the halo node e.g localhost:26657")
 	flags.StringVar(&cfg.HaloGRPCURL, "halo-grpc-url", cfg.HaloGRPCURL, "The gRPC URL of the halo node e.g localhost:9999")
 	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	flags.Uint64Var(&cfg.XBlockCacheSize, "xblock-cache-size", cfg.XBlockCacheSize, "Maximum number of finalized xblocks cached per chain in the database directory. Zero disables the cache")
 	flags.StringVar(&cfg.RouteScanAPIKey, "routescan-apikey", cfg.RouteScanAPIKey, "The RouteScan API key to use their APIs with higher rate limits")
 }
 
@@ -27,6 +28,6 @@ func bindLoadGenFlags(flags *pflag.FlagSet, cfg *loadgen.Config) {
 }
 
 func bindXFeeMngrFlags(flags *pflag.FlagSet, cfg *xfeemngr.Config) {
-	flags.StringToStringVar((*map[string]string)(&cfg.RPCEndpoints), "xfeemngr-rpc-endpoints", cfg.RPCEndpoints, "Cross-chain EVM RPC endpoints. e.g. \"ethereum=http://geth:8545,optimism=https://optimism.io\"")
+	flags.StringToStringVar((*map[string]string)(&cfg.RPCEndpoints), "xfeemngr-rpc-endpoints", cfg.RPCEndpoints, "Cross-chain EVM RPC endpoints. Multiple endpoints per chain are separated by '|'. e.g. \"ethereum=http://geth:8545|https://eth.io,optimism=https://optimism.io\"")
 	flags.StringVar(&cfg.CoinGeckoAPIKey, "xfeemngr-coingecko-apikey", cfg.CoinGeckoAPIKey, "The CoinGecko API key to use for fetching token prices")
 }
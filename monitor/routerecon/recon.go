@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/omni-network/omni/e2e/app/eoa"
+	"github.com/omni-network/omni/lib/contracts"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/lib/log"
@@ -117,8 +118,8 @@ func reconCrossTx(
 	receipt, err := receiptByID(dst.XBlock.Receipts, msgID)
 	if err != nil {
 		return err
-	} else if receipt.RelayerAddress != relayerAddr {
-		return errors.New("receipt relayer mismatch", "got", receipt.RelayerAddress, "want", relayerAddr)
+	} else if relayer := attributeRelayer(receipt.RelayerAddress, dst.Sender); relayer != relayerAddr {
+		return errors.New("receipt relayer mismatch", "got", relayer, "want", relayerAddr)
 	}
 
 	if err := verifySrc(crossTx, src, msg); err != nil {
@@ -140,6 +141,16 @@ func reconCrossTx(
 	return nil
 }
 
+// attributeRelayer returns the relayer of the xreceipt. Submissions batched by the relayer
+// are sent via Multicall3, which the portal reports as relayer, so they are attributed to the destination tx sender.
+func attributeRelayer(receiptRelayer common.Address, txSender common.Address) common.Address {
+	if receiptRelayer == contracts.Multicall3() {
+		return txSender
+	}
+
+	return receiptRelayer
+}
+
 func verifyDst(crossTx crossTxJSON, dst source, receipt xchain.Receipt, relayerAddr common.Address) error {
 	if crossTx.DstBlockHash != dst.XBlock.BlockHash {
 		return errors.New("block hash mismatch", "got", crossTx.DstBlockHash, "want", dst.XBlock.BlockHash)
@@ -153,12 +164,13 @@ func verifyDst(crossTx crossTxJSON, dst source, receipt xchain.Receipt, relayerA
 		return errors.New("timestamp mismatch", "got", crossTx.DstTimestamp, "want", dst.XBlock.Timestamp)
 	}
 
-	if crossTx.Data.Relayer != dst.Sender {
-		return errors.New("relayer not destination tx sender", "relayer", crossTx.From, "sender", dst.Sender)
+	relayer := attributeRelayer(crossTx.Data.Relayer, dst.Sender)
+	if relayer != dst.Sender {
+		return errors.New("relayer not destination tx sender", "relayer", crossTx.Data.Relayer, "sender", dst.Sender)
 	}
 
-	if crossTx.Data.Relayer != relayerAddr {
-		return errors.New("relayer mismatch", "got", crossTx.Data.Relayer, "want", relayerAddr)
+	if relayer != relayerAddr {
+		return errors.New("relayer mismatch", "got", relayer, "want", relayerAddr)
 	}
 
 	if crossTx.Data.GasUsed != receipt.GasUsed {
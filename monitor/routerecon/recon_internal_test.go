@@ -6,12 +6,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/omni-network/omni/lib/contracts"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
 	xconnect "github.com/omni-network/omni/lib/xchain/connect"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -136,3 +139,13 @@ func (a allCallback) Match(crossTx crossTxJSON) (bool, error) {
 func fmtTime(t time.Time) string {
 	return t.Format("01-02 15:04")
 }
+
+func TestAttributeRelayer(t *testing.T) {
+	t.Parallel()
+
+	relayer := common.HexToAddress("0x01")
+	sender := common.HexToAddress("0x02")
+
+	require.Equal(t, relayer, attributeRelayer(relayer, sender))
+	require.Equal(t, sender, attributeRelayer(contracts.Multicall3(), sender))
+}
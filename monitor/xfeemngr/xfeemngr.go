@@ -195,14 +195,14 @@ func makeEthClients(chains []evmchain.Metadata, rpcs xchain.RPCEndpoints) (map[u
 	clients := make(map[uint64]ethclient.Client)
 
 	for _, chain := range chains {
-		rpc, err := rpcs.ByNameOrID(chain.Name, chain.ChainID)
+		urls, err := rpcs.AllByNameOrID(chain.Name, chain.ChainID)
 		if err != nil {
 			return nil, err
 		}
 
-		c, err := ethclient.Dial(chain.Name, rpc)
+		c, err := ethclient.DialMulti(chain.Name, urls)
 		if err != nil {
-			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ChainID, "rpc_url", rpc)
+			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ChainID)
 		}
 
 		clients[chain.ChainID] = c
@@ -95,6 +95,27 @@ func (k *Keeper) InsertWithdrawal(ctx context.Context, withdrawalAddr common.Add
 	return nil
 }
 
+// ListWithdrawals returns all pending withdrawals, sorted by id (oldest to newest).
+func (k *Keeper) ListWithdrawals(ctx context.Context) ([]*Withdrawal, error) {
+	iter, err := k.withdrawalTable.List(ctx, WithdrawalPrimaryKey{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list withdrawals")
+	}
+	defer iter.Close()
+
+	var withdrawals []*Withdrawal
+	for iter.Next() {
+		val, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "get withdrawal")
+		}
+
+		withdrawals = append(withdrawals, val)
+	}
+
+	return withdrawals, nil
+}
+
 // listWithdrawalsByAddress returns all withdrawals with provided address.
 func (k *Keeper) listWithdrawalsByAddress(ctx context.Context, withdrawalAddr common.Address) ([]*Withdrawal, error) {
 	iter, err := k.withdrawalTable.List(ctx, WithdrawalAddressIndexKey{}.WithAddress(withdrawalAddr[:]))
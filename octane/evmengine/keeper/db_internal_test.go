@@ -6,6 +6,7 @@ import (
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/k1util"
 	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/octane/evmengine/types"
 
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
@@ -133,3 +134,61 @@ func TestKeeper_withdrawalsPersistence(t *testing.T) {
 	matchesTestCase(withdrawalsByHeight[0], inputs[0])
 	matchesTestCase(withdrawalsByHeight[1], inputs[1])
 }
+
+func TestKeeper_genesisWithdrawals(t *testing.T) {
+	t.Parallel()
+
+	cdc := getCodec(t)
+	txConfig := authtx.NewTxConfig(cdc, nil)
+
+	newKeeper := func() (*Keeper, sdk.Context) {
+		mockEngine, err := newMockEngineAPI(0)
+		require.NoError(t, err)
+
+		header := cmtproto.Header{Height: 1, AppHash: tutil.RandomHash().Bytes()}
+		ctx, storeService := setupCtxStore(t, &header)
+
+		keeper, err := NewKeeper(cdc, storeService, &mockEngine, txConfig, mockAddressProvider{}, newRandomFeeRecipientProvider(), 10)
+		require.NoError(t, err)
+
+		return keeper, ctx
+	}
+
+	keeper, ctx := newKeeper()
+	populateGenesisHead(ctx, t, keeper)
+
+	addr1 := tutil.RandomAddress()
+	addr2 := tutil.RandomAddress()
+	require.NoError(t, keeper.InsertWithdrawal(ctx, addr1, 777))
+	require.NoError(t, keeper.InsertWithdrawal(ctx, addr2, 8888))
+	require.NoError(t, keeper.InsertWithdrawal(ctx, addr1, 99999))
+
+	// Export and import the genesis state via JSON, as done by the module.
+	exported, err := keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, exported.Withdrawals, 3)
+
+	bz, err := cdc.MarshalJSON(exported)
+	require.NoError(t, err)
+
+	var genState types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &genState))
+
+	imported, importedCtx := newKeeper()
+	require.NoError(t, imported.InitGenesis(importedCtx, &genState))
+
+	head, err := imported.GetExecutionHead(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, exported.ExecutionBlockHash, head.GetBlockHash())
+
+	withdrawals, err := keeper.EligibleWithdrawals(ctx.WithBlockHeight(2), false)
+	require.NoError(t, err)
+	importedWithdrawals, err := imported.EligibleWithdrawals(importedCtx.WithBlockHeight(2), false)
+	require.NoError(t, err)
+	require.Equal(t, withdrawals, importedWithdrawals)
+
+	// Re-exporting the imported state is identical.
+	reexported, err := imported.ExportGenesis(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
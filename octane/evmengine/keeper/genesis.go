@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/cast"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/octane/evmengine/types"
+)
+
+// InitGenesis initializes the genesis execution head and pending withdrawals from the genesis state.
+// Withdrawals are re-inserted in order, so they are assigned new ids.
+func (k *Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) error {
+	if err := k.InsertGenesisHead(ctx, data.ExecutionBlockHash); err != nil {
+		return errors.Wrap(err, "insert genesis head")
+	}
+
+	for _, w := range data.Withdrawals {
+		addr, err := cast.EthAddress(w.Address)
+		if err != nil {
+			return errors.Wrap(err, "genesis withdrawal address")
+		}
+
+		if err := k.InsertWithdrawal(ctx, addr, w.AmountGwei); err != nil {
+			return errors.Wrap(err, "insert genesis withdrawal")
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis returns the genesis state containing the current execution head and all pending withdrawals.
+func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	head, err := k.GetExecutionHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals, err := k.ListWithdrawals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := &types.GenesisState{
+		ExecutionBlockHash: head.GetBlockHash(),
+	}
+	for _, w := range withdrawals {
+		genState.Withdrawals = append(genState.Withdrawals, types.GenesisWithdrawal{
+			Address:    w.GetAddress(),
+			AmountGwei: w.GetAmountGwei(),
+		})
+	}
+
+	return genState, nil
+}
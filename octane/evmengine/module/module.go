@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log"
 
+	"github.com/omni-network/omni/lib/cast"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
 	"github.com/omni-network/omni/octane/evmengine/keeper"
@@ -90,22 +91,20 @@ func (m AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, raw json.Ra
 	var data types.GenesisState
 	cdc.MustUnmarshalJSON(raw, &data)
 
-	if err := m.keeper.InsertGenesisHead(ctx, data.ExecutionBlockHash); err != nil {
-		panic(errors.Wrap(err, "insert genesis head"))
+	if err := m.keeper.InitGenesis(ctx, &data); err != nil {
+		panic(errors.Wrap(err, "init genesis"))
 	}
 }
 
 func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
 	log.Println("!!!WARN!!! You must modify evmengine.execution_block_hash in genesis file correctly.")
 
-	head, err := m.keeper.GetExecutionHead(ctx)
+	genState, err := m.keeper.ExportGenesis(ctx)
 	if err != nil {
 		panic(err)
 	}
-	genState := types.GenesisState{
-		ExecutionBlockHash: head.BlockHash,
-	}
-	return cdc.MustMarshalJSON(&genState)
+
+	return cdc.MustMarshalJSON(genState)
 }
 
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
@@ -124,6 +123,14 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 		return errors.New("invalid execution block hash length")
 	}
 
+	for _, w := range data.Withdrawals {
+		if _, err := cast.EthAddress(w.Address); err != nil {
+			return errors.Wrap(err, "invalid withdrawal address")
+		} else if w.AmountGwei == 0 {
+			return errors.New("zero withdrawal amount")
+		}
+	}
+
 	return nil
 }
 
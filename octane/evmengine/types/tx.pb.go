@@ -31,7 +31,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState is an empty genesis state required to trigger valsync genesis logic only.
 type GenesisState struct {
-	ExecutionBlockHash []byte `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty"`
+	ExecutionBlockHash []byte              `protobuf:"bytes,1,opt,name=execution_block_hash,json=executionBlockHash,proto3" json:"execution_block_hash,omitempty"`
+	Withdrawals        []GenesisWithdrawal `protobuf:"bytes,2,rep,name=withdrawals,proto3" json:"withdrawals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +75,66 @@ func (m *GenesisState) GetExecutionBlockHash() []byte {
 	return nil
 }
 
+func (m *GenesisState) GetWithdrawals() []GenesisWithdrawal {
+	if m != nil {
+		return m.Withdrawals
+	}
+	return nil
+}
+
+// GenesisWithdrawal is a pending withdrawal included in exported genesis state.
+type GenesisWithdrawal struct {
+	Address    []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AmountGwei uint64 `protobuf:"varint,2,opt,name=amount_gwei,json=amountGwei,proto3" json:"amount_gwei,omitempty"`
+}
+
+func (m *GenesisWithdrawal) Reset()         { *m = GenesisWithdrawal{} }
+func (m *GenesisWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*GenesisWithdrawal) ProtoMessage()    {}
+func (*GenesisWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_288b272163299061, []int{1}
+}
+func (m *GenesisWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisWithdrawal.Merge(m, src)
+}
+func (m *GenesisWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisWithdrawal proto.InternalMessageInfo
+
+func (m *GenesisWithdrawal) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *GenesisWithdrawal) GetAmountGwei() uint64 {
+	if m != nil {
+		return m.AmountGwei
+	}
+	return 0
+}
+
 // MsgExecutionPayload defines the next EVM execution payload and the
 // logs from previous execution payload.
 type MsgExecutionPayload struct {
@@ -89,7 +150,7 @@ func (m *MsgExecutionPayload) Reset()         { *m = MsgExecutionPayload{} }
 func (m *MsgExecutionPayload) String() string { return proto.CompactTextString(m) }
 func (*MsgExecutionPayload) ProtoMessage()    {}
 func (*MsgExecutionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_288b272163299061, []int{1}
+	return fileDescriptor_288b272163299061, []int{2}
 }
 func (m *MsgExecutionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,7 +221,7 @@ func (m *ExecutionPayloadResponse) Reset()         { *m = ExecutionPayloadRespon
 func (m *ExecutionPayloadResponse) String() string { return proto.CompactTextString(m) }
 func (*ExecutionPayloadResponse) ProtoMessage()    {}
 func (*ExecutionPayloadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_288b272163299061, []int{2}
+	return fileDescriptor_288b272163299061, []int{3}
 }
 func (m *ExecutionPayloadResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +262,7 @@ func (m *EVMEvent) Reset()         { *m = EVMEvent{} }
 func (m *EVMEvent) String() string { return proto.CompactTextString(m) }
 func (*EVMEvent) ProtoMessage()    {}
 func (*EVMEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_288b272163299061, []int{3}
+	return fileDescriptor_288b272163299061, []int{4}
 }
 func (m *EVMEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +338,7 @@ func (m *ExecutionPayloadDeneb) Reset()         { *m = ExecutionPayloadDeneb{} }
 func (m *ExecutionPayloadDeneb) String() string { return proto.CompactTextString(m) }
 func (*ExecutionPayloadDeneb) ProtoMessage()    {}
 func (*ExecutionPayloadDeneb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_288b272163299061, []int{4}
+	return fileDescriptor_288b272163299061, []int{5}
 }
 func (m *ExecutionPayloadDeneb) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -398,7 +459,7 @@ func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
 func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
 func (*Withdrawal) ProtoMessage()    {}
 func (*Withdrawal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_288b272163299061, []int{5}
+	return fileDescriptor_288b272163299061, []int{6}
 }
 func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,6 +504,7 @@ func (m *Withdrawal) GetAmountGwei() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "octane.evmengine.types.GenesisState")
+	proto.RegisterType((*GenesisWithdrawal)(nil), "octane.evmengine.types.GenesisWithdrawal")
 	proto.RegisterType((*MsgExecutionPayload)(nil), "octane.evmengine.types.MsgExecutionPayload")
 	proto.RegisterType((*ExecutionPayloadResponse)(nil), "octane.evmengine.types.ExecutionPayloadResponse")
 	proto.RegisterType((*EVMEvent)(nil), "octane.evmengine.types.EVMEvent")
@@ -453,60 +515,62 @@ func init() {
 func init() { proto.RegisterFile("octane/evmengine/types/tx.proto", fileDescriptor_288b272163299061) }
 
 var fileDescriptor_288b272163299061 = []byte{
-	// 842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0xce, 0x1f, 0x3f, 0xaf, 0x1b, 0x67, 0x9a, 0xa6, 0x8b, 0x01, 0xc7, 0xec, 0x01,
-	0xdc, 0x46, 0xb5, 0xd3, 0xc0, 0x89, 0x13, 0x98, 0x86, 0x20, 0x44, 0x50, 0xb4, 0x15, 0x45, 0xe2,
-	0xb2, 0x9a, 0xdd, 0x7d, 0x5d, 0x8f, 0xf0, 0xee, 0x2c, 0xf3, 0xc6, 0x8e, 0x73, 0x43, 0x1c, 0x38,
-	0xf3, 0x51, 0xfa, 0x31, 0x7a, 0xec, 0x81, 0x03, 0xea, 0xa1, 0x42, 0xc9, 0xa1, 0x5f, 0x03, 0xcd,
-	0xac, 0xff, 0x84, 0xd4, 0x91, 0x7a, 0xda, 0x99, 0xdf, 0xfb, 0xbd, 0x99, 0xf7, 0xef, 0x37, 0x0b,
-	0xfb, 0x32, 0xd6, 0x3c, 0xc7, 0x1e, 0x8e, 0x33, 0xcc, 0x53, 0x91, 0x63, 0x4f, 0x5f, 0x14, 0x48,
-	0x3d, 0x3d, 0xe9, 0x16, 0x4a, 0x6a, 0xc9, 0xf6, 0x4a, 0x42, 0x77, 0x4e, 0xe8, 0x5a, 0x42, 0x73,
-	0x37, 0x95, 0xa9, 0xb4, 0x94, 0x9e, 0x59, 0x95, 0xec, 0xe6, 0xfd, 0x58, 0x52, 0x26, 0xa9, 0x97,
-	0x51, 0xda, 0x1b, 0x3f, 0x36, 0x9f, 0xd2, 0xe0, 0x7f, 0x05, 0xee, 0x09, 0xe6, 0x48, 0x82, 0x9e,
-	0x6a, 0xae, 0x91, 0x1d, 0xc2, 0x2e, 0x4e, 0x30, 0x1e, 0x69, 0x21, 0xf3, 0x30, 0x1a, 0xca, 0xf8,
-	0xd7, 0x70, 0xc0, 0x69, 0xe0, 0x39, 0x6d, 0xa7, 0xe3, 0x06, 0x6c, 0x6e, 0xeb, 0x1b, 0xd3, 0x77,
-	0x9c, 0x06, 0xfe, 0xeb, 0x55, 0xb8, 0x7b, 0x4a, 0xe9, 0xf1, 0xcc, 0x72, 0xc6, 0x2f, 0x86, 0x92,
-	0x27, 0xec, 0x23, 0xa8, 0xf2, 0x91, 0x1e, 0x48, 0x25, 0xf4, 0x85, 0x75, 0xaf, 0x06, 0x0b, 0x80,
-	0x1d, 0xc0, 0xce, 0xe2, 0x9e, 0xa2, 0x74, 0xf1, 0x56, 0xed, 0x25, 0x0d, 0xbc, 0x79, 0xd4, 0x33,
-	0xb8, 0x5b, 0x28, 0x1c, 0xcf, 0x78, 0x21, 0x8e, 0x31, 0xd7, 0xe4, 0xad, 0xb5, 0xd7, 0x3a, 0xb5,
-	0xa3, 0x76, 0x77, 0x79, 0x25, 0xba, 0xc7, 0xcf, 0x4e, 0x8f, 0x0d, 0xb1, 0x5f, 0x79, 0xf9, 0x66,
-	0x7f, 0x25, 0xd8, 0x31, 0x47, 0x4c, 0x4f, 0xb4, 0x38, 0xb1, 0x07, 0xd0, 0x88, 0x86, 0x32, 0x0a,
-	0x63, 0x99, 0x65, 0x42, 0x67, 0xf6, 0xd0, 0x4a, 0x7b, 0xad, 0xe3, 0x06, 0xdb, 0x06, 0xff, 0x66,
-	0x01, 0x33, 0x84, 0xfb, 0xef, 0xc4, 0x1b, 0x26, 0x98, 0x63, 0xe4, 0xad, 0xb7, 0x9d, 0x4e, 0xed,
-	0xe8, 0xd1, 0xad, 0x61, 0xdc, 0xc8, 0xe6, 0x89, 0x71, 0x0a, 0xee, 0xe1, 0x32, 0xf8, 0xcb, 0x3b,
-	0x7f, 0xbc, 0x7d, 0xf1, 0x70, 0x51, 0x26, 0xbf, 0x09, 0xde, 0x4d, 0xff, 0x00, 0xa9, 0x90, 0x39,
-	0xa1, 0x7f, 0x06, 0x5b, 0xb3, 0x14, 0x99, 0x07, 0x9b, 0x3c, 0x49, 0x14, 0x12, 0x4d, 0x3b, 0x35,
-	0xdb, 0xb2, 0x3d, 0xd8, 0xd0, 0xb2, 0x10, 0x31, 0x79, 0xab, 0x36, 0xb3, 0xe9, 0x8e, 0x31, 0xa8,
-	0x24, 0x5c, 0x73, 0x6f, 0xcd, 0xd2, 0xed, 0xda, 0xff, 0x7b, 0x1d, 0xee, 0x2d, 0x0d, 0x97, 0x3d,
-	0x82, 0x5a, 0xc1, 0x15, 0xe6, 0xfa, 0xda, 0x34, 0xf4, 0x5d, 0x53, 0xd7, 0xd7, 0x6f, 0xf6, 0x2b,
-	0x66, 0x0e, 0x02, 0x28, 0x09, 0x66, 0xcd, 0xbe, 0x80, 0xfa, 0x73, 0xc4, 0x50, 0x61, 0x2c, 0x0a,
-	0x81, 0xb9, 0x2e, 0x3b, 0xdb, 0xdf, 0x9e, 0x3a, 0x6c, 0x7e, 0x5d, 0x06, 0x17, 0xb8, 0xcf, 0x11,
-	0x83, 0x19, 0x89, 0x1d, 0x00, 0x90, 0x19, 0xc2, 0x50, 0x49, 0xa9, 0xcb, 0xc0, 0x6e, 0xdc, 0x51,
-	0xb5, 0xf6, 0x40, 0x4a, 0xcd, 0x1e, 0x43, 0x5d, 0x61, 0x8c, 0xa2, 0xd0, 0x54, 0xf2, 0x2b, 0x4b,
-	0xf8, 0xee, 0x8c, 0x62, 0x5d, 0x3e, 0x06, 0x18, 0xca, 0x94, 0xcc, 0x58, 0xcb, 0xcc, 0xb6, 0xcd,
-	0x0d, 0xaa, 0x06, 0xe9, 0x1b, 0xc0, 0xe6, 0x68, 0xa6, 0x4c, 0xf1, 0x3c, 0xe1, 0xd2, 0xdb, 0x58,
-	0x9a, 0xa3, 0xc2, 0x71, 0x60, 0xed, 0xec, 0x13, 0x70, 0x4b, 0x7d, 0xe4, 0xa3, 0x2c, 0x42, 0xe5,
-	0x6d, 0xb6, 0x9d, 0x4e, 0x25, 0xa8, 0x59, 0xec, 0x47, 0x0b, 0xb1, 0x0f, 0xa1, 0x9a, 0x72, 0x0a,
-	0x87, 0x22, 0x13, 0xda, 0xdb, 0xb2, 0xf6, 0xad, 0x94, 0xd3, 0x0f, 0x66, 0xcf, 0x3e, 0x00, 0xb3,
-	0x0e, 0x47, 0x84, 0x89, 0x57, 0xb5, 0xb6, 0xcd, 0x94, 0xd3, 0x4f, 0x84, 0x56, 0x3a, 0x5a, 0x64,
-	0x48, 0x9a, 0x67, 0x85, 0x07, 0xd6, 0xb6, 0x00, 0x4c, 0x1a, 0x38, 0xd1, 0x8a, 0x87, 0xb6, 0x7f,
-	0xb5, 0x32, 0x0d, 0x8b, 0x3c, 0xe1, 0x9a, 0xb3, 0xcf, 0xa0, 0x11, 0x71, 0xc2, 0xd0, 0x34, 0xa0,
-	0x40, 0x15, 0xa6, 0x9c, 0x3c, 0xd7, 0x92, 0xea, 0x06, 0xff, 0x16, 0xf1, 0x0c, 0xd5, 0x09, 0x27,
-	0x53, 0xee, 0x6b, 0x02, 0xaf, 0x2f, 0x2b, 0x77, 0x34, 0x53, 0x39, 0xf3, 0xc1, 0xd5, 0x8a, 0xe7,
-	0xc4, 0x63, 0x33, 0x1b, 0xe4, 0xdd, 0xb1, 0xc3, 0xf4, 0x3f, 0x8c, 0x7d, 0x0f, 0xb5, 0x73, 0xa1,
-	0x07, 0x89, 0xe2, 0xe7, 0x7c, 0x48, 0xde, 0xb6, 0x95, 0xa7, 0x7f, 0x9b, 0x2e, 0x7e, 0x9e, 0x53,
-	0xa7, 0x02, 0xbd, 0xee, 0xcc, 0x7c, 0xa8, 0x5b, 0x69, 0xce, 0x4b, 0xd4, 0x98, 0x97, 0x37, 0x3a,
-	0x99, 0x96, 0xe9, 0x53, 0xd8, 0xc6, 0x49, 0x8c, 0x64, 0x3b, 0x6a, 0xa9, 0xde, 0x8e, 0x65, 0xd5,
-	0x4b, 0xb8, 0x5f, 0x72, 0xfd, 0x1c, 0x60, 0x71, 0x19, 0xdb, 0x85, 0x75, 0x91, 0x27, 0x38, 0xb1,
-	0x43, 0x5c, 0x09, 0xca, 0x0d, 0x7b, 0xb0, 0x10, 0xd0, 0x2d, 0xb3, 0x3a, 0x57, 0xd4, 0x3e, 0xd4,
-	0x78, 0x26, 0x47, 0xb9, 0x0e, 0xd3, 0x73, 0x14, 0x76, 0x4e, 0x2b, 0x01, 0x94, 0xd0, 0xc9, 0x39,
-	0x8a, 0xa3, 0x3f, 0x1d, 0x80, 0x53, 0x4a, 0x9f, 0xa2, 0x1a, 0x8b, 0x18, 0xd9, 0x6f, 0xd0, 0x78,
-	0xe7, 0x71, 0x3c, 0xb8, 0xad, 0x2a, 0x4b, 0x5e, 0xd2, 0xe6, 0xe1, 0xfb, 0x3e, 0x2d, 0xb3, 0xa7,
-	0xa1, 0xb9, 0xfe, 0xfb, 0xdb, 0x17, 0x0f, 0x9d, 0xfe, 0xe1, 0xcb, 0xcb, 0x96, 0xf3, 0xea, 0xb2,
-	0xe5, 0xfc, 0x7b, 0xd9, 0x72, 0xfe, 0xba, 0x6a, 0xad, 0xbc, 0xba, 0x6a, 0xad, 0xfc, 0x73, 0xd5,
-	0x5a, 0xf9, 0x65, 0x6f, 0xf9, 0xef, 0x25, 0xda, 0xb0, 0x7f, 0x85, 0xcf, 0xff, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0x05, 0xea, 0x37, 0x67, 0x7f, 0x06, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x72, 0x1b, 0x45,
+	0x10, 0xf6, 0x5a, 0xf2, 0x8f, 0x5a, 0xab, 0x58, 0x9e, 0x38, 0xce, 0x20, 0x40, 0x16, 0x7b, 0x00,
+	0x39, 0xae, 0x48, 0x8e, 0xe1, 0xc4, 0x0d, 0x11, 0x63, 0x8a, 0xc2, 0x29, 0xb3, 0x29, 0x42, 0x15,
+	0x97, 0xad, 0xd1, 0x6e, 0x67, 0x35, 0x85, 0x76, 0x67, 0x99, 0x19, 0xc9, 0xf2, 0x8d, 0xe2, 0xc0,
+	0x99, 0x2a, 0x5e, 0x24, 0x8f, 0x91, 0x63, 0x0e, 0x1c, 0xa8, 0x1c, 0x52, 0x94, 0x7d, 0xc8, 0x6b,
+	0x50, 0x33, 0xab, 0x1f, 0xc7, 0x5e, 0xa5, 0x38, 0x69, 0xe6, 0xeb, 0xaf, 0x5b, 0xdd, 0xdf, 0x74,
+	0xf7, 0xc2, 0x9e, 0x08, 0x35, 0x4b, 0xb1, 0x8b, 0xe3, 0x04, 0xd3, 0x98, 0xa7, 0xd8, 0xd5, 0x17,
+	0x19, 0xaa, 0xae, 0x9e, 0x74, 0x32, 0x29, 0xb4, 0x20, 0xbb, 0x39, 0xa1, 0x33, 0x27, 0x74, 0x2c,
+	0xa1, 0xb1, 0x13, 0x8b, 0x58, 0x58, 0x4a, 0xd7, 0x9c, 0x72, 0x76, 0xe3, 0x7e, 0x28, 0x54, 0x22,
+	0x54, 0x37, 0x51, 0x71, 0x77, 0xfc, 0xc8, 0xfc, 0xe4, 0x06, 0xef, 0x2f, 0x07, 0xdc, 0x13, 0x4c,
+	0x51, 0x71, 0xf5, 0x54, 0x33, 0x8d, 0xe4, 0x10, 0x76, 0x70, 0x82, 0xe1, 0x48, 0x73, 0x91, 0x06,
+	0xfd, 0xa1, 0x08, 0x7f, 0x09, 0x06, 0x4c, 0x0d, 0xa8, 0xd3, 0x72, 0xda, 0xae, 0x4f, 0xe6, 0xb6,
+	0x9e, 0x31, 0x7d, 0xcb, 0xd4, 0x80, 0xfc, 0x00, 0xd5, 0x73, 0xae, 0x07, 0x91, 0x64, 0xe7, 0x6c,
+	0xa8, 0xe8, 0x6a, 0xab, 0xd4, 0xae, 0x1e, 0xed, 0x77, 0x8a, 0xf3, 0xeb, 0x4c, 0xff, 0xec, 0xa7,
+	0xb9, 0x47, 0xaf, 0xfc, 0xf2, 0xcd, 0xde, 0x8a, 0x7f, 0x3d, 0x86, 0xf7, 0x04, 0xb6, 0x6f, 0xf1,
+	0x08, 0x85, 0x0d, 0x16, 0x45, 0x12, 0x95, 0x9a, 0x26, 0x33, 0xbb, 0x92, 0x3d, 0xa8, 0xb2, 0x44,
+	0x8c, 0x52, 0x1d, 0xc4, 0xe7, 0xc8, 0xe9, 0x6a, 0xcb, 0x69, 0x97, 0x7d, 0xc8, 0xa1, 0x93, 0x73,
+	0xe4, 0xde, 0xeb, 0x55, 0xb8, 0x7b, 0xaa, 0xe2, 0xe3, 0x59, 0xf2, 0x67, 0xec, 0x62, 0x28, 0x58,
+	0x44, 0x3e, 0x82, 0x0a, 0x1b, 0xe9, 0x81, 0x90, 0x5c, 0x5f, 0xd8, 0xa0, 0x15, 0x7f, 0x01, 0x90,
+	0x03, 0xd8, 0x5e, 0x48, 0x91, 0xe5, 0x2e, 0x36, 0xb8, 0xeb, 0xd7, 0xf1, 0x66, 0xa8, 0x67, 0x70,
+	0x37, 0x93, 0x38, 0x9e, 0xf1, 0x02, 0x1c, 0x63, 0xaa, 0x15, 0x2d, 0x59, 0x35, 0x5a, 0xcb, 0xd4,
+	0x38, 0x7e, 0x76, 0x7a, 0x6c, 0x88, 0x53, 0x11, 0xb6, 0x4d, 0x88, 0x69, 0x44, 0x8b, 0x2b, 0xb2,
+	0x0f, 0xf5, 0xfe, 0x50, 0xf4, 0x83, 0x50, 0x24, 0x09, 0xd7, 0x89, 0x0d, 0x5a, 0x6e, 0x95, 0xda,
+	0xae, 0xbf, 0x65, 0xf0, 0xaf, 0x17, 0x30, 0x41, 0xb8, 0x7f, 0x2b, 0xdf, 0x20, 0xc2, 0x14, 0xfb,
+	0x74, 0xad, 0xe5, 0xb4, 0xab, 0x47, 0x0f, 0x97, 0xa6, 0x71, 0xa3, 0x9a, 0xc7, 0xc6, 0xc9, 0xbf,
+	0x87, 0x45, 0xf0, 0x97, 0x77, 0x7e, 0x7f, 0xfb, 0xe2, 0xc1, 0x42, 0x26, 0xaf, 0x01, 0xf4, 0xa6,
+	0xbf, 0x8f, 0x2a, 0x13, 0xa9, 0x42, 0xef, 0x0c, 0x36, 0x67, 0x25, 0xbe, 0xe7, 0xfd, 0x76, 0x61,
+	0x5d, 0x8b, 0x8c, 0x87, 0x79, 0xf3, 0xb8, 0xfe, 0xf4, 0x46, 0x08, 0x94, 0x23, 0xa6, 0x19, 0x2d,
+	0x59, 0xba, 0x3d, 0x7b, 0x7f, 0xaf, 0xc1, 0xbd, 0xc2, 0x74, 0xc9, 0x43, 0xa8, 0x66, 0x4c, 0x62,
+	0xaa, 0xaf, 0x35, 0x6c, 0xcf, 0x35, 0xba, 0xbe, 0x7e, 0xb3, 0x57, 0x36, 0xad, 0xea, 0x43, 0x4e,
+	0x30, 0x67, 0xf2, 0x05, 0xd4, 0x9e, 0x23, 0x06, 0x12, 0x43, 0x9e, 0x71, 0x4c, 0x75, 0xfe, 0xb2,
+	0xbd, 0xad, 0xa9, 0xc3, 0xc6, 0x57, 0x79, 0x72, 0xbe, 0xfb, 0x1c, 0xd1, 0x9f, 0x91, 0xc8, 0x01,
+	0x80, 0x32, 0x73, 0x12, 0x48, 0x21, 0x34, 0x2d, 0x15, 0xfc, 0x47, 0xc5, 0xda, 0x7d, 0x21, 0x34,
+	0x79, 0x04, 0x35, 0x89, 0x21, 0xf2, 0x4c, 0xab, 0x9c, 0x5f, 0x2e, 0xe0, 0xbb, 0x33, 0x8a, 0x75,
+	0xf9, 0x18, 0x60, 0x28, 0x62, 0x65, 0x26, 0x4f, 0x24, 0xf6, 0xd9, 0x5c, 0xbf, 0x62, 0x90, 0x9e,
+	0x01, 0x6c, 0x8d, 0xa6, 0xcb, 0x24, 0x4b, 0x23, 0x26, 0xe8, 0x7a, 0x61, 0x8d, 0x12, 0xc7, 0xbe,
+	0xb5, 0x93, 0x4f, 0xc0, 0xcd, 0x47, 0x38, 0x1d, 0x25, 0x7d, 0x94, 0x74, 0xc3, 0x4e, 0x46, 0xd5,
+	0x62, 0x4f, 0x2c, 0x44, 0x3e, 0x84, 0x4a, 0xcc, 0x54, 0x30, 0xe4, 0x09, 0xd7, 0x74, 0xd3, 0xda,
+	0x37, 0x63, 0xa6, 0xbe, 0x37, 0x77, 0xf2, 0x01, 0x98, 0x73, 0x30, 0x52, 0x18, 0xd1, 0x8a, 0xb5,
+	0x6d, 0xc4, 0x4c, 0xfd, 0xa8, 0xd0, 0x8e, 0x8e, 0xe6, 0x09, 0x2a, 0xcd, 0x92, 0x8c, 0x82, 0xb5,
+	0x2d, 0x00, 0x53, 0x06, 0x4e, 0xb4, 0x64, 0x81, 0x7d, 0xbf, 0x6a, 0x5e, 0x86, 0x45, 0x1e, 0x33,
+	0xcd, 0xc8, 0x67, 0x50, 0xef, 0x33, 0x85, 0x81, 0x79, 0x80, 0x0c, 0x65, 0x10, 0x33, 0x45, 0x5d,
+	0x4b, 0xaa, 0x19, 0xfc, 0x1b, 0xc4, 0x33, 0x94, 0x27, 0x4c, 0x19, 0xb9, 0xaf, 0xed, 0xa0, 0x5a,
+	0x91, 0xdc, 0xfd, 0xf9, 0x22, 0xf2, 0xc0, 0xd5, 0x92, 0xa5, 0x8a, 0x85, 0xa6, 0x37, 0x14, 0xbd,
+	0x63, 0x9b, 0xe9, 0x1d, 0x8c, 0x7c, 0xf7, 0xee, 0xb2, 0xda, 0xb2, 0xe3, 0xe9, 0x2d, 0x9b, 0x8b,
+	0xf7, 0x6e, 0x29, 0xe2, 0x41, 0xcd, 0x8e, 0xe6, 0x5c, 0xa2, 0xfa, 0x5c, 0xde, 0xfe, 0xc9, 0x54,
+	0xa6, 0x4f, 0x61, 0x0b, 0x27, 0x21, 0x2a, 0x15, 0xcc, 0xa8, 0x74, 0xdb, 0xb2, 0x6a, 0x39, 0xdc,
+	0xcb, 0xb9, 0x5e, 0x0a, 0x70, 0x6d, 0xd5, 0xed, 0xc0, 0x1a, 0x4f, 0x23, 0x9c, 0xd8, 0x26, 0x2e,
+	0xfb, 0xf9, 0x85, 0xec, 0x2f, 0x06, 0x68, 0x49, 0xaf, 0x2e, 0xdb, 0x88, 0xa5, 0x9b, 0x1b, 0xf1,
+	0xe8, 0x0f, 0x07, 0xe0, 0x54, 0xc5, 0x4f, 0x51, 0x8e, 0x79, 0x88, 0xe4, 0x57, 0xa8, 0xdf, 0x5a,
+	0x8e, 0x07, 0xcb, 0x54, 0x29, 0xd8, 0xa4, 0x8d, 0xc3, 0xff, 0xbb, 0x5a, 0x66, 0xab, 0xa1, 0xb1,
+	0xf6, 0xdb, 0xdb, 0x17, 0x0f, 0x9c, 0xde, 0xe1, 0xcb, 0xcb, 0xa6, 0xf3, 0xea, 0xb2, 0xe9, 0xfc,
+	0x7b, 0xd9, 0x74, 0xfe, 0xbc, 0x6a, 0xae, 0xbc, 0xba, 0x6a, 0xae, 0xfc, 0x73, 0xd5, 0x5c, 0xf9,
+	0x79, 0xb7, 0xf8, 0x13, 0xd8, 0x5f, 0xb7, 0x5f, 0xae, 0xcf, 0xff, 0x1b, 0x00, 0x06, 0x69, 0x60,
+	0xad, 0x23, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -612,6 +676,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ExecutionBlockHash) > 0 {
 		i -= len(m.ExecutionBlockHash)
 		copy(dAtA[i:], m.ExecutionBlockHash)
@@ -622,6 +700,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AmountGwei != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AmountGwei))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExecutionPayload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -988,6 +1101,28 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountGwei != 0 {
+		n += 1 + sovTx(uint64(m.AmountGwei))
+	}
 	return n
 }
 
@@ -1205,6 +1340,143 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				m.ExecutionBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, GenesisWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountGwei", wireType)
+			}
+			m.AmountGwei = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AmountGwei |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,245 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/relayer/app/cursor"
+)
+
+// adminAPI serves the relayer admin HTTP API which provides per-stream status
+// and manual control of destination chain workers.
+type adminAPI struct {
+	network netconf.Network
+	xprov   xchain.Provider
+	cursors *cursor.Store
+	workers map[uint64]*Worker // Workers by destination chain ID
+}
+
+// streamStatus is the status of a stream returned by the admin API.
+type streamStatus struct {
+	Stream                string `json:"stream"`
+	SrcChainID            uint64 `json:"src_chain_id"`
+	DstChainID            uint64 `json:"dst_chain_id"`
+	ShardID               uint64 `json:"shard_id"`
+	EmittedMsgOffset      uint64 `json:"emitted_msg_offset"`
+	SubmittedMsgOffset    uint64 `json:"submitted_msg_offset"`
+	SubmittedAttestOffset uint64 `json:"submitted_attest_offset"`
+	LatestAttestOffset    uint64 `json:"latest_attest_offset"`    // Latest attest offset processed by the worker
+	ConfirmedAttestOffset uint64 `json:"confirmed_attest_offset"` // Latest attest offset fully submitted and finalized
+}
+
+// workerStatus is the status of a destination chain worker returned by the admin API.
+type workerStatus struct {
+	DstChain        string `json:"dst_chain"`
+	DstChainID      uint64 `json:"dst_chain_id"`
+	Paused          bool   `json:"paused"`
+	Running         bool   `json:"running"`
+	BufferWaiting   int64  `json:"buffer_waiting"`
+	MempoolInflight int64  `json:"mempool_inflight"`
+}
+
+func newWorkerStatus(chain netconf.Chain, status WorkerStatus) workerStatus {
+	return workerStatus{
+		DstChain:        chain.Name,
+		DstChainID:      chain.ID,
+		Paused:          status.Paused,
+		Running:         status.Running,
+		BufferWaiting:   status.Waiting,
+		MempoolInflight: status.Inflight,
+	}
+}
+
+// serveAdmin starts a goroutine that serves the admin API. It
+// returns a channel that will receive an error if the server fails to start.
+func serveAdmin(ctx context.Context, address string, api adminAPI) <-chan error {
+	errChan := make(chan error)
+	go func() {
+		log.Info(ctx, "Serving relayer admin API", "address", address)
+
+		srv := &http.Server{
+			Addr:              address,
+			ReadHeaderTimeout: 5 * time.Second,
+			IdleTimeout:       5 * time.Second,
+			WriteTimeout:      30 * time.Second, // Stream status queries all chains
+			Handler:           api.handler(),
+			BaseContext:       func(_ net.Listener) context.Context { return ctx },
+		}
+		errChan <- errors.Wrap(srv.ListenAndServe(), "serve admin")
+	}()
+
+	return errChan
+}
+
+// handler returns the admin API HTTP handler.
+func (a adminAPI) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /streams", a.handleStreams)
+	mux.HandleFunc("GET /workers", a.handleWorkers)
+	mux.HandleFunc("POST /workers/{chain}/pause", a.handleWorker(func(w *Worker, _ *http.Request) error {
+		w.Pause()
+		return nil
+	}))
+	mux.HandleFunc("POST /workers/{chain}/resume", a.handleWorker(func(w *Worker, _ *http.Request) error {
+		w.Resume()
+		return nil
+	}))
+	mux.HandleFunc("POST /workers/{chain}/restart", a.handleWorker(a.restartWorker))
+
+	return mux
+}
+
+// handleStreams returns the status of all streams to all destination chains.
+func (a adminAPI) handleStreams(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var resp []streamStatus
+	for _, dstChain := range a.network.EVMChains() {
+		offsets, err := a.cursors.StreamerOffsets(ctx, dstChain.ID)
+		if err != nil {
+			writeErr(ctx, rw, http.StatusInternalServerError, err)
+			return
+		}
+
+		for _, stream := range a.network.StreamsTo(dstChain.ID) {
+			submitted, _, err := a.xprov.GetSubmittedCursor(ctx, xchain.LatestRef, stream)
+			if err != nil {
+				writeErr(ctx, rw, http.StatusInternalServerError, errors.Wrap(err, "get submitted cursor"))
+				return
+			}
+
+			emitted, _, err := a.xprov.GetEmittedCursor(ctx, xchain.LatestRef, stream)
+			if err != nil {
+				writeErr(ctx, rw, http.StatusInternalServerError, errors.Wrap(err, "get emitted cursor"))
+				return
+			}
+
+			streamerOffsets := offsets[stream.ChainVersion()]
+
+			resp = append(resp, streamStatus{
+				Stream:                a.network.StreamName(stream),
+				SrcChainID:            stream.SourceChainID,
+				DstChainID:            stream.DestChainID,
+				ShardID:               uint64(stream.ShardID),
+				EmittedMsgOffset:      emitted.MsgOffset,
+				SubmittedMsgOffset:    submitted.MsgOffset,
+				SubmittedAttestOffset: submitted.AttestOffset,
+				LatestAttestOffset:    streamerOffsets.Latest,
+				ConfirmedAttestOffset: streamerOffsets.Confirmed,
+			})
+		}
+	}
+
+	writeJSON(ctx, rw, resp)
+}
+
+// handleWorkers returns the status of all destination chain workers.
+func (a adminAPI) handleWorkers(rw http.ResponseWriter, r *http.Request) {
+	var resp []workerStatus
+	for _, chain := range a.network.EVMChains() {
+		worker, ok := a.workers[chain.ID]
+		if !ok {
+			continue
+		}
+
+		resp = append(resp, newWorkerStatus(chain, worker.Status()))
+	}
+
+	writeJSON(r.Context(), rw, resp)
+}
+
+// handleWorker returns a handler that calls fn with the worker identified by the "chain" path value (name or ID).
+func (a adminAPI) handleWorker(fn func(*Worker, *http.Request) error) http.HandlerFunc {
+	return func(rw http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		chain, ok := a.chainByNameOrID(r.PathValue("chain"))
+		if !ok {
+			writeErr(ctx, rw, http.StatusNotFound, errors.New("unknown chain", "chain", r.PathValue("chain")))
+			return
+		}
+
+		worker, ok := a.workers[chain.ID]
+		if !ok {
+			writeErr(ctx, rw, http.StatusNotFound, errors.New("no worker for chain", "chain", chain.Name))
+			return
+		}
+
+		if err := fn(worker, r); err != nil {
+			writeErr(ctx, rw, http.StatusBadRequest, err)
+			return
+		}
+
+		log.Info(ctx, "Admin API worker request", "path", r.URL.Path, "query", r.URL.RawQuery)
+
+		writeJSON(ctx, rw, newWorkerStatus(chain, worker.Status()))
+	}
+}
+
+// restartWorker restarts the worker streaming attestations of the source chain version
+// from the attest offset provided by the "src_chain", "conf_level" and "attest_offset" query parameters.
+func (a adminAPI) restartWorker(w *Worker, r *http.Request) error {
+	query := r.URL.Query()
+
+	srcChain, ok := a.chainByNameOrID(query.Get("src_chain"))
+	if !ok {
+		return errors.New("unknown source chain", "src_chain", query.Get("src_chain"))
+	}
+
+	confLevel, ok := parseConfLevel(query.Get("conf_level"))
+	if !ok {
+		return errors.New("invalid conf level", "conf_level", query.Get("conf_level"))
+	}
+
+	offset, err := strconv.ParseUint(query.Get("attest_offset"), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "parse attest offset")
+	}
+
+	return w.RestartFrom(xchain.ChainVersion{ID: srcChain.ID, ConfLevel: confLevel}, offset)
+}
+
+// chainByNameOrID returns the network chain by name or ID.
+func (a adminAPI) chainByNameOrID(nameOrID string) (netconf.Chain, bool) {
+	if chain, ok := a.network.ChainByName(nameOrID); ok {
+		return chain, true
+	}
+
+	id, err := strconv.ParseUint(nameOrID, 10, 64)
+	if err != nil {
+		return netconf.Chain{}, false
+	}
+
+	return a.network.Chain(id)
+}
+
+// parseConfLevel returns the valid conf level by name, or false.
+func parseConfLevel(name string) (xchain.ConfLevel, bool) {
+	for _, level := range append(xchain.FuzzyConfLevels(), xchain.ConfFinalized) {
+		if level.String() == name {
+			return level, true
+		}
+	}
+
+	return 0, false
+}
+
+func writeJSON(ctx context.Context, rw http.ResponseWriter, resp any) {
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(resp); err != nil {
+		log.Warn(ctx, "Failed writing admin API response", err)
+	}
+}
+
+func writeErr(ctx context.Context, rw http.ResponseWriter, status int, err error) {
+	log.Warn(ctx, "Admin API request failed", err)
+	http.Error(rw, err.Error(), status)
+}
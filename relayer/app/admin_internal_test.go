@@ -0,0 +1,79 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkerControl(t *testing.T) {
+	t.Parallel()
+
+	network := netconf.Network{
+		ID: netconf.Simnet,
+		Chains: []netconf.Chain{
+			{ID: 1, Name: "src", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+			{ID: 2, Name: "dst", Shards: []xchain.ShardID{xchain.ShardFinalized0}},
+		},
+	}
+	worker := NewWorker(network.Chains[1], network, nil, nil, nil, nil, nil, nil, profitGate{}, bufferPolicy{}, relayFilter{}, batchLimits{})
+
+	// Simulate a running worker
+	var canceled bool
+	worker.setRun(func() { canceled = true }, newActiveBuffer("dst", 1, batchLimits{}, nil, bufferPolicy{}, network.StreamName))
+	require.True(t, worker.Status().Running)
+
+	// Pausing stops the current run
+	worker.Pause()
+	require.True(t, canceled)
+	require.True(t, worker.takeRestart())
+	require.True(t, worker.Status().Paused)
+
+	resumed := make(chan bool)
+	go func() {
+		resumed <- worker.awaitResumed(log.WithNoopLogger(context.Background()))
+	}()
+	worker.Resume()
+	require.True(t, <-resumed)
+	require.False(t, worker.Status().Paused)
+
+	// Restart from a valid chain version offset
+	srcFinal := xchain.ChainVersion{ID: 1, ConfLevel: xchain.ConfFinalized}
+	require.NoError(t, worker.RestartFrom(srcFinal, 7))
+	require.Equal(t, map[xchain.ChainVersion]uint64{srcFinal: 7}, worker.takeRestartFroms())
+	require.Empty(t, worker.takeRestartFroms())
+
+	require.ErrorContains(t, worker.RestartFrom(srcFinal, 0), "invalid attest offset")
+	require.ErrorContains(t, worker.RestartFrom(xchain.ChainVersion{ID: 2, ConfLevel: xchain.ConfFinalized}, 1), "unknown chain version")
+
+	// Workers endpoint
+	api := adminAPI{network: network, workers: map[uint64]*Worker{2: worker}}
+	rec := httptest.NewRecorder()
+	api.handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/workers", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var statuses []workerStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &statuses))
+	require.Equal(t, []workerStatus{{DstChain: "dst", DstChainID: 2, Running: true}}, statuses)
+}
+
+func TestParseConfLevel(t *testing.T) {
+	t.Parallel()
+
+	for _, level := range []xchain.ConfLevel{xchain.ConfLatest, xchain.ConfFinalized} {
+		parsed, ok := parseConfLevel(level.String())
+		require.True(t, ok)
+		require.Equal(t, level, parsed)
+	}
+
+	_, ok := parseConfLevel("unknown")
+	require.False(t, ok)
+}
@@ -2,6 +2,7 @@ package relayer
 
 import (
 	"context"
+	"path/filepath"
 
 	"github.com/omni-network/omni/contracts/bindings"
 	haloapp "github.com/omni-network/omni/halo/app"
@@ -12,16 +13,18 @@ import (
 	"github.com/omni-network/omni/lib/chaos"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/evmchain"
 	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/txmgr"
 	"github.com/omni-network/omni/lib/xchain"
 	xprovider "github.com/omni-network/omni/lib/xchain/provider"
 	"github.com/omni-network/omni/relayer/app/cursor"
+	"github.com/omni-network/omni/relayer/app/gasmodel"
 
 	"github.com/cometbft/cometbft/rpc/client/http"
 
 	"github.com/ethereum/go-ethereum/common"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 
 	dbm "github.com/cosmos/cosmos-db"
 )
@@ -45,14 +48,19 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	rpcClientPerChain, err := initializeRPCClients(network.EVMChains(), cfg.RPCEndpoints)
+	rpcClientPerChain, err := initializeRPCClients(network.EVMChains(), cfg.RPCEndpoints, cfg.RPCQuorum)
 	if err != nil {
 		return err
 	}
 
-	privateKey, err := ethcrypto.LoadECDSA(cfg.PrivateKey)
+	senderKeys, err := loadSenderKeys(ctx, cfg)
 	if err != nil {
-		return errors.Wrap(err, "failed to load private key")
+		return err
+	}
+
+	shardMode := ShardMode(cfg.SenderShardMode)
+	if err := shardMode.Verify(); err != nil {
+		return err
 	}
 
 	cprov, err := newCProvider(ctx, cfg)
@@ -60,12 +68,21 @@ func Run(ctx context.Context, cfg Config) error {
 		return err
 	}
 
-	xprov := xprovider.New(network, rpcClientPerChain, cprov)
+	var xprovOpts []xprovider.Option
+	if cfg.XBlockCacheSize > 0 {
+		cache, err := newBlockCache(ctx, cfg)
+		if err != nil {
+			return err
+		}
+		xprovOpts = append(xprovOpts, xprovider.WithBlockCache(cache))
+	}
+
+	xprov := xprovider.New(network, rpcClientPerChain, cprov, xprovOpts...)
 
 	pricer := newTokenPricer(ctx)
-	pnl := newPnlLogger(network.ID, pricer)
+	pnl := newPnlLogger(network.ID, pricer, cfg.DryRun)
 
-	db, err := initializeDB(ctx, cfg)
+	db, err := initializeDB(ctx, cfg, "app")
 	if err != nil {
 		return err
 	}
@@ -75,23 +92,79 @@ func Run(ctx context.Context, cfg Config) error {
 	}
 	cursors.StartLoops(ctx)
 
+	journalDB, err := initializeDB(ctx, cfg, "txmgr")
+	if err != nil {
+		return err
+	}
+	journal, err := txmgr.NewJournal(journalDB)
+	if err != nil {
+		return errors.Wrap(err, "new txmgr journal")
+	}
+
+	profitPolicy := ProfitPolicy(cfg.ProfitPolicy)
+	if err := profitPolicy.Verify(); err != nil {
+		return err
+	}
+
+	gasModelDB, err := initializeDB(ctx, cfg, "gasmodel")
+	if err != nil {
+		return err
+	}
+	gasModel, err := gasmodel.New(gasModelDB)
+	if err != nil {
+		return errors.Wrap(err, "new gas model")
+	}
+
+	bufPolicy, err := newBufferPolicy(network, cfg.StreamPriorities, cfg.StreamWeights)
+	if err != nil {
+		return err
+	}
+
+	filter, err := newRelayFilter(network, cfg.Filter)
+	if err != nil {
+		return err
+	}
+
+	workers := make(map[uint64]*Worker)
 	for _, destChain := range network.EVMChains() {
+		if !filter.AllowDest(destChain.ID) {
+			log.Info(ctx, "Not relaying to filtered destination chain", "dst_chain", destChain.Name)
+			continue
+		}
+
 		// Setup send provider
+		boost := new(gasBoost) // Shared by senders of all runs.
 		sendProvider := func() (SendAsync, error) {
-			sender, err := NewSender(
-				network.ID,
-				destChain,
-				rpcClientPerChain[destChain.ID],
-				*privateKey,
-				network.ChainVersionNames(),
-				pnl.log,
-			)
+			var senders []Sender
+			for _, key := range senderKeys {
+				sender, err := NewSender(
+					ctx,
+					network.ID,
+					destChain,
+					rpcClientPerChain[destChain.ID],
+					key,
+					network.ChainVersionNames(),
+					pnl.log,
+					journal,
+					gasModel,
+					boost,
+					cfg.DryRun,
+					cfg.BatchSubmissions,
+				)
+				if err != nil {
+					return nil, err
+				}
+				senders = append(senders, sender)
+			}
+
+			pool, err := newSenderPool(destChain, shardMode, senders)
 			if err != nil {
 				return nil, err
 			}
 
-			return sender.SendAsync, nil
+			return pool.SendAsync, nil
 		}
+		go monitorBalancesForever(ctx, destChain, rpcClientPerChain[destChain.ID], senderKeyAddresses(senderKeys))
 
 		// Setup validator set awaiter
 		portal, err := bindings.NewOmniPortal(destChain.PortalAddress, rpcClientPerChain[destChain.ID])
@@ -100,6 +173,33 @@ func Run(ctx context.Context, cfg Config) error {
 		}
 		awaitValSet := newValSetAwaiter(portal, destChain.BlockPeriod)
 
+		// Setup profitability gate
+		destMeta, ok := evmchain.MetadataByID(destChain.ID)
+		if !ok {
+			return errors.New("chain metadata not found", "chain_id", destChain.ID)
+		}
+		gate := profitGate{
+			policy:    profitPolicy,
+			minRatio:  cfg.ProfitMinRatio,
+			maxDelay:  cfg.ProfitMaxDelay,
+			batched:   cfg.BatchSubmissions,
+			network:   network.ID,
+			dest:      destMeta,
+			estimator: newGasEstimator(network.ID),
+			gasPrice:  rpcClientPerChain[destChain.ID].SuggestGasPrice,
+			pricer:    pricer,
+		}
+
+		// Batch submissions via Multicall3 if enabled
+		batch := batchLimits{MaxSize: 1}
+		if cfg.BatchSubmissions {
+			batch = batchLimits{
+				MaxSize:   maxBatchSize,
+				DestChain: destChain.ID,
+				Estimator: newGasEstimator(network.ID),
+			}
+		}
+
 		// Start worker
 		worker := NewWorker(
 			destChain,
@@ -110,17 +210,35 @@ func Run(ctx context.Context, cfg Config) error {
 			sendProvider,
 			awaitValSet,
 			cursors,
+			gate,
+			bufPolicy,
+			filter,
+			batch,
 		)
 
+		workers[destChain.ID] = worker
+
 		go worker.Run(ctx)
 	}
 
+	var adminChan <-chan error // Nil channel blocks forever if admin API is disabled
+	if cfg.AdminAddr != "" {
+		adminChan = serveAdmin(ctx, cfg.AdminAddr, adminAPI{
+			network: network,
+			xprov:   xprov,
+			cursors: cursors,
+			workers: workers,
+		})
+	}
+
 	select {
 	case <-ctx.Done():
 		log.Info(ctx, "Shutdown detected, stopping...")
 		return nil
 	case err := <-monitorChan:
 		return err
+	case err := <-adminChan:
+		return err
 	}
 }
 
@@ -148,16 +266,23 @@ func newCProvider(ctx context.Context, cfg Config) (cchain.Provider, error) {
 	return cprovider.NewABCI(c, cfg.Network), nil
 }
 
-func initializeRPCClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints) (map[uint64]ethclient.Client, error) {
+func initializeRPCClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints, quorum int) (map[uint64]ethclient.Client, error) {
 	rpcClientPerChain := make(map[uint64]ethclient.Client)
 	for _, chain := range chains {
-		rpc, err := endpoints.ByNameOrID(chain.Name, chain.ID)
+		rpcs, err := endpoints.AllByNameOrID(chain.Name, chain.ID)
 		if err != nil {
 			return nil, err
 		}
-		c, err := ethclient.Dial(chain.Name, rpc)
+
+		// Quorum reads only apply to chains with multiple endpoints.
+		var opts []ethclient.MultiOption
+		if len(rpcs) > 1 {
+			opts = append(opts, ethclient.WithQuorum(quorum))
+		}
+
+		c, err := ethclient.DialMulti(chain.Name, rpcs, opts...)
 		if err != nil {
-			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID, "rpc_url", rpc)
+			return nil, errors.Wrap(err, "dial rpc", "chain_name", chain.Name, "chain_id", chain.ID)
 		}
 		rpcClientPerChain[chain.ID] = c
 	}
@@ -165,13 +290,19 @@ func initializeRPCClients(chains []netconf.Chain, endpoints xchain.RPCEndpoints)
 	return rpcClientPerChain, nil
 }
 
-func initializeDB(ctx context.Context, cfg Config) (dbm.DB, error) {
+func initializeDB(ctx context.Context, cfg Config, name string) (dbm.DB, error) {
 	if cfg.DBDir == "" {
-		log.Warn(ctx, "No --db-dir provided, using in-memory DB", nil)
+		log.Warn(ctx, "No --db-dir provided, using in-memory DB", nil, "name", name)
 		return dbm.NewMemDB(), nil
 	}
 
-	db, err := dbm.NewGoLevelDB("app", cfg.DBDir, nil)
+	dir := cfg.DBDir
+	if cfg.DryRun {
+		// Dry-run doesn't share state (e.g. cursors) with non-dry-run relayers using the same directory.
+		dir = filepath.Join(dir, "dryrun")
+	}
+
+	db, err := dbm.NewGoLevelDB(name, dir, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "new golevel db")
 	}
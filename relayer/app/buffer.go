@@ -1,88 +1,402 @@
 package relayer
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/omni-network/omni/lib/chaos"
 	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/xchain"
 
 	"golang.org/x/sync/semaphore"
 )
 
+const (
+	// streamQueueLimit is the default maximum number of submissions queued per stream.
+	streamQueueLimit = 4
+
+	// strideScale is the stride of a stream with weight 1. Streams with weight w have stride strideScale/w.
+	strideScale = 1 << 20
+)
+
+// batchLimits limits the submissions the activeBuffer packs into a single transaction.
+type batchLimits struct {
+	MaxSize   int          // Maximum number of submissions per transaction, batching is disabled if one or less.
+	DestChain uint64       // Destination chain ID
+	Estimator gasEstimator // Destination chain gas estimator, batches are limited to subGasMax.
+}
+
+// fits returns true if the submissions fit into a single transaction.
+// Submissions requiring proper (RPC) gas estimation are never batched, since their gas usage is unknown.
+func (l batchLimits) fits(subs []xchain.Submission) bool {
+	if len(subs) <= 1 {
+		return true
+	} else if len(subs) > l.MaxSize || l.Estimator == nil {
+		return false
+	}
+
+	gas := batchGas(l.Estimator, l.DestChain, subs)
+
+	return gas != properGasEstimation && gas <= subGasMax
+}
+
+// bufferPolicy defines how the activeBuffer schedules streams.
+// Streams with higher priority are always scheduled first, while streams
+// of equal priority are scheduled fairly in proportion to their weights.
+type bufferPolicy struct {
+	priorities map[uint64]int64 // Priority by source chain ID, defaults to 0.
+	weights    map[uint64]int64 // Weight by source chain ID, defaults to 1.
+}
+
+// newBufferPolicy returns a buffer policy from the priorities and weights by source chain name.
+func newBufferPolicy(network netconf.Network, priorities, weights map[string]int64) (bufferPolicy, error) {
+	resp := bufferPolicy{
+		priorities: make(map[uint64]int64),
+		weights:    make(map[uint64]int64),
+	}
+
+	for name, priority := range priorities {
+		chain, ok := network.ChainByName(name)
+		if !ok {
+			return bufferPolicy{}, errors.New("unknown stream priority chain", "chain", name)
+		}
+		resp.priorities[chain.ID] = priority
+	}
+
+	for name, weight := range weights {
+		chain, ok := network.ChainByName(name)
+		if !ok {
+			return bufferPolicy{}, errors.New("unknown stream weight chain", "chain", name)
+		} else if weight <= 0 || weight > strideScale {
+			return bufferPolicy{}, errors.New("invalid stream weight", "chain", name, "weight", weight)
+		}
+		resp.weights[chain.ID] = weight
+	}
+
+	return resp, nil
+}
+
+func (p bufferPolicy) priority(stream xchain.StreamID) int64 {
+	return p.priorities[stream.SourceChainID]
+}
+
+func (p bufferPolicy) stride(stream xchain.StreamID) uint64 {
+	weight, ok := p.weights[stream.SourceChainID]
+	if !ok {
+		weight = 1
+	}
+
+	return strideScale / uint64(weight)
+}
+
 // activeBuffer links the output of each worker's cprovider/creators (one per chain version)
 // to the destination chain async sender. Fan-in buffer.
 //
 // It limits the number of concurrent transactions it forwards to the async sender
 // to limit the mempool size.
 //
-// While the mempool limit is reached, calls to AddInput block.
+// Submissions are queued per stream, so a busy stream doesn't starve others.
+// While a stream's queue is full, calls to AddInput for that stream block.
+// Queues are scheduled by the bufferPolicy using strict priority and then
+// weighted fair (stride) scheduling within the same priority.
+//
+// If batching is enabled, submissions waiting in the buffer are packed
+// into a single transaction within the batch limits.
 //
 // If stops processing on any error.
 type activeBuffer struct {
 	chainName    string
-	buffer       chan xchain.Submission
 	mempoolLimit int64
+	batch        batchLimits
+	streamLimit  int // Maximum number of submissions queued per stream
 	errChan      chan error
 	sendAsync    SendAsync
+	policy       bufferPolicy
+	streamName   func(xchain.StreamID) string
+	notify       chan struct{} // Signals submissions were queued
+	now          func() time.Time
+
+	mu     sync.Mutex
+	queues map[xchain.StreamID]*streamQueue
+	vtime  uint64 // Virtual time; the pass of the latest scheduled queue
+	queued int    // Total number of queued submissions
+
+	waiting  atomic.Int64 // Number of submissions queued or blocked waiting to be queued
+	inflight atomic.Int64 // Number of transactions in the mempool
+}
+
+// streamQueue is a FIFO queue of submissions of a single stream.
+type streamQueue struct {
+	stream   xchain.StreamID
+	name     string
+	priority int64
+	stride   uint64        // Pass increment per scheduled submission, inversely proportional to weight
+	pass     uint64        // Virtual time the queue is next scheduled at
+	slots    chan struct{} // Semaphore limiting queued submissions
+	subs     []queuedSub
 }
 
-func newActiveBuffer(chainName string, mempoolLimit int64, sendAsync SendAsync) *activeBuffer {
+type queuedSub struct {
+	Sub   xchain.Submission
+	Since time.Time
+}
+
+func newActiveBuffer(
+	chainName string,
+	mempoolLimit int64,
+	batch batchLimits,
+	sendAsync SendAsync,
+	policy bufferPolicy,
+	streamName func(xchain.StreamID) string,
+) *activeBuffer {
 	return &activeBuffer{
 		chainName:    chainName,
-		buffer:       make(chan xchain.Submission),
 		mempoolLimit: mempoolLimit,
+		batch:        batch,
+		streamLimit:  streamQueueLimit,
 		errChan:      make(chan error, 1),
 		sendAsync:    sendAsync,
+		policy:       policy,
+		streamName:   streamName,
+		notify:       make(chan struct{}, 1),
+		now:          time.Now,
+		queues:       make(map[xchain.StreamID]*streamQueue),
 	}
 }
 
-// AddInput adds a new submission to the buffer. It blocks while mempoolLimit is reached.
+// AddInput adds a new submission to its stream's queue. It blocks while the stream's queue is full.
 func (b *activeBuffer) AddInput(ctx context.Context, submission xchain.Submission) error {
+	b.waiting.Add(1)
+
+	q := b.queue(submissionStream(submission))
+
 	select {
 	case <-ctx.Done():
+		b.waiting.Add(-1)
 		b.submitErr(errors.Wrap(ctx.Err(), "context canceled"))
-	case b.buffer <- submission: // Unbuffered, will block until a reader is ready. We don't want to restart the worker.
+
+		return nil
+	case q.slots <- struct{}{}: // Blocks while the stream's queue is full. We don't want to restart the worker.
 	}
 
-	bufferLen.WithLabelValues(b.chainName).Set(float64(len(b.buffer)))
+	b.mu.Lock()
+	if len(q.subs) == 0 {
+		// Idle queues don't accumulate credit, they resume from the current virtual time.
+		q.pass = max(q.pass, b.vtime)
+	}
+	q.subs = append(q.subs, queuedSub{Sub: submission, Since: b.now()})
+	b.queued++
+	bufferLen.WithLabelValues(b.chainName).Set(float64(b.queued))
+	streamQueueLen.WithLabelValues(q.name).Set(float64(len(q.subs)))
+	b.mu.Unlock()
+
+	select {
+	case b.notify <- struct{}{}:
+	default:
+	}
 
 	return nil
 }
 
+// queue returns the stream's queue, creating it if it doesn't exist.
+func (b *activeBuffer) queue(stream xchain.StreamID) *streamQueue {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if q, ok := b.queues[stream]; ok {
+		return q
+	}
+
+	q := &streamQueue{
+		stream:   stream,
+		name:     b.streamName(stream),
+		priority: b.policy.priority(stream),
+		stride:   b.policy.stride(stream),
+		slots:    make(chan struct{}, max(b.streamLimit, 1)),
+	}
+	b.queues[stream] = q
+
+	return q
+}
+
 // Run processes the buffer, sending submissions to the async sender.
 func (b *activeBuffer) Run(ctx context.Context) error {
 	sema := semaphore.NewWeighted(b.mempoolLimit)
+	for {
+		// Acquire the semaphore before scheduling, since submissions queue up while waiting.
+		if err := b.acquire(ctx, sema); err != nil {
+			return err
+		}
+
+		batch, err := b.awaitBatch(ctx)
+		if err != nil {
+			return err
+		}
+
+		mempoolLen.WithLabelValues(b.chainName).Inc()
+		b.inflight.Add(1)
+		batchSize.WithLabelValues(b.chainName).Observe(float64(len(batch)))
+
+		// Trigger async send synchronously (for ordered nonces), but wait for response async.
+		response := b.sendAsync(ctx, batch...)
+		go func() {
+			err := <-response
+			if err != nil {
+				b.submitErr(errors.Wrap(err, "send submission"))
+			}
+
+			mempoolLen.WithLabelValues(b.chainName).Dec()
+			b.inflight.Add(-1)
+			sema.Release(1)
+		}()
+
+		// Chaos test this worker with random errors.
+		if err := chaos.MaybeError(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// acquire blocks until the semaphore is acquired.
+// It returns early with the first submission error, since the worker must reset.
+func (b *activeBuffer) acquire(ctx context.Context, sema *semaphore.Weighted) error {
+	select {
+	case err := <-b.errChan:
+		return err
+	default:
+	}
+
+	if sema.TryAcquire(1) {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- sema.Acquire(ctx, 1)
+	}()
+
+	select {
+	case err := <-acquired:
+		if err != nil {
+			return errors.Wrap(err, "acquire semaphore")
+		}
+
+		return nil
+	case err := <-b.errChan:
+		cancel()
+		if <-acquired == nil {
+			sema.Release(1) // Acquired concurrently, release it again.
+		}
+
+		return err
+	}
+}
+
+// awaitBatch blocks until submissions are queued and returns the next batch.
+// It returns the first submission error, checked before every batch.
+func (b *activeBuffer) awaitBatch(ctx context.Context) ([]xchain.Submission, error) {
 	for {
+		select {
+		case err := <-b.errChan:
+			return nil, err
+		default:
+		}
+
+		if batch := b.nextBatch(); len(batch) > 0 {
+			return batch, nil
+		}
+
 		select {
 		case <-ctx.Done():
-			return errors.Wrap(ctx.Err(), "context canceled")
+			return nil, errors.Wrap(ctx.Err(), "context canceled")
 		case err := <-b.errChan:
-			return err
-		case submission := <-b.buffer:
-			if err := sema.Acquire(ctx, 1); err != nil {
-				return errors.Wrap(err, "acquire semaphore")
-			}
-			mempoolLen.WithLabelValues(b.chainName).Inc()
-
-			// Trigger async send synchronously (for ordered nonces), but wait for response async.
-			response := b.sendAsync(ctx, submission)
-			go func() {
-				err := <-response
-				if err != nil {
-					b.submitErr(errors.Wrap(err, "send submission"))
-				}
-
-				mempoolLen.WithLabelValues(b.chainName).Dec()
-				sema.Release(1)
-			}()
-
-			// Chaos test this worker with random errors.
-			if err := chaos.MaybeError(ctx); err != nil {
-				return err
-			}
+			return nil, err
+		case <-b.notify:
+		}
+	}
+}
+
+// nextBatch returns the next batch of scheduled submissions, greedily adding
+// queued submissions while within the batch limits.
+// It never blocks, so batching only occurs when submissions are queued, i.e., when the destination is busy.
+func (b *activeBuffer) nextBatch() []xchain.Submission {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var batch []xchain.Submission
+	for {
+		q, ok := b.scheduleUnsafe()
+		if !ok {
+			break
+		} else if !b.batch.fits(append(slices.Clone(batch), q.subs[0].Sub)) {
+			break
+		}
+
+		batch = append(batch, b.popUnsafe(q))
+	}
+
+	return batch
+}
+
+// scheduleUnsafe returns the non-empty queue to schedule next: highest priority,
+// then lowest pass, then lowest stream for determinism. It returns false if all queues are empty.
+// It is unsafe since it assumes the lock is held.
+func (b *activeBuffer) scheduleUnsafe() (*streamQueue, bool) {
+	var next *streamQueue
+	for _, q := range b.queues {
+		if len(q.subs) == 0 {
+			continue
+		} else if next == nil || compareQueues(q, next) < 0 {
+			next = q
 		}
 	}
+
+	return next, next != nil
+}
+
+// popUnsafe pops the first submission of the queue, advancing its pass.
+// It is unsafe since it assumes the lock is held.
+func (b *activeBuffer) popUnsafe(q *streamQueue) xchain.Submission {
+	queued := q.subs[0]
+	q.subs = q.subs[1:]
+	<-q.slots // Unblock AddInput
+
+	b.vtime = q.pass
+	q.pass += q.stride
+	b.queued--
+
+	bufferLen.WithLabelValues(b.chainName).Set(float64(b.queued))
+	streamQueueLen.WithLabelValues(q.name).Set(float64(len(q.subs)))
+	streamQueueDelay.WithLabelValues(q.name).Observe(b.now().Sub(queued.Since).Seconds())
+	b.waiting.Add(-1)
+
+	return queued.Sub
+}
+
+// compareQueues returns a negative number if a should be scheduled before b.
+func compareQueues(a, b *streamQueue) int {
+	if c := cmp.Compare(b.priority, a.priority); c != 0 {
+		return c
+	} else if c := cmp.Compare(a.pass, b.pass); c != 0 {
+		return c
+	} else if c := cmp.Compare(a.stream.SourceChainID, b.stream.SourceChainID); c != 0 {
+		return c
+	}
+
+	return cmp.Compare(a.stream.ShardID, b.stream.ShardID)
+}
+
+// Occupancy returns the number of submissions waiting in the buffer and the number of transactions in the mempool.
+func (b *activeBuffer) Occupancy() (int64, int64) {
+	return b.waiting.Load(), b.inflight.Load()
 }
 
 func (b *activeBuffer) submitErr(err error) {
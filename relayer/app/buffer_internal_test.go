@@ -2,10 +2,13 @@ package relayer
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/xchain"
 
 	fuzz "github.com/google/gofuzz"
@@ -15,17 +18,11 @@ import (
 
 func Test_activeBuffer_AddInput(t *testing.T) {
 	t.Parallel()
-	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	ctx, cancel := context.WithTimeout(log.WithNoopLogger(context.Background()), 50*time.Millisecond)
 	defer cancel()
 	limit := int64(5)
 	sender := &mockBufSender{}
-	buffer := newActiveBuffer("test", limit, sender.Send)
-
-	// Have a reader ready as we are unbuffered and blocking
-	go func() {
-		for range buffer.buffer {
-		}
-	}()
+	buffer := newActiveBuffer("test", limit, batchLimits{}, sender.Send, bufferPolicy{}, testStreamName)
 
 	err := buffer.AddInput(ctx, xchain.Submission{})
 	require.NoError(t, err)
@@ -47,12 +44,14 @@ func newMockSender() *mockBufSender {
 	}
 }
 
-func (m *mockBufSender) Send(_ context.Context, sub xchain.Submission) <-chan error {
+func (m *mockBufSender) Send(_ context.Context, subs ...xchain.Submission) <-chan error {
 	// Simulate async send that returns success when MineNext is called below.
 	resp := make(chan error, 1)
 
 	go func() {
-		m.sendChan <- sub
+		for _, sub := range subs {
+			m.sendChan <- sub
+		}
 		resp <- nil
 	}()
 
@@ -67,7 +66,7 @@ func (m *mockBufSender) Next() xchain.Submission {
 // than the mempoolLimit.
 func Test_activeBuffer_Run(t *testing.T) {
 	t.Parallel()
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(log.WithNoopLogger(context.Background()))
 	defer cancel()
 	//
 	const (
@@ -76,10 +75,17 @@ func Test_activeBuffer_Run(t *testing.T) {
 	)
 
 	sender := newMockSender()
-	buffer := newActiveBuffer("test", memLimit, sender.Send)
+	buffer := newActiveBuffer("test", memLimit, batchLimits{}, sender.Send, bufferPolicy{}, testStreamName)
+	buffer.streamLimit = 1
 
 	var input []xchain.Submission
 	fuzz.New().NilChance(0).NumElements(size, size).Fuzz(&input)
+	for i := range input {
+		// Ensure all submissions are of the same stream.
+		input[i].BlockHeader.ChainID = 0
+		input[i].DestChainID = 0
+		input[i].Msgs = nil
+	}
 
 	go func() {
 		err := buffer.Run(ctx)
@@ -121,3 +127,167 @@ func Test_activeBuffer_Run(t *testing.T) {
 	// Assert equality of input and output submissions
 	require.Len(t, input, len(output))
 }
+
+// Test_activeBuffer_RunError tests that submission errors are returned while blocked on the mempool limit
+// and while submissions are queued.
+func Test_activeBuffer_RunError(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(log.WithNoopLogger(context.Background()))
+	defer cancel()
+
+	// Sender never responds, so the mempool limit of 1 blocks after the first submission.
+	send := func(context.Context, ...xchain.Submission) <-chan error {
+		return make(chan error)
+	}
+
+	for _, blocked := range []bool{true, false} {
+		buffer := newActiveBuffer("test", 1, batchLimits{}, send, bufferPolicy{}, testStreamName)
+		if blocked {
+			require.NoError(t, buffer.AddInput(ctx, xchain.Submission{}))
+		}
+
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- buffer.Run(ctx)
+		}()
+
+		if blocked {
+			// Wait for the first submission to be sent, blocking on the semaphore.
+			require.Eventually(t, func() bool { return buffer.inflight.Load() == 1 }, time.Second, time.Millisecond)
+		}
+
+		expected := errors.New("send failed")
+		buffer.submitErr(expected)
+		select {
+		case err := <-errChan:
+			require.ErrorIs(t, err, expected)
+		case <-time.After(time.Second):
+			require.Fail(t, "expected error", "blocked=%v", blocked)
+		}
+	}
+}
+
+// Test_activeBuffer_Batch tests that queued submissions are packed into batches of up to maxBatch.
+func Test_activeBuffer_Batch(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(log.WithNoopLogger(context.Background()))
+	defer cancel()
+
+	const (
+		maxBatch = 3
+		size     = 7
+	)
+
+	batches := make(chan []xchain.Submission, size)
+	send := func(_ context.Context, subs ...xchain.Submission) <-chan error {
+		batches <- subs
+		resp := make(chan error, 1)
+		resp <- nil
+
+		return resp
+	}
+
+	limits := batchLimits{
+		MaxSize:   maxBatch,
+		Estimator: func(uint64, []xchain.Msg) uint64 { return subGasBase },
+	}
+	buffer := newActiveBuffer("test", 1, limits, send, bufferPolicy{}, testStreamName)
+
+	// Queue all submissions before running, so they are available for batching.
+	buffer.streamLimit = size
+	for i := range size {
+		require.NoError(t, buffer.AddInput(ctx, xchain.Submission{AttHeader: xchain.AttestHeader{AttestOffset: uint64(i)}}))
+	}
+
+	go func() {
+		err := buffer.Run(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	}()
+
+	var offsets []uint64
+	for len(offsets) < size {
+		batch := <-batches
+		require.LessOrEqual(t, len(batch), maxBatch)
+		for _, sub := range batch {
+			offsets = append(offsets, sub.AttHeader.AttestOffset)
+		}
+	}
+
+	// Ordering is preserved.
+	for i, offset := range offsets {
+		require.EqualValues(t, i, offset)
+	}
+}
+
+// Test_activeBuffer_Schedule tests that streams are scheduled by priority and then fairly by weight.
+func Test_activeBuffer_Schedule(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	const (
+		consensus = 1
+		busy      = 2
+		quiet     = 3
+		heavy     = 4
+		size      = 12
+	)
+
+	policy := bufferPolicy{
+		priorities: map[uint64]int64{consensus: 1},
+		weights:    map[uint64]int64{heavy: 2},
+	}
+	buffer := newActiveBuffer("test", 1, batchLimits{}, nil, policy, testStreamName)
+	buffer.streamLimit = size
+
+	add := func(chainID uint64, n int) {
+		for i := range n {
+			sub := xchain.Submission{
+				BlockHeader: xchain.BlockHeader{ChainID: chainID},
+				AttHeader:   xchain.AttestHeader{AttestOffset: uint64(i)},
+			}
+			require.NoError(t, buffer.AddInput(ctx, sub))
+		}
+	}
+
+	next := func() xchain.Submission {
+		batch := buffer.nextBatch()
+		require.Len(t, batch, 1)
+
+		return batch[0]
+	}
+
+	// A burst on the busy stream doesn't starve the quiet stream.
+	add(busy, size)
+	require.EqualValues(t, busy, next().BlockHeader.ChainID)
+	add(quiet, 1)
+	require.EqualValues(t, quiet, next().BlockHeader.ChainID)
+
+	// Higher priority streams are always scheduled first.
+	add(consensus, 2)
+	require.EqualValues(t, consensus, next().BlockHeader.ChainID)
+	require.EqualValues(t, consensus, next().BlockHeader.ChainID)
+
+	// Streams of equal priority are scheduled in proportion to their weight.
+	add(heavy, size)
+	counts := make(map[uint64]int)
+	for range 9 {
+		counts[next().BlockHeader.ChainID]++
+	}
+	require.Equal(t, 3, counts[busy])
+	require.Equal(t, 6, counts[heavy])
+
+	// Ordering is preserved per stream.
+	offsets := make(map[uint64][]uint64)
+	for batch := buffer.nextBatch(); len(batch) > 0; batch = buffer.nextBatch() {
+		sub := batch[0]
+		offsets[sub.BlockHeader.ChainID] = append(offsets[sub.BlockHeader.ChainID], sub.AttHeader.AttestOffset)
+	}
+	for _, stream := range offsets {
+		require.IsIncreasing(t, stream)
+	}
+	require.Len(t, offsets[busy], size-1-3)
+}
+
+func testStreamName(stream xchain.StreamID) string {
+	return fmt.Sprint(stream.SourceChainID)
+}
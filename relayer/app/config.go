@@ -3,6 +3,7 @@ package relayer
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	"github.com/omni-network/omni/lib/buildinfo"
 	"github.com/omni-network/omni/lib/errors"
@@ -16,23 +17,50 @@ import (
 )
 
 type Config struct {
-	RPCEndpoints   xchain.RPCEndpoints
-	PrivateKey     string
-	HaloCometURL   string
-	HaloGRPCURL    string
-	Network        netconf.ID
-	MonitoringAddr string
-	DBDir          string
+	RPCEndpoints     xchain.RPCEndpoints
+	RPCQuorum        int
+	PrivateKey       string
+	ExtraPrivateKeys []string
+	FireAPIKey       string
+	FireKeyPath      string
+	FireAddresses    []string
+	SenderShardMode  string
+	BatchSubmissions bool
+	HaloCometURL     string
+	HaloGRPCURL      string
+	Network          netconf.ID
+	MonitoringAddr   string
+	AdminAddr        string
+	DryRun           bool
+	DBDir            string
+	XBlockCacheSize  uint64
+	ProfitPolicy     string
+	ProfitMinRatio   float64
+	ProfitMaxDelay   time.Duration
+	StreamPriorities map[string]int64
+	StreamWeights    map[string]int64
+	Filter           FilterConfig
 }
 
 func DefaultConfig() Config {
 	return Config{
-		PrivateKey:     "relayer.key",
-		HaloCometURL:   "localhost:26657",
-		HaloGRPCURL:    "",
-		Network:        "",
-		MonitoringAddr: ":26660",
-		DBDir:          "./db",
+		RPCQuorum:       1,
+		PrivateKey:      "relayer.key",
+		SenderShardMode: string(ShardModeStream),
+		HaloCometURL:    "localhost:26657",
+		HaloGRPCURL:     "",
+		Network:         "",
+		MonitoringAddr:  ":26660",
+		DBDir:           "./db",
+		ProfitPolicy:    string(ProfitPolicyAlways),
+		ProfitMinRatio:  100,
+		ProfitMaxDelay:  10 * time.Minute,
+		StreamPriorities: map[string]int64{
+			"omni_consensus": 1, // Consensus chain validator set updates first
+		},
+		Filter: FilterConfig{
+			MaxDeferral: defaultMaxDeferral,
+		},
 	}
 }
 
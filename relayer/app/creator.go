@@ -2,13 +2,10 @@ package relayer
 
 import (
 	"slices"
-	"sort"
 
-	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/attestverify"
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/xchain"
-
-	"github.com/ethereum/go-ethereum/common"
 )
 
 // CreateSubmissions splits the update into multiple submissions that are each small enough (wrt calldata and gas)
@@ -24,7 +21,7 @@ func CreateSubmissions(up StreamUpdate) ([]xchain.Submission, error) {
 	}
 
 	// Select minimum signatures to reach quorum to reduce gas costs
-	sigs, err := quorumSigs(up.ValSet, up.Attestation.Signatures)
+	sigs, err := attestverify.QuorumSigs(up.ValSet, up.Attestation.Signatures)
 	if err != nil {
 		return nil, errors.Wrap(err, "quorum sigs", "valset_id", up.Attestation.ValidatorSetID, "att_offset", up.Attestation.AttestOffset)
 	}
@@ -59,44 +56,6 @@ func CreateSubmissions(up StreamUpdate) ([]xchain.Submission, error) {
 	return resp, nil
 }
 
-// quorumSigs returns the minimum number of signatures required to reach quorum.
-func quorumSigs(valSet []cchain.PortalValidator, signatures []xchain.SigTuple) ([]xchain.SigTuple, error) {
-	var totalPower int64
-	powers := make(map[common.Address]int64)
-	for _, val := range valSet {
-		totalPower += val.Power
-		powers[val.Address] = val.Power
-	}
-
-	quorum := totalPower * 2 / 3
-
-	// Order signatures by power decreasing
-	sort.Slice(signatures, func(i, j int) bool {
-		return powers[signatures[i].ValidatorAddress] > powers[signatures[j].ValidatorAddress]
-	})
-
-	// Select minimum signatures to reach quorum
-	var resp []xchain.SigTuple
-	var sum int64
-	for _, sig := range signatures {
-		resp = append(resp, sig)
-		power, ok := powers[sig.ValidatorAddress]
-		if !ok {
-			return nil, errors.New("signature not in validator set [BUG]", "val", sig.ValidatorAddress)
-		}
-		sum += power
-		if sum > quorum {
-			break
-		}
-	}
-
-	if sum <= quorum {
-		return nil, errors.New("quorum not reached", "got", sum, "need", quorum)
-	}
-
-	return resp, nil
-}
-
 // groupMsgsByCost split the messages into groups that are each small enough (wrt calldata and gas)
 // to be submitted on-chain.
 func groupMsgsByCost(msgs []xchain.Msg) [][]xchain.Msg {
@@ -102,6 +102,42 @@ func (s *Store) WorkerOffsets(
 	return resp, nil
 }
 
+// Offsets are the latest and highest confirmed attest offsets of a streamer.
+type Offsets struct {
+	Latest    uint64
+	Confirmed uint64
+}
+
+// StreamerOffsets returns the latest and highest confirmed offsets of each streamer to the provided destination chain.
+func (s *Store) StreamerOffsets(
+	ctx context.Context,
+	destChain uint64,
+) (map[xchain.ChainVersion]Offsets, error) {
+	all, err := listAll(ctx, s.db)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make(map[xchain.ChainVersion]Offsets)
+	for s, cursors := range splitByStreamer(all) {
+		if s.DstChainID != destChain || len(cursors) == 0 {
+			continue
+		}
+
+		var offsets Offsets
+		for _, c := range cursors {
+			offsets.Latest = max(offsets.Latest, c.GetAttestOffset())
+			if c.GetConfirmed() {
+				offsets.Confirmed = max(offsets.Confirmed, c.GetAttestOffset())
+			}
+		}
+
+		resp[s.ChainVersion()] = offsets
+	}
+
+	return resp, nil
+}
+
 // Insert cursor for the provided streamer if it doesn't exist, otherwise ignore (keep existing).
 func (s *Store) Insert(
 	ctx context.Context,
@@ -135,11 +135,11 @@ func (m *mockXChainClient) GetEmittedCursor(ctx context.Context, ref xchain.Ref,
 }
 
 type mockSender struct {
-	SendTransactionFn func(ctx context.Context, submission xchain.Submission) <-chan error
+	SendTransactionFn func(ctx context.Context, submissions ...xchain.Submission) <-chan error
 }
 
-func (m *mockSender) SendTransaction(ctx context.Context, submission xchain.Submission) <-chan error {
-	return m.SendTransactionFn(ctx, submission)
+func (m *mockSender) SendTransaction(ctx context.Context, submissions ...xchain.Submission) <-chan error {
+	return m.SendTransactionFn(ctx, submissions...)
 }
 
 const mockValSetID = 99
@@ -0,0 +1,157 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/txmgr"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Simulation results, used as metric labels.
+const (
+	simSuccess  = "success"
+	simReverted = "reverted"
+)
+
+// simulator simulates submission transactions against the destination chain without broadcasting them.
+//
+// Note that simulations don't change destination chain state, so only the submission following
+// the on-chain submitted cursor of a stream can succeed. Subsequent submissions of the same stream
+// are expected to revert with "wrong offset".
+//
+// Transactions are signed with local sender keys, so the logged tx hashes match those that would be sent.
+// Transactions of external signers aren't signed, so dry-runs don't create Fireblocks signing requests.
+type simulator struct {
+	ethCl   ethclient.Client
+	chainID uint64
+	from    common.Address
+	privKey *ecdsa.PrivateKey // Local sender key, nil if external.
+}
+
+// Simulate builds the candidate transaction and estimates its gas against the latest destination chain state.
+// It returns the (unsent) transaction, a would-be receipt and the revert reason if the transaction would revert.
+// The transaction is only signed if the sender key is local.
+func (s simulator) Simulate(ctx context.Context, candidate txmgr.TxCandidate) (*ethtypes.Transaction, *ethclient.Receipt, string, error) {
+	head, err := s.ethCl.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, "latest header")
+	}
+
+	nonce, err := s.ethCl.PendingNonceAt(ctx, s.from)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, "pending nonce")
+	}
+
+	tip, err := s.ethCl.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, "suggest gas tip cap")
+	}
+
+	baseFee := head.BaseFee
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+
+	status := ethtypes.ReceiptStatusSuccessful
+	var reason string
+	gasUsed, err := s.ethCl.EstimateGas(ctx, ethereum.CallMsg{
+		From:  s.from,
+		To:    candidate.To,
+		Value: candidate.Value,
+		Data:  candidate.TxData,
+	})
+	if err != nil {
+		var ok bool
+		reason, ok = revertReason(err)
+		if !ok {
+			return nil, nil, "", errors.Wrap(err, "estimate gas")
+		}
+		status = ethtypes.ReceiptStatusFailed
+	}
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   umath.NewBigInt(s.chainID),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       candidate.GasLimit,
+		To:        candidate.To,
+		Value:     candidate.Value,
+		Data:      candidate.TxData,
+	})
+
+	if s.privKey != nil {
+		tx, err = ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(tx.ChainId()), s.privKey)
+		if err != nil {
+			return nil, nil, "", errors.Wrap(err, "sign tx")
+		}
+	}
+
+	rec := &ethclient.Receipt{
+		Type:              ethtypes.DynamicFeeTxType,
+		Status:            status,
+		TxHash:            tx.Hash(),
+		GasUsed:           gasUsed,
+		EffectiveGasPrice: new(big.Int).Add(baseFee, tip),
+		BlockNumber:       head.Number,
+	}
+
+	return tx, rec, reason, nil
+}
+
+// simulateAsync simulates the submissions asynchronously, recording the would-be results.
+// It returns a channel that will receive an error if the simulation fails, but not if the transaction would revert.
+func (s Sender) simulateAsync(ctx context.Context, subs []xchain.Submission, candidate txmgr.TxCandidate, srcChains []string, reqAttrs []any) <-chan error {
+	asyncResp := make(chan error, 1)
+	go func() {
+		tx, rec, reason, err := s.simulator.Simulate(ctx, candidate)
+		if err != nil {
+			asyncResp <- errors.Wrap(err, "failed to simulate tx", reqAttrs...)
+			return
+		}
+
+		result := simSuccess
+		if rec.Status != ethtypes.ReceiptStatusSuccessful {
+			result = simReverted
+		}
+
+		var numMsgs int
+		for _, sub := range subs {
+			numMsgs += len(sub.Msgs)
+		}
+
+		for _, srcChain := range srcChains {
+			simulationTotal.WithLabelValues(srcChain, s.chain.Name, result).Inc()
+		}
+
+		log.Info(ctx, "Simulated submission (dry-run)",
+			"result", result,
+			"revert_reason", reason,
+			"gas_limit", candidate.GasLimit,
+			"gas_used", rec.GasUsed,
+			"nonce", tx.Nonce(),
+			"tx_hash", tx.Hash(),
+			"batch", len(subs),
+			"msgs", numMsgs,
+		)
+
+		if s.onSubmit != nil {
+			go s.onSubmit(ctx, tx, rec, subs, failedSubmissions(s.chain.PortalAddress, rec, subs))
+		}
+
+		asyncResp <- nil
+	}()
+
+	return asyncResp
+}
@@ -0,0 +1,134 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/txmgr"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	from := common.Address{2}
+
+	const chainID = 100
+
+	client := &simClient{gas: 50_000}
+	sim := simulator{
+		ethCl:   client,
+		chainID: chainID,
+		from:    from,
+	}
+
+	to := common.Address{1}
+	candidate := txmgr.TxCandidate{
+		TxData:   []byte{1, 2, 3},
+		To:       &to,
+		GasLimit: 100_000,
+		Value:    big.NewInt(0),
+	}
+
+	// Successful simulation of an external signer returns an unsigned tx and would-be receipt.
+	tx, rec, reason, err := sim.Simulate(ctx, candidate)
+	require.NoError(t, err)
+	require.Empty(t, reason)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, rec.Status)
+	require.EqualValues(t, 50_000, rec.GasUsed)
+	require.EqualValues(t, 100_000, tx.Gas())
+	require.EqualValues(t, 7, tx.Nonce())
+	require.Equal(t, tx.Hash(), rec.TxHash)
+	require.EqualValues(t, chainID, tx.ChainId().Uint64())
+
+	v, r, sig := tx.RawSignatureValues()
+	require.Zero(t, v.Sign()+r.Sign()+sig.Sign())
+
+	// Local sender keys sign the simulated tx.
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signed := simulator{
+		ethCl:   client,
+		chainID: chainID,
+		from:    crypto.PubkeyToAddress(privKey.PublicKey),
+		privKey: privKey,
+	}
+	tx, rec, _, err = signed.Simulate(ctx, candidate)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), rec.TxHash)
+	signer, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	require.NoError(t, err)
+	require.Equal(t, signed.from, signer)
+
+	// Reverts are decoded and don't error.
+	client.revert = "OmniPortal: wrong offset"
+	_, rec, reason, err = sim.Simulate(ctx, candidate)
+	require.NoError(t, err)
+	require.Equal(t, "OmniPortal: wrong offset", reason)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, rec.Status)
+
+	// Other errors are returned.
+	client.revert = ""
+	client.err = ethereum.NotFound
+	_, _, _, err = sim.Simulate(ctx, candidate)
+	require.ErrorIs(t, err, ethereum.NotFound)
+}
+
+// simClient is a fake ethclient.Client only implementing the methods used by the simulator.
+type simClient struct {
+	ethclient.Client
+
+	gas    uint64
+	revert string
+	err    error
+}
+
+func (c *simClient) HeaderByNumber(context.Context, *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{Number: big.NewInt(1), BaseFee: big.NewInt(1e9)}, nil
+}
+
+func (*simClient) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return 7, nil
+}
+
+func (*simClient) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return big.NewInt(1e8), nil
+}
+
+func (c *simClient) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	if c.err != nil {
+		return 0, c.err
+	} else if c.revert != "" {
+		return 0, newRevertErr(c.revert)
+	}
+
+	return c.gas, nil
+}
+
+// revertDataErr is a rpc.DataError of a reverted call.
+type revertDataErr struct {
+	data string
+}
+
+func newRevertErr(reason string) revertDataErr {
+	typ, _ := abi.NewType("string", "", nil)
+	packed, _ := abi.Arguments{{Type: typ}}.Pack(reason)
+	selector := crypto.Keccak256([]byte("Error(string)"))[:4]
+
+	return revertDataErr{data: hexutil.Encode(append(selector, packed...))}
+}
+
+func (revertDataErr) Error() string    { return "execution reverted" }
+func (e revertDataErr) ErrorData() any { return e.data }
+func (revertDataErr) ErrorCode() int   { return 3 }
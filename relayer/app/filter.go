@@ -0,0 +1,426 @@
+package relayer
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxDeferredMsgs is the maximum number of unsponsored msgs deferred per stream.
+	maxDeferredMsgs = 10_000
+	// defaultMaxDeferral is the default maximum duration unsponsored msgs are deferred.
+	defaultMaxDeferral = time.Hour
+)
+
+// FilterConfig defines which streams and xmsgs the relayer relays.
+// Empty allow lists allow all, while deny lists take precedence over allow lists.
+type FilterConfig struct {
+	AllowSrcChains []string // Source chain names
+	DenySrcChains  []string
+	AllowDstChains []string // Destination chain names
+	DenyDstChains  []string
+	AllowShards    []string // Shard labels (F, L, B) or IDs
+	DenyShards     []string
+	AllowSenders   []string // Source msg sender addresses
+	DenySenders    []string
+	AllowDestAddrs []string // Destination addresses
+	DenyDestAddrs  []string
+	// MaxDeferral is the maximum duration unsponsored msgs are deferred before they are dropped. Zero disables it.
+	MaxDeferral time.Duration
+}
+
+// allowDeny is an allow and deny list filter.
+type allowDeny[T comparable] struct {
+	allow map[T]bool
+	deny  map[T]bool
+}
+
+// Allowed returns true if the value isn't denied and either allowed or the allow list is empty.
+func (f allowDeny[T]) Allowed(v T) bool {
+	if f.deny[v] {
+		return false
+	}
+
+	return len(f.allow) == 0 || f.allow[v]
+}
+
+// Empty returns true if the filter allows all values.
+func (f allowDeny[T]) Empty() bool {
+	return len(f.allow) == 0 && len(f.deny) == 0
+}
+
+func newAllowDeny[T comparable](allow, deny []string, parse func(string) (T, error)) (allowDeny[T], error) {
+	toSet := func(values []string) (map[T]bool, error) {
+		resp := make(map[T]bool)
+		for _, value := range values {
+			v, err := parse(value)
+			if err != nil {
+				return nil, err
+			}
+			resp[v] = true
+		}
+
+		return resp, nil
+	}
+
+	allowSet, err := toSet(allow)
+	if err != nil {
+		return allowDeny[T]{}, err
+	}
+
+	denySet, err := toSet(deny)
+	if err != nil {
+		return allowDeny[T]{}, err
+	}
+
+	return allowDeny[T]{allow: allowSet, deny: denySet}, nil
+}
+
+// relayFilter filters relayed streams and sponsored xmsgs.
+//
+// Streams not allowed by the stream filters (source chain, destination chain, shard) are not relayed at all.
+//
+// Msgs not allowed by the msg filters (sender, destination address) are not sponsored. Since the portal requires
+// sequential msg offsets, unsponsored msgs are deferred (with their attestation proofs) and only submitted
+// if still undelivered when a subsequent sponsored msg of the same stream is submitted.
+// So the relayer only pays for unsponsored msgs preceding sponsored msgs.
+// Consensus chain msgs (validator set updates) are always sponsored.
+type relayFilter struct {
+	consensusID uint64
+	srcChains   allowDeny[uint64]
+	dstChains   allowDeny[uint64]
+	shards      allowDeny[xchain.ShardID]
+	senders     allowDeny[common.Address]
+	destAddrs   allowDeny[common.Address]
+	maxDeferral time.Duration
+}
+
+// newRelayFilter returns a new relay filter from the config.
+func newRelayFilter(network netconf.Network, cfg FilterConfig) (relayFilter, error) {
+	parseChain := func(name string) (uint64, error) {
+		chain, ok := network.ChainByName(name)
+		if !ok {
+			return 0, errors.New("unknown filter chain", "chain", name)
+		}
+
+		return chain.ID, nil
+	}
+
+	parseShard := func(label string) (xchain.ShardID, error) {
+		for _, shard := range []xchain.ShardID{xchain.ShardFinalized0, xchain.ShardLatest0, xchain.ShardBroadcast0} {
+			if shard.Label() == label {
+				return shard, nil
+			}
+		}
+
+		id, err := strconv.ParseUint(label, 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid filter shard", "shard", label)
+		}
+
+		return xchain.ShardID(id), nil
+	}
+
+	parseAddr := func(addr string) (common.Address, error) {
+		if !common.IsHexAddress(addr) {
+			return common.Address{}, errors.New("invalid filter address", "address", addr)
+		}
+
+		return common.HexToAddress(addr), nil
+	}
+
+	var resp relayFilter
+	var err error
+	if resp.srcChains, err = newAllowDeny(cfg.AllowSrcChains, cfg.DenySrcChains, parseChain); err != nil {
+		return relayFilter{}, err
+	} else if resp.dstChains, err = newAllowDeny(cfg.AllowDstChains, cfg.DenyDstChains, parseChain); err != nil {
+		return relayFilter{}, err
+	} else if resp.shards, err = newAllowDeny(cfg.AllowShards, cfg.DenyShards, parseShard); err != nil {
+		return relayFilter{}, err
+	} else if resp.senders, err = newAllowDeny(cfg.AllowSenders, cfg.DenySenders, parseAddr); err != nil {
+		return relayFilter{}, err
+	} else if resp.destAddrs, err = newAllowDeny(cfg.AllowDestAddrs, cfg.DenyDestAddrs, parseAddr); err != nil {
+		return relayFilter{}, err
+	}
+
+	if consensus, ok := network.OmniConsensusChain(); ok {
+		resp.consensusID = consensus.ID
+	}
+
+	resp.maxDeferral = cfg.MaxDeferral
+
+	return resp, nil
+}
+
+// AllowDest returns true if streams to the destination chain are relayed.
+func (f relayFilter) AllowDest(chainID uint64) bool {
+	return f.dstChains.Allowed(chainID)
+}
+
+// AllowStream returns true if the stream is relayed.
+func (f relayFilter) AllowStream(stream xchain.StreamID) bool {
+	return f.srcChains.Allowed(stream.SourceChainID) &&
+		f.dstChains.Allowed(stream.DestChainID) &&
+		f.shards.Allowed(stream.ShardID)
+}
+
+// SponsorsAll returns true if all msgs of relayed streams are sponsored, i.e., no msg filters are configured.
+func (f relayFilter) SponsorsAll() bool {
+	return f.senders.Empty() && f.destAddrs.Empty()
+}
+
+// Sponsors returns true if the msg is sponsored.
+func (f relayFilter) Sponsors(msg xchain.Msg) bool {
+	if f.consensusID != 0 && msg.SourceChainID == f.consensusID {
+		return true
+	}
+
+	return f.senders.Allowed(msg.SourceMsgSender) && f.destAddrs.Allowed(msg.DestAddress)
+}
+
+// submittedOffsetFunc returns the latest submitted msg offset of the stream on the destination chain.
+type submittedOffsetFunc func(context.Context, xchain.StreamID) (uint64, error)
+
+// refetchFunc returns the stream update of the referenced attestation, including all msgs of the stream.
+type refetchFunc func(context.Context, xchain.StreamID, droppedRef) (StreamUpdate, error)
+
+// droppedRef references a dropped deferred update, so its msgs and proofs can be re-fetched if required.
+type droppedRef struct {
+	ChainVersion xchain.ChainVersion
+	AttestOffset uint64
+	FirstOffset  uint64 // First dropped msg offset
+	LastOffset   uint64 // Last dropped msg offset
+}
+
+// deferredUpdates holds stream updates of unsponsored msgs, so they can be submitted (with their proofs)
+// before subsequent sponsored msgs of the same stream, preserving stream ordering without stalling.
+//
+// Deferred msgs are held in memory only, but their cursors remain unconfirmed until delivered, so they are
+// replayed (and deferred again) after a restart. To bound memory, deferred msgs are dropped once a stream
+// exceeds maxDeferredMsgs or its oldest deferred msg exceeds the filter's maximum deferral (if non-zero).
+// Dropped msgs are never submitted on their own. Only references to their attestations are kept, so their
+// msgs and proofs are re-fetched if still undelivered when a subsequent sponsored msg requires their offsets.
+type deferredUpdates struct {
+	filter     relayFilter
+	submitted  submittedOffsetFunc
+	refetch    refetchFunc
+	streamName func(xchain.StreamID) string
+	now        func() time.Time
+
+	mu      sync.Mutex
+	updates map[xchain.StreamID][]StreamUpdate
+	since   map[xchain.StreamID]time.Time // Time of the oldest deferred update per stream
+	dropped map[xchain.StreamID][]droppedRef
+}
+
+func newDeferredUpdates(
+	filter relayFilter,
+	submitted submittedOffsetFunc,
+	refetch refetchFunc,
+	streamName func(xchain.StreamID) string,
+) *deferredUpdates {
+	return &deferredUpdates{
+		filter:     filter,
+		submitted:  submitted,
+		refetch:    refetch,
+		streamName: streamName,
+		now:        time.Now,
+		updates:    make(map[xchain.StreamID][]StreamUpdate),
+		since:      make(map[xchain.StreamID]time.Time),
+		dropped:    make(map[xchain.StreamID][]droppedRef),
+	}
+}
+
+// Sponsor returns the stream updates to submit given the next update of a stream.
+//
+// If the update doesn't contain sponsored msgs, it is deferred and nothing is returned.
+// If the stream's deferral limits are then exceeded, its deferred msgs are dropped.
+// Otherwise, it returns the previously dropped (re-fetched) and deferred msgs not yet delivered by other relayers,
+// followed by the update's msgs up to and including the last sponsored msg.
+// Trailing unsponsored msgs are deferred.
+func (d *deferredUpdates) Sponsor(ctx context.Context, update StreamUpdate) ([]StreamUpdate, error) {
+	last := -1
+	for i, msg := range update.Msgs {
+		if d.filter.Sponsors(msg) {
+			last = i
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	stream := update.StreamID
+	if last < 0 {
+		d.deferUnsafe(update)
+		if d.exceededUnsafe(stream) {
+			log.Info(ctx, "Dropping deferred unsponsored msgs exceeding deferral limits",
+				"stream", d.streamName(stream),
+				"msgs", countMsgs(d.updates[stream]),
+				"age", d.now().Sub(d.since[stream]).Truncate(time.Second),
+			)
+			d.dropUnsafe(stream)
+		}
+
+		return nil, nil
+	}
+
+	resp, err := d.flushUnsafe(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	head := update
+	head.Msgs = update.Msgs[:last+1]
+	resp = append(resp, head)
+
+	if tail := update.Msgs[last+1:]; len(tail) > 0 {
+		update.Msgs = tail
+		d.deferUnsafe(update)
+	}
+
+	return resp, nil
+}
+
+// DropExpired drops the deferred msgs of the included streams whose oldest deferral exceeds the maximum deferral.
+// This bounds the deferral of streams without subsequent updates.
+func (d *deferredUpdates) DropExpired(ctx context.Context, include func(xchain.StreamID) bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for stream := range d.updates {
+		if !include(stream) || !d.exceededUnsafe(stream) {
+			continue
+		}
+
+		log.Info(ctx, "Dropping expired deferred unsponsored msgs",
+			"stream", d.streamName(stream),
+			"msgs", countMsgs(d.updates[stream]),
+			"age", d.now().Sub(d.since[stream]).Truncate(time.Second),
+		)
+		d.dropUnsafe(stream)
+	}
+}
+
+// deferUnsafe defers the update.
+// It is unsafe since it assumes the lock is held.
+func (d *deferredUpdates) deferUnsafe(update StreamUpdate) {
+	stream := update.StreamID
+	if len(d.updates[stream]) == 0 {
+		d.since[stream] = d.now()
+	}
+	d.updates[stream] = append(d.updates[stream], update)
+	d.updateMetricUnsafe(stream)
+}
+
+// exceededUnsafe returns true if the stream's deferred msgs exceed the maximum count or age (if non-zero).
+// It is unsafe since it assumes the lock is held.
+func (d *deferredUpdates) exceededUnsafe(stream xchain.StreamID) bool {
+	if len(d.updates[stream]) == 0 {
+		return false
+	} else if countMsgs(d.updates[stream]) > maxDeferredMsgs {
+		return true
+	}
+
+	return d.filter.maxDeferral > 0 && d.now().Sub(d.since[stream]) > d.filter.maxDeferral
+}
+
+// dropUnsafe drops the deferred updates of the stream, only keeping (small) references to them.
+// It is unsafe since it assumes the lock is held.
+func (d *deferredUpdates) dropUnsafe(stream xchain.StreamID) {
+	for _, update := range d.updates[stream] {
+		d.dropped[stream] = append(d.dropped[stream], droppedRef{
+			ChainVersion: update.Attestation.ChainVersion,
+			AttestOffset: update.Attestation.AttestOffset,
+			FirstOffset:  update.Msgs[0].StreamOffset,
+			LastOffset:   update.Msgs[len(update.Msgs)-1].StreamOffset,
+		})
+	}
+
+	delete(d.updates, stream)
+	delete(d.since, stream)
+	d.updateMetricUnsafe(stream)
+}
+
+// flushUnsafe returns the undelivered dropped (re-fetched) and deferred updates of the stream,
+// removing them from the deferral.
+// It is unsafe since it assumes the lock is held.
+func (d *deferredUpdates) flushUnsafe(ctx context.Context, stream xchain.StreamID) ([]StreamUpdate, error) {
+	if len(d.updates[stream]) == 0 && len(d.dropped[stream]) == 0 {
+		return nil, nil
+	}
+
+	offset, err := d.submitted(ctx, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []StreamUpdate
+	for _, ref := range d.dropped[stream] {
+		if ref.LastOffset <= offset {
+			continue // Delivered by other relayers.
+		}
+
+		update, err := d.refetch(ctx, stream, ref)
+		if err != nil {
+			return nil, errors.Wrap(err, "refetch dropped update")
+		}
+
+		if update, ok := pruneMsgs(update, offset, ref.FirstOffset, ref.LastOffset); ok {
+			resp = append(resp, update)
+		}
+	}
+
+	for _, update := range d.updates[stream] {
+		if update, ok := pruneMsgs(update, offset, 0, math.MaxUint64); ok {
+			resp = append(resp, update)
+		}
+	}
+
+	delete(d.updates, stream)
+	delete(d.since, stream)
+	delete(d.dropped, stream)
+	d.updateMetricUnsafe(stream)
+
+	return resp, nil
+}
+
+func (d *deferredUpdates) updateMetricUnsafe(stream xchain.StreamID) {
+	deferredMsgs.WithLabelValues(d.streamName(stream)).Set(float64(countMsgs(d.updates[stream])))
+}
+
+// pruneMsgs returns the update with only msgs not yet submitted and within the inclusive offset range,
+// or false if none remain.
+func pruneMsgs(update StreamUpdate, submitted, first, last uint64) (StreamUpdate, bool) {
+	var msgs []xchain.Msg
+	for _, msg := range update.Msgs {
+		if msg.StreamOffset > submitted && msg.StreamOffset >= first && msg.StreamOffset <= last {
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(msgs) == 0 {
+		return StreamUpdate{}, false
+	}
+
+	update.Msgs = msgs
+
+	return update, true
+}
+
+func countMsgs(updates []StreamUpdate) int {
+	var resp int
+	for _, update := range updates {
+		resp += len(update.Msgs)
+	}
+
+	return resp
+}
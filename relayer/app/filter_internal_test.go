@@ -0,0 +1,203 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayFilter(t *testing.T) {
+	t.Parallel()
+
+	network := netconf.Network{Chains: []netconf.Chain{
+		{ID: 1, Name: "chain1"},
+		{ID: 2, Name: "chain2"},
+		{ID: 3, Name: "chain3"},
+	}}
+
+	filter, err := newRelayFilter(network, FilterConfig{
+		DenySrcChains:  []string{"chain3"},
+		AllowDstChains: []string{"chain1", "chain2"},
+		AllowShards:    []string{"F"},
+		AllowSenders:   []string{common.Address{1}.Hex()},
+		DenyDestAddrs:  []string{common.Address{2}.Hex()},
+	})
+	require.NoError(t, err)
+
+	require.True(t, filter.AllowDest(1))
+	require.False(t, filter.AllowDest(3))
+
+	require.True(t, filter.AllowStream(xchain.StreamID{SourceChainID: 2, DestChainID: 1, ShardID: xchain.ShardFinalized0}))
+	require.False(t, filter.AllowStream(xchain.StreamID{SourceChainID: 3, DestChainID: 1, ShardID: xchain.ShardFinalized0}))
+	require.False(t, filter.AllowStream(xchain.StreamID{SourceChainID: 2, DestChainID: 1, ShardID: xchain.ShardLatest0}))
+
+	require.False(t, filter.SponsorsAll())
+	newMsg := func(sender, dest common.Address) xchain.Msg {
+		return xchain.Msg{
+			MsgID:           xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: 2}},
+			SourceMsgSender: sender,
+			DestAddress:     dest,
+		}
+	}
+	require.True(t, filter.Sponsors(newMsg(common.Address{1}, common.Address{})))
+	require.False(t, filter.Sponsors(newMsg(common.Address{3}, common.Address{})))
+	require.False(t, filter.Sponsors(newMsg(common.Address{1}, common.Address{2})))
+
+	// Empty filters allow all.
+	require.True(t, relayFilter{}.AllowStream(xchain.StreamID{SourceChainID: 3}))
+	require.True(t, relayFilter{}.SponsorsAll())
+
+	// Invalid filters
+	_, err = newRelayFilter(network, FilterConfig{AllowSrcChains: []string{"unknown"}})
+	require.ErrorContains(t, err, "unknown filter chain")
+	_, err = newRelayFilter(network, FilterConfig{DenySenders: []string{"0x1"}})
+	require.ErrorContains(t, err, "invalid filter address")
+}
+
+func TestDeferredUpdates(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	sponsored := common.Address{1}
+	filter := relayFilter{
+		senders:     allowDeny[common.Address]{allow: map[common.Address]bool{sponsored: true}},
+		maxDeferral: time.Hour,
+	}
+
+	stream := xchain.StreamID{SourceChainID: 1, DestChainID: 2}
+	var submitted uint64
+	created := make(map[uint64]StreamUpdate) // Created updates by attest offset
+	var refetched []uint64
+	deferred := newDeferredUpdates(filter, func(context.Context, xchain.StreamID) (uint64, error) {
+		return submitted, nil
+	}, func(_ context.Context, _ xchain.StreamID, ref droppedRef) (StreamUpdate, error) {
+		refetched = append(refetched, ref.AttestOffset)
+		return created[ref.AttestOffset], nil
+	}, func(xchain.StreamID) string { return "test" })
+
+	now := time.Unix(0, 0)
+	deferred.now = func() time.Time { return now }
+
+	newUpdate := func(attOffset uint64, senders ...common.Address) StreamUpdate {
+		var msgs []xchain.Msg
+		for i, sender := range senders {
+			msgs = append(msgs, xchain.Msg{
+				MsgID:           xchain.MsgID{StreamID: stream, StreamOffset: attOffset*10 + uint64(i)},
+				SourceMsgSender: sender,
+			})
+		}
+
+		update := StreamUpdate{
+			StreamID:    stream,
+			Attestation: xchain.Attestation{AttestHeader: xchain.AttestHeader{AttestOffset: attOffset}},
+			Msgs:        msgs,
+		}
+		created[attOffset] = update
+
+		return update
+	}
+
+	offsets := func(updates []StreamUpdate) string {
+		var resp []uint64
+		for _, update := range updates {
+			for _, msg := range update.Msgs {
+				resp = append(resp, msg.StreamOffset)
+			}
+		}
+
+		return fmt.Sprint(resp)
+	}
+
+	// Unsponsored updates are deferred.
+	updates, err := deferred.Sponsor(ctx, newUpdate(1, common.Address{}, common.Address{}))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	updates, err = deferred.Sponsor(ctx, newUpdate(2, common.Address{}))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// Sponsored msgs flush undelivered deferred msgs, deferring trailing unsponsored msgs.
+	submitted = 10 // First msg delivered by another relayer.
+	updates, err = deferred.Sponsor(ctx, newUpdate(3, common.Address{}, sponsored, common.Address{}))
+	require.NoError(t, err)
+	require.Equal(t, "[11 20 30 31]", offsets(updates))
+	require.Len(t, updates, 3)
+
+	// Delivered deferred msgs are dropped.
+	submitted = 32
+	updates, err = deferred.Sponsor(ctx, newUpdate(4, sponsored))
+	require.NoError(t, err)
+	require.Equal(t, "[40]", offsets(updates))
+
+	// Unsponsored msgs deferred longer than the max deferral are dropped.
+	updates, err = deferred.Sponsor(ctx, newUpdate(5, common.Address{}))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	now = now.Add(time.Minute)
+	updates, err = deferred.Sponsor(ctx, newUpdate(6, common.Address{}, common.Address{}))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	other := func(xchain.StreamID) bool { return false }
+	all := func(xchain.StreamID) bool { return true }
+
+	deferred.DropExpired(ctx, all)
+	require.Len(t, deferred.updates[stream], 2)
+
+	now = now.Add(time.Hour)
+	deferred.DropExpired(ctx, other)
+	require.Len(t, deferred.updates[stream], 2)
+
+	deferred.DropExpired(ctx, all)
+	require.Empty(t, deferred.updates)
+	require.Len(t, deferred.dropped[stream], 2)
+
+	// Dropped msgs are only re-fetched if undelivered when a subsequent sponsored msg requires them.
+	submitted = 60 // Some dropped msgs delivered by another relayer.
+	updates, err = deferred.Sponsor(ctx, newUpdate(7, sponsored, common.Address{}))
+	require.NoError(t, err)
+	require.Equal(t, "[61 70]", offsets(updates))
+	require.Equal(t, []uint64{6}, refetched)
+	require.Empty(t, deferred.dropped)
+
+	// Dropped trailing msgs are re-fetched without the already submitted sponsored head.
+	deferred.DropExpired(ctx, all)
+	require.Len(t, deferred.updates[stream], 1)
+	now = now.Add(2 * time.Hour)
+	deferred.DropExpired(ctx, all)
+	require.Empty(t, deferred.updates)
+
+	updates, err = deferred.Sponsor(ctx, newUpdate(8, sponsored))
+	require.NoError(t, err)
+	require.Equal(t, "[71 80]", offsets(updates))
+	require.Equal(t, []uint64{6, 7}, refetched)
+
+	// A zero max deferral never drops deferred msgs by age.
+	deferred.filter.maxDeferral = 0
+	updates, err = deferred.Sponsor(ctx, newUpdate(9, common.Address{}))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	now = now.Add(100 * time.Hour)
+	deferred.DropExpired(ctx, all)
+	require.Len(t, deferred.updates[stream], 1)
+
+	// Streams exceeding the max deferred msgs are dropped regardless.
+	var senders []common.Address
+	for range maxDeferredMsgs {
+		senders = append(senders, common.Address{})
+	}
+	updates, err = deferred.Sponsor(ctx, newUpdate(10, senders...))
+	require.NoError(t, err)
+	require.Empty(t, updates)
+	require.Empty(t, deferred.updates)
+	require.Len(t, deferred.dropped[stream], 2)
+}
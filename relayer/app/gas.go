@@ -1,15 +1,21 @@
 package relayer
 
 import (
+	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/lib/evmchain"
 	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/relayer/app/gasmodel"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
 	subGasBase               uint64 = 500_000
 	subGasXmsgOverhead       uint64 = 100_000
 	subGasMax                uint64 = 10_000_000 // Many chains have block gas limit of 30M, so we limit ourselves to 1/3 of that.
+	subGasBatchOverhead      uint64 = 350_000    // Each additional batched submission still verifies its quorum and proof, only the tx overhead is shared.
 	subEphemeralConsensusGas uint64 = 5_000_000
 	properGasEstimation      uint64 = 0 // Use proper (RPC) gas estimation
 )
@@ -69,3 +75,60 @@ func naiveSubmissionGas(msgs []xchain.Msg) uint64 {
 
 	return resp
 }
+
+// batchGas returns the estimated max gas usage of a batch of submissions packed into a single transaction:
+// - <first submission> + sum(<additional submission> - <gasBase> + <gasBatchOverhead>).
+// It returns zero if proper (RPC) gas estimation should be used for any of the submissions.
+func batchGas(estimator gasEstimator, destChain uint64, subs []xchain.Submission) uint64 {
+	var resp uint64
+	for i, sub := range subs {
+		gas := estimator(destChain, sub.Msgs)
+		if gas == properGasEstimation {
+			return properGasEstimation
+		}
+
+		if i > 0 {
+			gas = umath.SubtractOrZero(gas, subGasBase) + subGasBatchOverhead
+		}
+
+		resp += gas
+	}
+
+	return resp
+}
+
+// naiveBatchGas returns the estimated max gas usage of a batch of submissions using the naive model.
+func naiveBatchGas(subs []xchain.Submission) uint64 {
+	naive := func(_ uint64, msgs []xchain.Msg) uint64 {
+		return naiveSubmissionGas(msgs)
+	}
+
+	return batchGas(naive, 0, subs)
+}
+
+// gasFeatures returns the learned gas model features of a transaction submitting the submissions with the provided calldata.
+func gasFeatures(subs []xchain.Submission, txData []byte) gasmodel.Features {
+	resp := gasmodel.Features{
+		Submissions:  uint64(len(subs)),
+		CalldataSize: uint64(len(txData)),
+	}
+	for _, sub := range subs {
+		resp.Msgs += uint64(len(sub.Msgs))
+		resp.Sigs += uint64(len(sub.Signatures))
+	}
+
+	return resp
+}
+
+//nolint:gochecknoglobals // Static filterer only used to parse logs.
+var xreceiptFilterer = mustPortalFilterer()
+
+// mustPortalFilterer returns a portal filterer only used to parse logs. It panics on error.
+func mustPortalFilterer() *bindings.OmniPortalFilterer {
+	filterer, err := bindings.NewOmniPortalFilterer(common.Address{}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return filterer
+}
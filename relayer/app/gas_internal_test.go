@@ -116,3 +116,25 @@ func TestGasEstimator(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchGas(t *testing.T) {
+	t.Parallel()
+
+	msg := func(gasLimit uint64) xchain.Msg {
+		return xchain.Msg{DestGasLimit: gasLimit}
+	}
+
+	subs := []xchain.Submission{
+		{Msgs: []xchain.Msg{msg(10)}},
+		{Msgs: []xchain.Msg{msg(20), msg(30)}},
+	}
+
+	first := subGasBase + subGasXmsgOverhead + 10
+	second := subGasBatchOverhead + 2*subGasXmsgOverhead + 20 + 30
+	require.Equal(t, first, naiveBatchGas(subs[:1]))
+	require.Equal(t, first+second, naiveBatchGas(subs))
+
+	// Proper gas estimation is used if any submission requires it.
+	estimator := newGasEstimator(netconf.Mainnet)
+	require.Equal(t, properGasEstimation, batchGas(estimator, evmchain.IDArbitrumOne, subs))
+}
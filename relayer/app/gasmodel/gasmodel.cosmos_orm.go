@@ -0,0 +1,153 @@
+// Code generated by protoc-gen-go-cosmos-orm. DO NOT EDIT.
+
+package gasmodel
+
+import (
+	context "context"
+	ormlist "cosmossdk.io/orm/model/ormlist"
+	ormtable "cosmossdk.io/orm/model/ormtable"
+	ormerrors "cosmossdk.io/orm/types/ormerrors"
+)
+
+type ModelTable interface {
+	Insert(ctx context.Context, model *Model) error
+	Update(ctx context.Context, model *Model) error
+	Save(ctx context.Context, model *Model) error
+	Delete(ctx context.Context, model *Model) error
+	Has(ctx context.Context, dst_chain_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, dst_chain_id uint64) (*Model, error)
+	List(ctx context.Context, prefixKey ModelIndexKey, opts ...ormlist.Option) (ModelIterator, error)
+	ListRange(ctx context.Context, from, to ModelIndexKey, opts ...ormlist.Option) (ModelIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ModelIndexKey) error
+	DeleteRange(ctx context.Context, from, to ModelIndexKey) error
+
+	doNotImplement()
+}
+
+type ModelIterator struct {
+	ormtable.Iterator
+}
+
+func (i ModelIterator) Value() (*Model, error) {
+	var model Model
+	err := i.UnmarshalMessage(&model)
+	return &model, err
+}
+
+type ModelIndexKey interface {
+	id() uint32
+	values() []interface{}
+	modelIndexKey()
+}
+
+// primary key starting index..
+type ModelPrimaryKey = ModelDstChainIdIndexKey
+
+type ModelDstChainIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ModelDstChainIdIndexKey) id() uint32            { return 0 }
+func (x ModelDstChainIdIndexKey) values() []interface{} { return x.vs }
+func (x ModelDstChainIdIndexKey) modelIndexKey()        {}
+
+func (this ModelDstChainIdIndexKey) WithDstChainId(dst_chain_id uint64) ModelDstChainIdIndexKey {
+	this.vs = []interface{}{dst_chain_id}
+	return this
+}
+
+type modelTable struct {
+	table ormtable.Table
+}
+
+func (this modelTable) Insert(ctx context.Context, model *Model) error {
+	return this.table.Insert(ctx, model)
+}
+
+func (this modelTable) Update(ctx context.Context, model *Model) error {
+	return this.table.Update(ctx, model)
+}
+
+func (this modelTable) Save(ctx context.Context, model *Model) error {
+	return this.table.Save(ctx, model)
+}
+
+func (this modelTable) Delete(ctx context.Context, model *Model) error {
+	return this.table.Delete(ctx, model)
+}
+
+func (this modelTable) Has(ctx context.Context, dst_chain_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, dst_chain_id)
+}
+
+func (this modelTable) Get(ctx context.Context, dst_chain_id uint64) (*Model, error) {
+	var model Model
+	found, err := this.table.PrimaryKey().Get(ctx, &model, dst_chain_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &model, nil
+}
+
+func (this modelTable) List(ctx context.Context, prefixKey ModelIndexKey, opts ...ormlist.Option) (ModelIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ModelIterator{it}, err
+}
+
+func (this modelTable) ListRange(ctx context.Context, from, to ModelIndexKey, opts ...ormlist.Option) (ModelIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ModelIterator{it}, err
+}
+
+func (this modelTable) DeleteBy(ctx context.Context, prefixKey ModelIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this modelTable) DeleteRange(ctx context.Context, from, to ModelIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this modelTable) doNotImplement() {}
+
+var _ ModelTable = modelTable{}
+
+func NewModelTable(db ormtable.Schema) (ModelTable, error) {
+	table := db.GetTable(&Model{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Model{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return modelTable{table}, nil
+}
+
+type GasmodelStore interface {
+	ModelTable() ModelTable
+
+	doNotImplement()
+}
+
+type gasmodelStore struct {
+	model ModelTable
+}
+
+func (x gasmodelStore) ModelTable() ModelTable {
+	return x.model
+}
+
+func (gasmodelStore) doNotImplement() {}
+
+var _ GasmodelStore = gasmodelStore{}
+
+func NewGasmodelStore(db ormtable.Schema) (GasmodelStore, error) {
+	modelTable, err := NewModelTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return gasmodelStore{
+		modelTable,
+	}, nil
+}
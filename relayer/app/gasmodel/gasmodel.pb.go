@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: relayer/app/gasmodel/gasmodel.proto
+
+package gasmodel
+
+import (
+	_ "cosmossdk.io/api/cosmos/orm/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Model struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DstChainId    uint64                 `protobuf:"varint,1,opt,name=dst_chain_id,json=dstChainId,proto3" json:"dst_chain_id,omitempty"`         // Chain ID as per https://chainlist.org
+	Xtx           []float64              `protobuf:"fixed64,2,rep,packed,name=xtx,proto3" json:"xtx,omitempty"`                                   // Decayed sum of feature outer products (row-major)
+	Xty           []float64              `protobuf:"fixed64,3,rep,packed,name=xty,proto3" json:"xty,omitempty"`                                   // Decayed sum of features multiplied by overhead gas used
+	Samples       uint64                 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`                                   // Total number of observed transactions
+	MaxFeatures   []uint64               `protobuf:"varint,5,rep,packed,name=max_features,json=maxFeatures,proto3" json:"max_features,omitempty"` // Maximum observed value per feature
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Model) Reset() {
+	*x = Model{}
+	mi := &file_relayer_app_gasmodel_gasmodel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Model) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Model) ProtoMessage() {}
+
+func (x *Model) ProtoReflect() protoreflect.Message {
+	mi := &file_relayer_app_gasmodel_gasmodel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Model.ProtoReflect.Descriptor instead.
+func (*Model) Descriptor() ([]byte, []int) {
+	return file_relayer_app_gasmodel_gasmodel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Model) GetDstChainId() uint64 {
+	if x != nil {
+		return x.DstChainId
+	}
+	return 0
+}
+
+func (x *Model) GetXtx() []float64 {
+	if x != nil {
+		return x.Xtx
+	}
+	return nil
+}
+
+func (x *Model) GetXty() []float64 {
+	if x != nil {
+		return x.Xty
+	}
+	return nil
+}
+
+func (x *Model) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *Model) GetMaxFeatures() []uint64 {
+	if x != nil {
+		return x.MaxFeatures
+	}
+	return nil
+}
+
+var File_relayer_app_gasmodel_gasmodel_proto protoreflect.FileDescriptor
+
+var file_relayer_app_gasmodel_gasmodel_proto_rawDesc = string([]byte{
+	0x0a, 0x23, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x67, 0x61,
+	0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2f, 0x67, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x70, 0x2e, 0x67, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x17, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x20,
+	0x0a, 0x0c, 0x64, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x78, 0x74, 0x78, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x03, 0x78,
+	0x74, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x78, 0x74, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x01, 0x52,
+	0x03, 0x78, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x3a, 0x18, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x12, 0x0a, 0x0e, 0x0a, 0x0c, 0x64, 0x73, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x42, 0xce, 0x01, 0x0a, 0x18,
+	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x70, 0x2e,
+	0x67, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x0d, 0x47, 0x61, 0x73, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6f, 0x6d, 0x6e, 0x69, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2f,
+	0x61, 0x70, 0x70, 0x2f, 0x67, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0xa2, 0x02, 0x03, 0x52,
+	0x41, 0x47, 0xaa, 0x02, 0x14, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70,
+	0x2e, 0x47, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0xca, 0x02, 0x14, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x47, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0xe2, 0x02, 0x20, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5c, 0x41, 0x70, 0x70, 0x5c, 0x47,
+	0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x16, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x3a, 0x3a, 0x41,
+	0x70, 0x70, 0x3a, 0x3a, 0x47, 0x61, 0x73, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_relayer_app_gasmodel_gasmodel_proto_rawDescOnce sync.Once
+	file_relayer_app_gasmodel_gasmodel_proto_rawDescData []byte
+)
+
+func file_relayer_app_gasmodel_gasmodel_proto_rawDescGZIP() []byte {
+	file_relayer_app_gasmodel_gasmodel_proto_rawDescOnce.Do(func() {
+		file_relayer_app_gasmodel_gasmodel_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_relayer_app_gasmodel_gasmodel_proto_rawDesc), len(file_relayer_app_gasmodel_gasmodel_proto_rawDesc)))
+	})
+	return file_relayer_app_gasmodel_gasmodel_proto_rawDescData
+}
+
+var file_relayer_app_gasmodel_gasmodel_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_relayer_app_gasmodel_gasmodel_proto_goTypes = []any{
+	(*Model)(nil), // 0: relayer.app.gasmodel.Model
+}
+var file_relayer_app_gasmodel_gasmodel_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_relayer_app_gasmodel_gasmodel_proto_init() }
+func file_relayer_app_gasmodel_gasmodel_proto_init() {
+	if File_relayer_app_gasmodel_gasmodel_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_relayer_app_gasmodel_gasmodel_proto_rawDesc), len(file_relayer_app_gasmodel_gasmodel_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_relayer_app_gasmodel_gasmodel_proto_goTypes,
+		DependencyIndexes: file_relayer_app_gasmodel_gasmodel_proto_depIdxs,
+		MessageInfos:      file_relayer_app_gasmodel_gasmodel_proto_msgTypes,
+	}.Build()
+	File_relayer_app_gasmodel_gasmodel_proto = out.File
+	file_relayer_app_gasmodel_gasmodel_proto_goTypes = nil
+	file_relayer_app_gasmodel_gasmodel_proto_depIdxs = nil
+}
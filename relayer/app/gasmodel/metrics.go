@@ -0,0 +1,35 @@
+package gasmodel
+
+import (
+	"strconv"
+
+	"github.com/omni-network/omni/lib/evmchain"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	samplesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "gasmodel",
+		Name:      "samples_total",
+		Help:      "Total number of transactions observed by the learned gas model per destination chain",
+	}, []string{"dst_chain"})
+
+	outOfGasTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "gasmodel",
+		Name:      "out_of_gas_total",
+		Help:      "Total number of out-of-gas transactions observed by the learned gas model per destination chain",
+	}, []string{"dst_chain"})
+)
+
+// chainLabel returns the chain name if known, otherwise the chain ID.
+func chainLabel(chainID uint64) string {
+	if meta, ok := evmchain.MetadataByID(chainID); ok {
+		return meta.Name
+	}
+
+	return strconv.FormatUint(chainID, 10)
+}
@@ -0,0 +1,245 @@
+// Package gasmodel provides a persisted per-destination-chain submission gas model
+// learned from the actual gas used by previous submissions.
+//
+// The model predicts the "overhead" gas of a submission transaction, i.e., the gas used
+// excluding the execution of the xmsgs themselves (which is bounded by their gas limits).
+// It fits an online least-squares linear regression of overhead gas over transaction features
+// (submission count, message count, signature count, calldata size), with older
+// observations exponentially decayed so the model tracks changing chain behaviour.
+package gasmodel
+
+import (
+	"context"
+	"math"
+	"sync"
+
+	"github.com/omni-network/omni/lib/errors"
+
+	ormv1alpha1 "cosmossdk.io/api/cosmos/orm/v1alpha1"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/orm/model/ormdb"
+	"cosmossdk.io/orm/types/ormerrors"
+	db "github.com/cosmos/cosmos-db"
+)
+
+const (
+	numFeatures  = 5    // Intercept + Features fields.
+	decay        = 0.99 // Weight of previous observations when adding a new observation.
+	minSamples   = 20   // Minimum number of observations before the model is used.
+	safetyMargin = 1.25 // Multiplier applied to predicted overhead gas.
+	ridge        = 1e-6 // Regularization ensuring the normal equations are solvable.
+	minOverhead  = 21_000
+)
+
+// Features of a submission transaction affecting its overhead gas usage.
+type Features struct {
+	Submissions  uint64 // Number of submissions in the transaction (batched if more than one)
+	Msgs         uint64 // Total number of xmsgs
+	Sigs         uint64 // Total number of validator signatures
+	CalldataSize uint64 // Size of the transaction calldata in bytes
+}
+
+func (f Features) values() [numFeatures]uint64 {
+	return [numFeatures]uint64{1, f.Submissions, f.Msgs, f.Sigs, f.CalldataSize}
+}
+
+// Store provides persisted gas models per destination chain.
+type Store struct {
+	table ModelTable
+
+	mu    sync.Mutex
+	cache map[uint64]*Model
+}
+
+// New returns a new gas model store backed by the provided DB.
+func New(db db.DB) (*Store, error) {
+	schema := &ormv1alpha1.ModuleSchemaDescriptor{SchemaFile: []*ormv1alpha1.ModuleSchemaDescriptor_FileEntry{
+		{Id: 1, ProtoFileName: File_relayer_app_gasmodel_gasmodel_proto.Path()},
+	}}
+
+	modDB, err := ormdb.NewModuleDB(schema, ormdb.ModuleDBOptions{KVStoreService: dbStoreService{db}})
+	if err != nil {
+		return nil, errors.Wrap(err, "create ormdb module db")
+	}
+
+	dbStore, err := NewGasmodelStore(modDB)
+	if err != nil {
+		return nil, errors.Wrap(err, "create store")
+	}
+
+	return &Store{
+		table: dbStore.ModelTable(),
+		cache: make(map[uint64]*Model),
+	}, nil
+}
+
+// Observe updates the destination chain's model with the overhead gas used by a successful transaction.
+func (s *Store) Observe(ctx context.Context, dstChainID uint64, f Features, overheadGas uint64) error {
+	return s.observe(ctx, dstChainID, f, float64(overheadGas))
+}
+
+// ObserveOutOfGas updates the destination chain's model with the overhead gas limit of a transaction that ran out of gas.
+// Since the limit is only a lower bound of the actual overhead gas, it is observed lifted by the safety margin,
+// increasing subsequent estimates of similar transactions.
+func (s *Store) ObserveOutOfGas(ctx context.Context, dstChainID uint64, f Features, overheadLimit uint64) error {
+	if err := s.observe(ctx, dstChainID, f, float64(overheadLimit)*safetyMargin); err != nil {
+		return err
+	}
+
+	outOfGasTotal.WithLabelValues(chainLabel(dstChainID)).Inc()
+
+	return nil
+}
+
+// observe adds the observation of overhead gas to the destination chain's model.
+func (s *Store) observe(ctx context.Context, dstChainID uint64, f Features, overheadGas float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, err := s.getUnsafe(ctx, dstChainID)
+	if err != nil {
+		return err
+	}
+
+	x := f.values()
+	for i := range numFeatures {
+		for j := range numFeatures {
+			idx := i*numFeatures + j
+			model.Xtx[idx] = decay*model.Xtx[idx] + float64(x[i])*float64(x[j])
+		}
+		model.Xty[i] = decay*model.Xty[i] + float64(x[i])*overheadGas
+		model.MaxFeatures[i] = max(model.MaxFeatures[i], x[i])
+	}
+	model.Samples++
+
+	if err := s.table.Save(ctx, model); err != nil {
+		return errors.Wrap(err, "save model")
+	}
+
+	samplesTotal.WithLabelValues(chainLabel(dstChainID)).Inc()
+
+	return nil
+}
+
+// Estimate returns the predicted overhead gas (including a safety margin) of a transaction with the provided features.
+// It returns false if the model is not ready or the features are outside the observed range,
+// in which case the caller should fall back to a static model.
+func (s *Store) Estimate(ctx context.Context, dstChainID uint64, f Features) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	model, err := s.getUnsafe(ctx, dstChainID)
+	if err != nil {
+		return 0, false, err
+	} else if model.Samples < minSamples {
+		return 0, false, nil
+	}
+
+	x := f.values()
+	for i := range numFeatures {
+		if x[i] > model.MaxFeatures[i] {
+			return 0, false, nil // Don't extrapolate.
+		}
+	}
+
+	coefs, ok := solve(model.Xtx, model.Xty)
+	if !ok {
+		return 0, false, nil
+	}
+
+	var predicted float64
+	for i := range numFeatures {
+		predicted += coefs[i] * float64(x[i])
+	}
+
+	predicted *= safetyMargin
+	if math.IsNaN(predicted) || predicted < minOverhead || predicted > math.MaxUint32 {
+		return 0, false, nil // Nonsensical prediction.
+	}
+
+	return uint64(predicted), true, nil
+}
+
+// getUnsafe returns the (possibly empty) model of the destination chain.
+// It is unsafe since it assumes the lock is held.
+func (s *Store) getUnsafe(ctx context.Context, dstChainID uint64) (*Model, error) {
+	if model, ok := s.cache[dstChainID]; ok {
+		return model, nil
+	}
+
+	model, err := s.table.Get(ctx, dstChainID)
+	if ormerrors.IsNotFound(err) {
+		model = &Model{DstChainId: dstChainID}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "get model")
+	}
+
+	// Ensure dimensions match, resetting the model if features changed.
+	if len(model.GetXtx()) != numFeatures*numFeatures || len(model.GetXty()) != numFeatures || len(model.GetMaxFeatures()) != numFeatures {
+		model = &Model{
+			DstChainId:  dstChainID,
+			Xtx:         make([]float64, numFeatures*numFeatures),
+			Xty:         make([]float64, numFeatures),
+			MaxFeatures: make([]uint64, numFeatures),
+		}
+	}
+
+	s.cache[dstChainID] = model
+
+	return model, nil
+}
+
+// solve returns the ridge regularized least-squares coefficients by solving
+// the normal equations (XᵀX + λI)β = Xᵀy using Gaussian elimination with partial pivoting.
+func solve(xtx []float64, xty []float64) ([numFeatures]float64, bool) {
+	const n = numFeatures
+
+	// Build augmented matrix [XᵀX + λI | Xᵀy]
+	var a [n][n + 1]float64
+	for i := range n {
+		for j := range n {
+			a[i][j] = xtx[i*n+j]
+		}
+		a[i][i] += ridge
+		a[i][n] = xty[i]
+	}
+
+	for col := range n {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(a[row][col]) > math.Abs(a[pivot][col]) {
+				pivot = row
+			}
+		}
+		if a[pivot][col] == 0 {
+			return [n]float64{}, false
+		}
+		a[col], a[pivot] = a[pivot], a[col]
+
+		for row := col + 1; row < n; row++ {
+			factor := a[row][col] / a[col][col]
+			for k := col; k <= n; k++ {
+				a[row][k] -= factor * a[col][k]
+			}
+		}
+	}
+
+	var resp [n]float64
+	for i := n - 1; i >= 0; i-- {
+		sum := a[i][n]
+		for j := i + 1; j < n; j++ {
+			sum -= a[i][j] * resp[j]
+		}
+		resp[i] = sum / a[i][i]
+	}
+
+	return resp, true
+}
+
+type dbStoreService struct {
+	db.DB
+}
+
+func (db dbStoreService) OpenKVStore(context.Context) store.KVStore {
+	return db.DB
+}
@@ -0,0 +1,95 @@
+package gasmodel
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const chainID = 1
+
+	// overhead is the "true" overhead gas model of the chain.
+	overhead := func(f Features) uint64 {
+		return 300_000 + 50_000*f.Submissions + 20_000*f.Msgs + 5_000*f.Sigs + 16*f.CalldataSize
+	}
+
+	memDB := db.NewMemDB()
+	store, err := New(memDB)
+	require.NoError(t, err)
+
+	features := Features{Submissions: 1, Msgs: 2, Sigs: 10, CalldataSize: 2000}
+
+	// Not ready without samples.
+	_, ok, err := store.Estimate(ctx, chainID, features)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	rnd := rand.New(rand.NewSource(0))
+	for range minSamples * 5 {
+		f := Features{
+			Submissions:  1 + uint64(rnd.Intn(4)),
+			Msgs:         1 + uint64(rnd.Intn(10)),
+			Sigs:         5 + uint64(rnd.Intn(10)),
+			CalldataSize: 1000 + uint64(rnd.Intn(5000)),
+		}
+		require.NoError(t, store.Observe(ctx, chainID, f, overhead(f)))
+	}
+
+	// Prediction includes safety margin.
+	estimate, ok, err := store.Estimate(ctx, chainID, features)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.InEpsilon(t, float64(overhead(features))*safetyMargin, float64(estimate), 0.01)
+
+	// Other chains are not affected.
+	_, ok, err = store.Estimate(ctx, chainID+1, features)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Don't extrapolate beyond observed features.
+	_, ok, err = store.Estimate(ctx, chainID, Features{Submissions: 10, Msgs: 1, Sigs: 5, CalldataSize: 1000})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// Model is persisted.
+	reloaded, err := New(memDB)
+	require.NoError(t, err)
+	estimate2, ok, err := reloaded.Estimate(ctx, chainID, features)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, estimate, estimate2)
+}
+
+func TestModelOutOfGas(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const chainID = 1
+
+	store, err := New(db.NewMemDB())
+	require.NoError(t, err)
+
+	features := Features{Submissions: 1, Msgs: 1, Sigs: 10, CalldataSize: 1000}
+	for range minSamples {
+		require.NoError(t, store.Observe(ctx, chainID, features, 400_000))
+	}
+
+	before, ok, err := store.Estimate(ctx, chainID, features)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Transactions running out of gas with the estimated limit increase subsequent estimates.
+	for range 5 {
+		require.NoError(t, store.ObserveOutOfGas(ctx, chainID, features, before))
+	}
+
+	after, ok, err := store.Estimate(ctx, chainID, features)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Greater(t, after, before)
+}
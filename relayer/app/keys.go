@@ -0,0 +1,120 @@
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/fireblocks"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/txmgr"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// senderKey is a key used to sign submission transactions.
+// It is either a local private key or an account signed by an external signer (e.g. fireblocks).
+type senderKey struct {
+	from     common.Address
+	privKey  *ecdsa.PrivateKey    // Local private key, nil if external.
+	external txmgr.ExternalSigner // External signer, nil if local.
+}
+
+// txMgrConfig returns a txmgr config signing with the key.
+func (k senderKey) txMgrConfig(cliCfg txmgr.CLIConfig, client ethclient.Client) (txmgr.Config, error) {
+	if k.privKey != nil {
+		return txmgr.NewConfig(cliCfg, k.privKey, client)
+	}
+
+	return txmgr.NewConfigWithSigner(cliCfg, k.external, k.from, client)
+}
+
+// loadSenderKeys returns all configured sender keys; local private keys followed by fireblocks accounts.
+func loadSenderKeys(ctx context.Context, cfg Config) ([]senderKey, error) {
+	var resp []senderKey
+	for _, path := range append([]string{cfg.PrivateKey}, cfg.ExtraPrivateKeys...) {
+		if path == "" {
+			continue
+		}
+
+		privKey, err := ethcrypto.LoadECDSA(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load private key", "path", path)
+		}
+
+		resp = append(resp, senderKey{
+			from:    ethcrypto.PubkeyToAddress(privKey.PublicKey),
+			privKey: privKey,
+		})
+	}
+
+	fireKeys, err := loadFireblocksKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp = append(resp, fireKeys...)
+
+	if len(resp) == 0 {
+		return nil, errors.New("no sender keys configured")
+	}
+
+	// Keys sharing an address would also share a nonce sequence.
+	seen := make(map[common.Address]bool)
+	for _, key := range resp {
+		if seen[key.from] {
+			return nil, errors.New("duplicate sender key", "address", key.from)
+		}
+		seen[key.from] = true
+	}
+
+	for _, key := range resp {
+		log.Info(ctx, "Loaded sender key", "address", key.from, "external", key.privKey == nil)
+	}
+
+	return resp, nil
+}
+
+// loadFireblocksKeys returns the configured fireblocks accounts as sender keys, or nil if fireblocks is disabled.
+func loadFireblocksKeys(cfg Config) ([]senderKey, error) {
+	if cfg.FireAPIKey == "" {
+		return nil, nil
+	} else if len(cfg.FireAddresses) == 0 {
+		return nil, errors.New("fireblocks addresses required if fireblocks is enabled")
+	}
+
+	key, err := fireblocks.LoadKey(cfg.FireKeyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "load fireblocks key")
+	}
+
+	fireCl, err := fireblocks.New(cfg.Network, cfg.FireAPIKey, key, fireblocks.WithSignNote("omni relayer "+cfg.Network.String()))
+	if err != nil {
+		return nil, errors.Wrap(err, "new fireblocks")
+	}
+
+	var resp []senderKey
+	for _, addr := range cfg.FireAddresses {
+		if !common.IsHexAddress(addr) {
+			return nil, errors.New("invalid fireblocks address", "address", addr)
+		}
+
+		resp = append(resp, senderKey{
+			from:     common.HexToAddress(addr),
+			external: fireCl.Sign,
+		})
+	}
+
+	return resp, nil
+}
+
+// senderKeyAddresses returns the addresses of the keys.
+func senderKeyAddresses(keys []senderKey) []common.Address {
+	resp := make([]common.Address, 0, len(keys))
+	for _, key := range keys {
+		resp = append(resp, key.from)
+	}
+
+	return resp
+}
@@ -13,6 +13,36 @@ var (
 		Help:      "The length of the async send worker activeBuffer per destination chain. Alert if too high",
 	}, []string{"dst_chain"})
 
+	streamQueueLen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "stream_queue_length",
+		Help:      "The number of submissions queued in the activeBuffer per stream. Alert if too high",
+	}, []string{"stream"})
+
+	streamQueueDelay = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "stream_queue_delay_seconds",
+		Help:      "Duration submissions are queued in the activeBuffer per stream before being sent",
+		Buckets:   []float64{.01, .1, .5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"stream"})
+
+	deferredMsgs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "deferred_msgs",
+		Help:      "The number of unsponsored msgs deferred per stream by relay msg filters",
+	}, []string{"stream"})
+
+	batchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "batch_size",
+		Help:      "Number of submissions packed into a single transaction per destination chain",
+		Buckets:   []float64{1, 2, 3, 4, 6, 8, 12, 16},
+	}, []string{"dst_chain"})
+
 	mempoolLen = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "relayer",
 		Subsystem: "worker",
@@ -48,6 +78,27 @@ var (
 		Help:      "The total number of reverted (unsuccessful) submissions to destination chain from a specific source chain",
 	}, []string{"src_chain", "dst_chain"})
 
+	submissionFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "submission_failures_total",
+		Help:      "The total number of failed submissions to a destination chain by failure reason",
+	}, []string{"dst_chain", "reason"})
+
+	xmsgFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "xmsg_execution_failures_total",
+		Help:      "The total number of delivered xmsgs that failed execution on a destination chain",
+	}, []string{"dst_chain"})
+
+	simulationTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "simulation_total",
+		Help:      "The total number of dry-run simulated submissions to destination chain from a specific source chain by result (success, reverted)",
+	}, []string{"src_chain", "dst_chain", "result"})
+
 	gasEstimated = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "relayer",
 		Subsystem: "worker",
@@ -56,10 +107,60 @@ var (
 		Buckets:   prometheus.ExponentialBucketsRange(21_000, 10_000_000, 8),
 	}, []string{"dst_chain"})
 
+	gasModelUsed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "gas_model_used_total",
+		Help:      "Total number of submission transactions using the learned gas model (instead of the static model) per destination chain",
+	}, []string{"dst_chain"})
+
+	gasUsedRatio = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "relayer",
+		Subsystem: "worker",
+		Name:      "gas_used_ratio",
+		Help:      "Ratio of gas used to gas limit of submission transactions per destination chain. Low values indicate overpaying gas limits",
+		Buckets:   []float64{0.1, 0.25, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95, 1},
+	}, []string{"dst_chain"})
+
 	spendTotal = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: "relayer",
 		Subsystem: "worker",
 		Name:      "spend_gwei_total",
 		Help:      "Total amount of tokens spent by the relayer on a destination chain (in gwei)",
 	}, []string{"chain", "token"})
+
+	profitDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "relayer",
+		Subsystem: "profit",
+		Name:      "decision_total",
+		Help:      "Total number of profitability gate decisions per stream by decision (send, hold, expired, exempt, error)",
+	}, []string{"stream", "decision"})
+
+	profitRatio = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "profit",
+		Name:      "ratio_percent",
+		Help:      "Latest predicted fees to cost ratio (in percent) of submissions per stream",
+	}, []string{"stream"})
+
+	heldSubmissions = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "profit",
+		Name:      "held_submissions",
+		Help:      "Number of unprofitable submissions held by the profitability gate per stream. Alert if too high",
+	}, []string{"stream"})
+
+	senderKeyBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "sender",
+		Name:      "key_balance_ether",
+		Help:      "The balance of the sender key on a destination chain in ether. Alert if low.",
+	}, []string{"dst_chain", "address"})
+
+	senderKeyStuck = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "relayer",
+		Subsystem: "sender",
+		Name:      "key_stuck",
+		Help:      "Constant gauge indicating whether the sender key's nonce is stuck and excluded from sending (1=true,0=false). Alert if stuck",
+	}, []string{"dst_chain", "address"})
 )
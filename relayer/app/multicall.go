@@ -0,0 +1,147 @@
+package relayer
+
+import (
+	"context"
+
+	"github.com/omni-network/omni/lib/contracts"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// multicall3ABI is the subset of the Multicall3 ABI used to batch xsubmit calls.
+const multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+//nolint:gochecknoglobals // Static ABI types
+var multicallABI = mustGetABI(&bind.MetaData{ABI: multicall3ABI})
+
+// multicall3Call matches the Multicall3.Call3 struct.
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// multicall3Result matches the Multicall3.Result struct.
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// multicallAddress returns the Multicall3 address if it is deployed on the chain.
+func multicallAddress(ctx context.Context, client ethclient.Client) (common.Address, bool, error) {
+	addr := contracts.Multicall3()
+
+	code, err := client.CodeAt(ctx, addr, nil)
+	if err != nil {
+		return common.Address{}, false, errors.Wrap(err, "get multicall code")
+	}
+
+	return addr, len(code) > 0, nil
+}
+
+// encodeXSubmitBatch returns the Multicall3 aggregate3 calldata that submits all submissions
+// to the portal in order. Calls are allowed to fail, so a reverting submission doesn't revert the whole batch,
+// see failedSubmissions.
+func encodeXSubmitBatch(portal common.Address, subs []xchain.Submission) ([]byte, error) {
+	calls := make([]multicall3Call, 0, len(subs))
+	for _, sub := range subs {
+		callData, err := xchain.EncodeXSubmit(xchain.SubmissionToBinding(sub))
+		if err != nil {
+			return nil, err
+		}
+
+		calls = append(calls, multicall3Call{
+			Target:       portal,
+			AllowFailure: true,
+			CallData:     callData,
+		})
+	}
+
+	resp, err := multicallABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, errors.Wrap(err, "pack aggregate3")
+	}
+
+	return resp, nil
+}
+
+// decodeXSubmitBatch returns the per-call results of the Multicall3 aggregate3 return data.
+func decodeXSubmitBatch(data []byte) ([]multicall3Result, error) {
+	unpacked, err := multicallABI.Unpack("aggregate3", data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unpack aggregate3")
+	} else if len(unpacked) != 1 {
+		return nil, errors.New("unexpected aggregate3 outputs", "outputs", len(unpacked))
+	}
+
+	resp, ok := abi.ConvertType(unpacked[0], new([]multicall3Result)).(*[]multicall3Result)
+	if !ok {
+		return nil, errors.New("unexpected aggregate3 results type [BUG]")
+	}
+
+	return *resp, nil
+}
+
+// failedSubmissions returns the batched submissions that reverted, i.e., submissions with
+// msgs that the portal didn't emit xreceipts for. It returns nil for single submissions,
+// since their reverts are reported by the receipt status.
+func failedSubmissions(portal common.Address, rec *ethclient.Receipt, subs []xchain.Submission) []xchain.Submission {
+	if len(subs) <= 1 || rec.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	type msgKey struct {
+		SourceChainID uint64
+		ShardID       uint64
+		Offset        uint64
+	}
+
+	received := make(map[msgKey]bool)
+	for _, l := range rec.Logs {
+		if l.Address != portal {
+			continue
+		}
+
+		xreceipt, err := xreceiptFilterer.ParseXReceipt(*l)
+		if err != nil {
+			continue // Not an XReceipt event.
+		}
+
+		received[msgKey{
+			SourceChainID: xreceipt.SourceChainId,
+			ShardID:       xreceipt.ShardId,
+			Offset:        xreceipt.Offset,
+		}] = true
+	}
+
+	var resp []xchain.Submission
+	for _, sub := range subs {
+		for _, msg := range sub.Msgs {
+			if !received[msgKey{
+				SourceChainID: msg.SourceChainID,
+				ShardID:       uint64(msg.ShardID),
+				Offset:        msg.StreamOffset,
+			}] {
+				resp = append(resp, sub)
+				break
+			}
+		}
+	}
+
+	return resp
+}
+
+func mustGetABI(metadata *bind.MetaData) *abi.ABI {
+	abi, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+
+	return abi
+}
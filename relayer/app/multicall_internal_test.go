@@ -0,0 +1,120 @@
+package relayer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailedSubmissions(t *testing.T) {
+	t.Parallel()
+
+	portal := tutil.RandomAddress()
+	sub := func(srcChain uint64, offsets ...uint64) xchain.Submission {
+		var msgs []xchain.Msg
+		for _, offset := range offsets {
+			msgs = append(msgs, xchain.Msg{MsgID: xchain.MsgID{
+				StreamID:     xchain.StreamID{SourceChainID: srcChain, ShardID: xchain.ShardFinalized0},
+				StreamOffset: offset,
+			}})
+		}
+
+		return xchain.Submission{Msgs: msgs}
+	}
+
+	subs := []xchain.Submission{sub(1, 1, 2), sub(2, 1), sub(3, 5)}
+
+	rec := &ethclient.Receipt{Status: ethtypes.ReceiptStatusSuccessful}
+	rec.Logs = []*ethtypes.Log{
+		xreceiptLog(t, portal, 1, 1, nil),
+		xreceiptLog(t, portal, 1, 2, nil),
+		xreceiptLog(t, tutil.RandomAddress(), 2, 1, nil), // Not emitted by the portal
+		xreceiptLog(t, portal, 3, 5, nil),
+	}
+
+	require.Equal(t, []xchain.Submission{subs[1]}, failedSubmissions(portal, rec, subs))
+
+	// Single submissions and reverted transactions are reported by the receipt status.
+	require.Empty(t, failedSubmissions(portal, rec, subs[1:2]))
+	rec.Status = ethtypes.ReceiptStatusFailed
+	require.Empty(t, failedSubmissions(portal, rec, subs))
+}
+
+func TestDecodeXSubmitBatch(t *testing.T) {
+	t.Parallel()
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("OmniPortal: wrong offset")
+	require.NoError(t, err)
+	revertData := append(crypto.Keccak256([]byte("Error(string)"))[:4], reason...)
+
+	expected := []multicall3Result{
+		{Success: true, ReturnData: []byte{}},
+		{Success: false, ReturnData: revertData},
+	}
+	data, err := multicallABI.Methods["aggregate3"].Outputs.Pack(expected)
+	require.NoError(t, err)
+
+	results, err := decodeXSubmitBatch(data)
+	require.NoError(t, err)
+	require.Equal(t, expected, results)
+	require.Equal(t, "OmniPortal: wrong offset", decodeRevert(results[1].ReturnData))
+
+	_, err = decodeXSubmitBatch([]byte{1, 2, 3})
+	require.Error(t, err)
+}
+
+func TestBatchLimits(t *testing.T) {
+	t.Parallel()
+
+	msgs := []xchain.Msg{{DestGasLimit: 1_000_000}}
+	subs := []xchain.Submission{{Msgs: msgs}, {Msgs: msgs}, {Msgs: msgs}}
+
+	naive := func(uint64, []xchain.Msg) uint64 { return naiveSubmissionGas(msgs) }
+	proper := func(uint64, []xchain.Msg) uint64 { return properGasEstimation }
+
+	require.True(t, batchLimits{}.fits(subs[:1]))
+	require.False(t, batchLimits{MaxSize: 1, Estimator: naive}.fits(subs[:2]))
+	require.True(t, batchLimits{MaxSize: 3, Estimator: naive}.fits(subs))
+	require.False(t, batchLimits{MaxSize: 3, Estimator: proper}.fits(subs[:2]))
+
+	// Batches are limited to subGasMax.
+	large := []xchain.Msg{{DestGasLimit: subGasMax / 2}}
+	largeGas := func(uint64, []xchain.Msg) uint64 { return naiveSubmissionGas(large) }
+	require.False(t, batchLimits{MaxSize: 3, Estimator: largeGas}.fits(subs[:2]))
+}
+
+// xreceiptLog returns a portal XReceipt event log, reporting failed execution if execErr is non-nil.
+func xreceiptLog(t *testing.T, portal common.Address, srcChain uint64, offset uint64, execErr []byte) *ethtypes.Log {
+	t.Helper()
+
+	portalABI, err := bindings.OmniPortalMetaData.GetAbi()
+	require.NoError(t, err)
+
+	event := portalABI.Events["XReceipt"]
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(100), tutil.RandomAddress(), execErr == nil, execErr)
+	require.NoError(t, err)
+
+	return &ethtypes.Log{
+		Address: portal,
+		Topics: []common.Hash{
+			event.ID,
+			common.BigToHash(new(big.Int).SetUint64(srcChain)),
+			common.BigToHash(new(big.Int).SetUint64(uint64(xchain.ShardFinalized0))),
+			common.BigToHash(new(big.Int).SetUint64(offset)),
+		},
+		Data: data,
+	}
+}
@@ -38,24 +38,30 @@ func newTokenPricer(ctx context.Context) *tokens.CachedPricer {
 type pnlLogger struct {
 	network netconf.ID
 	pricer  tokens.Pricer
+	dryRun  bool // Logs would-be pnl of simulated transactions, excluded from spend metrics.
 }
 
 // newPnlLogger creates a new pnl logger.
-func newPnlLogger(network netconf.ID, pricer tokens.Pricer) pnlLogger {
-	return pnlLogger{network: network, pricer: pricer}
+func newPnlLogger(network netconf.ID, pricer tokens.Pricer, dryRun bool) pnlLogger {
+	return pnlLogger{network: network, pricer: pricer, dryRun: dryRun}
 }
 
 // log logs the pnl for an xsubmit transaction, warning on error.
-func (l pnlLogger) log(ctx context.Context, tx *ethtypes.Transaction, receipt *ethclient.Receipt, sub xchain.Submission) {
-	if err := l.logE(ctx, tx, receipt, sub); err != nil {
+func (l pnlLogger) log(ctx context.Context, tx *ethtypes.Transaction, receipt *ethclient.Receipt, subs []xchain.Submission, failed []xchain.Submission) {
+	if err := l.logE(ctx, tx, receipt, subs, failed); err != nil {
 		log.Warn(ctx, "Failed to log pnl", err)
 	}
 }
 
 // logE logs the pnl for an xsubmit transaction, returning any errors.
-func (l pnlLogger) logE(ctx context.Context, tx *ethtypes.Transaction, receipt *ethclient.Receipt, sub xchain.Submission) error {
-	srcChainID := sub.BlockHeader.ChainID
-	dstChainID := sub.DestChainID
+// The transaction spend is logged once, while income is logged per (possibly batched) submission
+// that didn't revert, i.e., excluding failed submissions of a batch.
+func (l pnlLogger) logE(ctx context.Context, tx *ethtypes.Transaction, receipt *ethclient.Receipt, subs []xchain.Submission, failed []xchain.Submission) error {
+	if len(subs) == 0 {
+		return errors.New("no submissions [BUG]")
+	}
+
+	dstChainID := subs[0].DestChainID
 
 	dest, ok := evmchain.MetadataByID(dstChainID)
 	if !ok {
@@ -63,7 +69,9 @@ func (l pnlLogger) logE(ctx context.Context, tx *ethtypes.Transaction, receipt *
 	}
 
 	spendGwei := totalSpendGwei(tx, receipt)
-	spendTotal.WithLabelValues(dest.Name, dest.NativeToken.Symbol).Add(spendGwei)
+	if !l.dryRun {
+		spendTotal.WithLabelValues(dest.Name, dest.NativeToken.Symbol).Add(spendGwei)
+	}
 
 	prices, err := l.pricer.Price(ctx, tokens.OMNI, tokens.ETH)
 	if err != nil {
@@ -77,11 +85,21 @@ func (l pnlLogger) logE(ctx context.Context, tx *ethtypes.Transaction, receipt *
 		return errors.Wrap(err, "get spend")
 	}
 
+	var numMsgs int
+	for _, sub := range subs {
+		numMsgs += len(sub.Msgs)
+	}
+
 	md := map[string]any{
-		"tx":       tx.Hash().Hex(),
-		"gas_used": receipt.GasUsed,
-		"status":   receipt.Status,
-		"num_msgs": len(sub.Msgs),
+		"tx":         tx.Hash().Hex(),
+		"gas_used":   receipt.GasUsed,
+		"status":     receipt.Status,
+		"num_msgs":   numMsgs,
+		"num_subs":   len(subs),
+		"num_failed": len(failed),
+	}
+	if l.dryRun {
+		md["dry_run"] = true
 	}
 
 	id := tx.Hash().Hex()
@@ -105,10 +123,38 @@ func (l pnlLogger) logE(ctx context.Context, tx *ethtypes.Transaction, receipt *
 		},
 	)
 
-	// do not log income if:
-	//	 - the submission failed (avoid double counting)
-	// 	 - source chain is omni consensus chain (no fees collected)
-	if netconf.IsOmniConsensus(l.network, srcChainID) || receipt.Status != ethtypes.ReceiptStatusSuccessful {
+	// do not log income if the submission failed (avoid double counting)
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil
+	}
+
+	reverted := make(map[xchain.MsgID]bool)
+	for _, sub := range failed {
+		for _, msg := range sub.Msgs {
+			reverted[msg.MsgID] = true
+		}
+	}
+
+	for _, sub := range subs {
+		// do not log income of batched submissions that reverted (avoid double counting)
+		if len(sub.Msgs) > 0 && reverted[sub.Msgs[0].MsgID] {
+			continue
+		}
+
+		if err := l.logIncome(ctx, id, md, sub, prices); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// logIncome logs the fees collected by a successful submission.
+func (l pnlLogger) logIncome(ctx context.Context, id string, md map[string]any, sub xchain.Submission, prices map[tokens.Token]float64) error {
+	srcChainID := sub.BlockHeader.ChainID
+
+	// do not log income if source chain is omni consensus chain (no fees collected)
+	if netconf.IsOmniConsensus(l.network, srcChainID) {
 		return nil
 	}
 
@@ -0,0 +1,81 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/pnl"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/xchain"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPnlPartlyFailedBatch(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ctx := log.WithLogger(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	sub := func(srcChainID uint64) xchain.Submission {
+		return xchain.Submission{
+			BlockHeader: xchain.BlockHeader{ChainID: srcChainID},
+			DestChainID: evmchain.IDOptimism,
+			Msgs: []xchain.Msg{{
+				MsgID: xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: srcChainID}, StreamOffset: 1},
+				Fees:  big.NewInt(1e9),
+			}},
+		}
+	}
+
+	subs := []xchain.Submission{sub(evmchain.IDEthereum), sub(evmchain.IDArbitrumOne), sub(evmchain.IDBase)}
+	failed := []xchain.Submission{subs[1]}
+
+	tx := ethtypes.NewTx(&ethtypes.DynamicFeeTx{})
+	rec := &ethclient.Receipt{
+		Status:            ethtypes.ReceiptStatusSuccessful,
+		GasUsed:           100_000,
+		EffectiveGasPrice: big.NewInt(1e9),
+	}
+
+	pricer := tokens.NewMockPricer(map[tokens.Token]float64{tokens.OMNI: 1, tokens.ETH: 1000})
+	l := newPnlLogger(netconf.Mainnet, pricer, false)
+	require.NoError(t, l.logE(ctx, tx, rec, subs, failed))
+
+	// Income is only logged for the successful submissions of the batch.
+	incomeChains := make(map[string]int)
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var entry struct {
+			Type  pnl.Type `json:"type"`
+			Chain string   `json:"chain"`
+		}
+		require.NoError(t, dec.Decode(&entry))
+		if entry.Type == pnl.Income {
+			incomeChains[entry.Chain]++
+		}
+	}
+
+	name := func(chainID uint64) string {
+		meta, ok := evmchain.MetadataByID(chainID)
+		require.True(t, ok)
+
+		return meta.Name
+	}
+
+	// One income log per currency
+	require.Equal(t, map[string]int{
+		name(evmchain.IDEthereum): 3,
+		name(evmchain.IDBase):     3,
+	}, incomeChains)
+}
@@ -0,0 +1,327 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+)
+
+// ProfitPolicy defines how the relayer gates submissions on predicted profitability.
+type ProfitPolicy string
+
+const (
+	// ProfitPolicyAlways sends all submissions regardless of profitability.
+	ProfitPolicyAlways ProfitPolicy = "always"
+	// ProfitPolicyRatio sends each submission only if its fees cover the min ratio of its predicted cost.
+	ProfitPolicyRatio ProfitPolicy = "ratio"
+	// ProfitPolicyBatch holds submissions per stream until the fees of the batch cover the min ratio of its predicted cost.
+	ProfitPolicyBatch ProfitPolicy = "batch"
+)
+
+// Verify returns an error if the policy is not supported.
+func (p ProfitPolicy) Verify() error {
+	switch p {
+	case ProfitPolicyAlways, ProfitPolicyRatio, ProfitPolicyBatch:
+		return nil
+	default:
+		return errors.New("invalid profit policy", "policy", p)
+	}
+}
+
+// Profit gate decisions, used as metric labels and in logs.
+const (
+	decisionSend    = "send"    // Predicted profitable (or policy always).
+	decisionHold    = "hold"    // Predicted unprofitable, held until profitable or expired.
+	decisionExpired = "expired" // Held longer than max delay, sent regardless of profitability.
+	decisionExempt  = "exempt"  // Consensus chain submissions (no fees) are always sent.
+	decisionError   = "error"   // Prediction failed, sent regardless of profitability.
+)
+
+// profitGate predicts the profitability of submissions before sending them.
+// The zero value always sends.
+type profitGate struct {
+	policy    ProfitPolicy
+	minRatio  float64 // Minimum fees to cost ratio (in percent) required to send.
+	maxDelay  time.Duration
+	batched   bool // Submissions are batched in a single transaction, otherwise sent one transaction each.
+	network   netconf.ID
+	dest      evmchain.Metadata
+	estimator gasEstimator
+	gasPrice  func(context.Context) (*big.Int, error)
+	pricer    tokens.Pricer
+}
+
+// predict returns the predicted fees collected and cost spent (both in nano USD)
+// by sending the submissions in a single batched transaction, or one transaction each if not batched.
+func (g profitGate) predict(ctx context.Context, subs []xchain.Submission) (float64, float64, error) {
+	gasPrice, err := g.gasPrice(ctx)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get gas price")
+	}
+
+	var gas uint64
+	if g.batched {
+		gas = g.batchGas(subs)
+	} else {
+		for _, sub := range subs {
+			gas += g.batchGas([]xchain.Submission{sub})
+		}
+	}
+
+	prices, err := g.pricer.Price(ctx, tokens.OMNI, tokens.ETH)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get prices")
+	}
+
+	spendGwei := toGwei(new(big.Int).Mul(gasPrice, umath.NewBigInt(gas)))
+	spend, err := spendByDenom(g.dest, spendGwei, prices)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "get spend")
+	}
+
+	var fees float64
+	for _, sub := range subs {
+		src, ok := evmchain.MetadataByID(sub.BlockHeader.ChainID)
+		if !ok {
+			return 0, 0, errors.New("unknown source chain ID", "chain_id", sub.BlockHeader.ChainID)
+		}
+
+		fee, err := feeByDenom(src, sub, prices)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "get fees")
+		}
+
+		fees += fee.nUSD
+	}
+
+	return fees, spend.nUSD, nil
+}
+
+// batchGas returns the predicted gas of sending the submissions in a single transaction.
+func (g profitGate) batchGas(subs []xchain.Submission) uint64 {
+	gas := batchGas(g.estimator, g.dest.ChainID, subs)
+	if gas == properGasEstimation {
+		return naiveBatchGas(subs) // Rather overestimate than skip the prediction.
+	}
+
+	return gas
+}
+
+// enabled returns true if the gate may hold submissions, i.e., the policy isn't always.
+func (g profitGate) enabled() bool {
+	return g.policy != "" && g.policy != ProfitPolicyAlways
+}
+
+// decide returns the decision for sending the held submissions of a stream, and the number of submissions to send.
+func (g profitGate) decide(ctx context.Context, held []heldSubmission) (string, int, float64) {
+	if !g.enabled() {
+		return decisionSend, len(held), 0
+	} else if netconf.IsOmniConsensus(g.network, held[0].Sub.BlockHeader.ChainID) {
+		return decisionExempt, len(held), 0
+	} else if g.maxDelay > 0 && time.Since(held[0].Since) > g.maxDelay {
+		return decisionExpired, len(held), 0
+	}
+
+	// Ratio policy evaluates the oldest submission by itself, batch policy evaluates all held submissions together.
+	n := 1
+	if g.policy == ProfitPolicyBatch {
+		n = len(held)
+	}
+
+	subs := make([]xchain.Submission, 0, n)
+	for _, h := range held[:n] {
+		subs = append(subs, h.Sub)
+	}
+
+	fees, cost, err := g.predict(ctx, subs)
+	if err != nil {
+		log.Warn(ctx, "Failed predicting submission profitability (will send)", err)
+		return decisionError, n, 0
+	} else if cost <= 0 {
+		return decisionSend, n, 0
+	}
+
+	ratio := fees / cost * 100
+	if ratio < g.minRatio {
+		return decisionHold, 0, ratio
+	}
+
+	return decisionSend, n, ratio
+}
+
+// heldSubmission is a submission held by the profit gate.
+type heldSubmission struct {
+	Sub   xchain.Submission
+	Since time.Time
+}
+
+// gatedInput holds unprofitable submissions per stream before forwarding them to the next input function.
+// Submissions of a stream are always forwarded in order.
+// Streams are locked individually, so a stream blocked on a full buffer queue doesn't block other streams.
+// It is scoped to a single worker run, since held submissions are replayed from the cursors after a reset.
+type gatedInput struct {
+	gate       profitGate
+	next       func(context.Context, xchain.Submission) error
+	streamName func(xchain.StreamID) string
+
+	mu      sync.Mutex
+	streams map[xchain.StreamID]*gatedStream
+}
+
+// gatedStream is the held submissions of a single stream.
+type gatedStream struct {
+	mu   sync.Mutex
+	held []heldSubmission
+}
+
+// Wrap returns a new gated input forwarding submissions to next.
+func (g profitGate) Wrap(next func(context.Context, xchain.Submission) error, streamName func(xchain.StreamID) string) *gatedInput {
+	return &gatedInput{
+		gate:       g,
+		next:       next,
+		streamName: streamName,
+		streams:    make(map[xchain.StreamID]*gatedStream),
+	}
+}
+
+// stream returns the (possibly new) gated stream.
+func (i *gatedInput) stream(id xchain.StreamID) *gatedStream {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	s, ok := i.streams[id]
+	if !ok {
+		s = new(gatedStream)
+		i.streams[id] = s
+	}
+
+	return s
+}
+
+// AddInput adds the submission to its stream's held queue and forwards the queue if profitable.
+func (i *gatedInput) AddInput(ctx context.Context, sub xchain.Submission) error {
+	id := submissionStream(sub)
+	s := i.stream(id)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.held = append(s.held, heldSubmission{Sub: sub, Since: time.Now()})
+
+	return i.maybeForwardUnsafe(ctx, id, s)
+}
+
+// Run periodically re-evaluates held submissions until the context is canceled.
+// This ensures held submissions are sent once prices change or the max delay is exceeded.
+func (i *gatedInput) Run(ctx context.Context) error {
+	if !i.gate.enabled() {
+		return nil // Nothing is ever held.
+	}
+
+	period := max(i.gate.maxDelay/10, time.Second)
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	errs := make(chan error, 1)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			return err
+		case <-ticker.C:
+			i.forwardAll(ctx, errs)
+		}
+	}
+}
+
+// forwardAll asynchronously re-evaluates the held submissions of all streams, reporting the first error to errs.
+// Streams that are busy forwarding are skipped, they are re-evaluated on the next tick.
+func (i *gatedInput) forwardAll(ctx context.Context, errs chan<- error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for id, s := range i.streams {
+		if !s.mu.TryLock() {
+			continue
+		}
+
+		go func() {
+			defer s.mu.Unlock()
+
+			if err := i.maybeForwardUnsafe(ctx, id, s); err != nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+		}()
+	}
+}
+
+// maybeForwardUnsafe forwards the held submissions of the stream that the gate decides to send.
+// It is unsafe since it assumes the stream lock is held.
+func (i *gatedInput) maybeForwardUnsafe(ctx context.Context, id xchain.StreamID, s *gatedStream) error {
+	streamName := i.streamName(id)
+	ctx = log.WithCtx(ctx, "stream", streamName)
+
+	for len(s.held) > 0 {
+		held := s.held
+
+		decision, n, ratio := i.gate.decide(ctx, held)
+		profitDecisions.WithLabelValues(streamName, decision).Inc()
+		if ratio > 0 {
+			profitRatio.WithLabelValues(streamName).Set(ratio)
+		}
+
+		if decision == decisionHold {
+			heldSubmissions.WithLabelValues(streamName).Set(float64(len(held)))
+			log.Debug(ctx, "Holding unprofitable submissions",
+				"held", len(held),
+				"ratio_pct", ratio,
+				"min_ratio_pct", i.gate.minRatio,
+				"held_for", time.Since(held[0].Since).Truncate(time.Second),
+			)
+
+			return nil
+		} else if decision != decisionSend {
+			log.Info(ctx, "Sending submissions regardless of profitability", "decision", decision, "count", n)
+		} else if i.gate.enabled() {
+			log.Debug(ctx, "Sending profitable submissions", "count", n, "ratio_pct", ratio)
+		}
+
+		for _, h := range held[:n] {
+			if err := i.next(ctx, h.Sub); err != nil {
+				return err
+			}
+		}
+
+		s.held = held[n:]
+		heldSubmissions.WithLabelValues(streamName).Set(float64(len(s.held)))
+	}
+
+	return nil
+}
+
+// submissionStream returns the stream ID of the submission.
+func submissionStream(sub xchain.Submission) xchain.StreamID {
+	var shard xchain.ShardID
+	if len(sub.Msgs) > 0 {
+		shard = sub.Msgs[0].ShardID
+	}
+
+	return xchain.StreamID{
+		SourceChainID: sub.BlockHeader.ChainID,
+		DestChainID:   sub.DestChainID,
+		ShardID:       shard,
+	}
+}
@@ -0,0 +1,162 @@
+package relayer
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/umath"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfitGateDecide(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	dest, ok := evmchain.MetadataByID(evmchain.IDMockL1)
+	require.True(t, ok)
+
+	newGate := func(policy ProfitPolicy, unbatched bool) profitGate {
+		return profitGate{
+			policy:    policy,
+			minRatio:  100,
+			maxDelay:  time.Minute,
+			batched:   !unbatched,
+			network:   netconf.Devnet,
+			dest:      dest,
+			estimator: newGasEstimator(netconf.Devnet),
+			gasPrice: func(context.Context) (*big.Int, error) {
+				return big.NewInt(params.GWei), nil
+			},
+			pricer: tokens.NewMockPricer(map[tokens.Token]float64{tokens.ETH: 1, tokens.OMNI: 1}),
+		}
+	}
+
+	// held returns a submission from mock_l2 with a single xmsg paying the provided fees (in gwei).
+	held := func(feesGwei uint64, since time.Time) heldSubmission {
+		return heldSubmission{
+			Sub: xchain.Submission{
+				BlockHeader: xchain.BlockHeader{ChainID: evmchain.IDMockL2},
+				DestChainID: evmchain.IDMockL1,
+				Msgs: []xchain.Msg{{
+					MsgID: xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: evmchain.IDMockL2}},
+					Fees:  new(big.Int).Mul(umath.NewBigInt(feesGwei), umath.NewBigInt(params.GWei)),
+				}},
+			},
+			Since: since,
+		}
+	}
+
+	now := time.Now()
+	single := subGasBase + subGasXmsgOverhead          // 600k gwei cost at 1 gwei gas price.
+	second := subGasBatchOverhead + subGasXmsgOverhead // 450k gwei additional cost when batched.
+	unprofitable := held(single/2, now)                // 50% ratio by itself.
+	profitable := held(single+second, now)             // Covers both itself and the unprofitable one when batched.
+	expired := held(single/2, now.Add(-2*time.Minute)) // Unprofitable, but held longer than max delay.
+	consensus := held(0, now)                          // No fees, but exempt.
+	consensus.Sub.BlockHeader.ChainID = netconf.Devnet.Static().OmniConsensusChainIDUint64()
+
+	tests := []struct {
+		name      string
+		policy    ProfitPolicy
+		unbatched bool // Submissions are sent one transaction each.
+		held      []heldSubmission
+		decision  string
+		count     int
+	}{
+		{name: "always", policy: ProfitPolicyAlways, held: []heldSubmission{unprofitable, unprofitable}, decision: decisionSend, count: 2},
+		{name: "zero", policy: "", held: []heldSubmission{unprofitable}, decision: decisionSend, count: 1},
+		{name: "ratio hold", policy: ProfitPolicyRatio, held: []heldSubmission{unprofitable, profitable}, decision: decisionHold, count: 0},
+		{name: "ratio send", policy: ProfitPolicyRatio, held: []heldSubmission{profitable, unprofitable}, decision: decisionSend, count: 1},
+		{name: "batch send", policy: ProfitPolicyBatch, held: []heldSubmission{unprofitable, profitable}, decision: decisionSend, count: 2},
+		{name: "batch hold", policy: ProfitPolicyBatch, held: []heldSubmission{unprofitable, unprofitable}, decision: decisionHold, count: 0},
+		{name: "batch send three", policy: ProfitPolicyBatch, held: []heldSubmission{unprofitable, unprofitable, profitable}, decision: decisionSend, count: 3},
+		{name: "batch unbatched hold", policy: ProfitPolicyBatch, unbatched: true, held: []heldSubmission{unprofitable, unprofitable, profitable}, decision: decisionHold, count: 0},
+		{name: "expired", policy: ProfitPolicyBatch, held: []heldSubmission{expired, unprofitable}, decision: decisionExpired, count: 2},
+		{name: "exempt", policy: ProfitPolicyRatio, held: []heldSubmission{consensus}, decision: decisionExempt, count: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			decision, count, _ := newGate(test.policy, test.unbatched).decide(ctx, test.held)
+			require.Equal(t, test.decision, decision)
+			require.Equal(t, test.count, count)
+		})
+	}
+}
+
+func TestGatedInputStreamIsolation(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	// All submissions expire immediately, so the gate forwards them regardless of profitability.
+	gate := profitGate{policy: ProfitPolicyBatch, maxDelay: time.Nanosecond, network: netconf.Devnet}
+
+	buffer := newActiveBuffer("test", 1, batchLimits{}, nil, bufferPolicy{}, testStreamName) // Not running, so queues fill up.
+	input := gate.Wrap(buffer.AddInput, testStreamName)
+
+	sub := func(srcChainID uint64) xchain.Submission {
+		return xchain.Submission{
+			BlockHeader: xchain.BlockHeader{ChainID: srcChainID},
+			DestChainID: evmchain.IDMockL1,
+			Msgs:        []xchain.Msg{{MsgID: xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: srcChainID}}}},
+		}
+	}
+
+	// Fill stream A's queue.
+	for range streamQueueLimit {
+		require.NoError(t, input.AddInput(ctx, sub(evmchain.IDMockL2)))
+	}
+
+	// Adding another submission to stream A blocks on its full queue.
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- input.AddInput(ctx, sub(evmchain.IDMockL2))
+	}()
+
+	// Stream B and the periodic re-evaluation are not blocked by stream A.
+	done := make(chan error, 1)
+	go func() {
+		input.forwardAll(ctx, make(chan error, 1))
+		done <- input.AddInput(ctx, sub(evmchain.IDMockOp))
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "stream B blocked by stream A")
+	}
+
+	select {
+	case <-blocked:
+		require.Fail(t, "stream A not blocked")
+	default:
+	}
+
+	// Draining stream A unblocks it.
+	buffer.mu.Lock()
+	q := buffer.queues[submissionStream(sub(evmchain.IDMockL2))]
+	buffer.mu.Unlock()
+	<-q.slots
+
+	require.NoError(t, <-blocked)
+}
+
+func TestProfitPolicyVerify(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ProfitPolicyAlways.Verify())
+	require.NoError(t, ProfitPolicyRatio.Verify())
+	require.NoError(t, ProfitPolicyBatch.Verify())
+	require.Error(t, ProfitPolicy("never").Verify())
+}
@@ -0,0 +1,208 @@
+package relayer
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// failureReason classifies submission failures, used as metric label.
+type failureReason string
+
+const (
+	reasonSendError     failureReason = "send_error"     // Failed sending the transaction, e.g. RPC errors
+	reasonBadSigs       failureReason = "bad_signatures" // Invalid signatures or no quorum
+	reasonWrongOffset   failureReason = "wrong_offset"   // Msgs already submitted or out of order
+	reasonOutOfGas      failureReason = "out_of_gas"     // Gas limit too low
+	reasonValSetUnknown failureReason = "valset_not_found"
+	reasonValSetOld     failureReason = "valset_too_old"
+	reasonInvalidProof  failureReason = "invalid_proof"
+	reasonPaused        failureReason = "paused"
+	reasonUnknown       failureReason = "unknown"
+)
+
+// revertReasons maps known portal revert reasons (and custom error names) to failure reasons.
+//
+//nolint:gochecknoglobals // Static mapping.
+var revertReasons = map[string]failureReason{
+	"OmniPortal: wrong offset":        reasonWrongOffset,
+	"OmniPortal: unknown val set":     reasonValSetUnknown,
+	"OmniPortal: old val set":         reasonValSetOld,
+	"OmniPortal: no quorum":           reasonBadSigs,
+	"Quorum: invalid signature":       reasonBadSigs,
+	"Quorum: sigs not deduped/sorted": reasonBadSigs,
+	"ECDSAInvalidSignature":           reasonBadSigs,
+	"ECDSAInvalidSignatureLength":     reasonBadSigs,
+	"ECDSAInvalidSignatureS":          reasonBadSigs,
+	"OmniPortal: invalid proof":       reasonInvalidProof,
+	"MerkleProofInvalidMultiproof":    reasonInvalidProof,
+	"OmniPortal: paused":              reasonPaused,
+}
+
+//nolint:gochecknoglobals // Static ABI used to decode custom errors.
+var portalABI = mustGetABI(bindings.OmniPortalMetaData)
+
+// classifyRevert returns the failure reason of a reverted submission given its
+// decoded revert reason, gas used and gas limit.
+func classifyRevert(revert string, gasUsed, gasLimit uint64) failureReason {
+	if reason, ok := revertReasons[revert]; ok {
+		return reason
+	} else if gasUsed >= gasLimit || strings.Contains(revert, "out of gas") {
+		return reasonOutOfGas
+	}
+
+	return reasonUnknown
+}
+
+// submissionError is returned by the sender when submissions fail.
+// It allows the worker to take recovery actions specific to the failure reason.
+type submissionError struct {
+	Reason   failureReason
+	ValSetID uint64 // Highest validator set ID of the failed submissions
+	err      error
+}
+
+func (e submissionError) Error() string {
+	return e.err.Error()
+}
+
+func (e submissionError) Unwrap() error {
+	return e.err
+}
+
+// revertReason returns the decoded revert reason if the error is an execution revert, or false otherwise.
+// Portal custom errors are decoded to their names.
+func revertReason(err error) (string, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		if strings.Contains(err.Error(), "execution reverted") || strings.Contains(err.Error(), "out of gas") {
+			return err.Error(), true
+		}
+
+		return "", false
+	}
+
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return dataErr.Error(), true
+	}
+
+	data, err := hexutil.Decode(hexData)
+	if err != nil {
+		return hexData, true
+	}
+
+	return decodeRevert(data), true
+}
+
+// decodeRevert returns the revert reason of the revert data: either the Error(string) reason,
+// the name of a portal custom error, or the hex encoded data if unknown.
+func decodeRevert(data []byte) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+
+	if len(data) >= 4 {
+		for name, abiErr := range portalABI.Errors {
+			if [4]byte(abiErr.ID[:4]) == [4]byte(data[:4]) {
+				return name
+			}
+		}
+	}
+
+	return hexutil.Encode(data)
+}
+
+// failedXMsg is a delivered xmsg whose execution on the destination chain failed.
+type failedXMsg struct {
+	SourceChainID uint64
+	ShardID       uint64
+	Offset        uint64
+	Reason        string // Decoded revert reason of the xmsg execution
+}
+
+// failedXMsgs returns the xmsgs that the portal reported as failed via XReceipt events,
+// with their decoded execution revert reasons.
+func failedXMsgs(portal common.Address, rec *ethclient.Receipt) []failedXMsg {
+	var resp []failedXMsg
+	for _, l := range rec.Logs {
+		if l.Address != portal {
+			continue
+		}
+
+		xreceipt, err := xreceiptFilterer.ParseXReceipt(*l)
+		if err != nil || xreceipt.Success {
+			continue // Not an XReceipt event or successful execution.
+		}
+
+		resp = append(resp, failedXMsg{
+			SourceChainID: xreceipt.SourceChainId,
+			ShardID:       xreceipt.ShardId,
+			Offset:        xreceipt.Offset,
+			Reason:        decodeRevert(xreceipt.Err),
+		})
+	}
+
+	return resp
+}
+
+const (
+	gasBoostStep = 25  // Gas limit boost percentage increase per out-of-gas failure
+	gasBoostMax  = 100 // Maximum gas limit boost percentage
+)
+
+// gasBoost increases submission gas limits after out-of-gas failures.
+// It is shared by all senders of a destination chain and decays by one percent per successful submission.
+type gasBoost struct {
+	mu      sync.Mutex
+	percent uint64
+}
+
+// Apply returns the boosted gas limit. It is safe to call on a nil boost.
+func (b *gasBoost) Apply(gas uint64) uint64 {
+	if b == nil {
+		return gas
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return gas + gas*b.percent/100
+}
+
+// Raise increases the boost after an out-of-gas failure.
+func (b *gasBoost) Raise(ctx context.Context) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.percent = min(b.percent+gasBoostStep, gasBoostMax)
+	log.Warn(ctx, "Submission out of gas, raising gas limits", nil, "boost_percent", b.percent)
+}
+
+// Decay decreases the boost after a successful submission.
+func (b *gasBoost) Decay() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.percent > 0 {
+		b.percent--
+	}
+}
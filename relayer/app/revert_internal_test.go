@@ -0,0 +1,140 @@
+package relayer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/tutil"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevertReason(t *testing.T) {
+	t.Parallel()
+
+	// Error(string) reverts
+	reason, ok := revertReason(newRevertErr("OmniPortal: wrong offset"))
+	require.True(t, ok)
+	require.Equal(t, "OmniPortal: wrong offset", reason)
+
+	// Portal custom errors
+	customErr := portalABI.Errors["ECDSAInvalidSignature"]
+	reason, ok = revertReason(revertDataErr{data: hexutil.Encode(customErr.ID[:4])})
+	require.True(t, ok)
+	require.Equal(t, "ECDSAInvalidSignature", reason)
+
+	// Unknown custom errors
+	reason, ok = revertReason(revertDataErr{data: "0x01020304"})
+	require.True(t, ok)
+	require.Equal(t, "0x01020304", reason)
+
+	// Reverts without data
+	reason, ok = revertReason(errors.New("out of gas"))
+	require.True(t, ok)
+	require.Equal(t, "out of gas", reason)
+
+	// Other errors
+	_, ok = revertReason(errors.New("connection refused"))
+	require.False(t, ok)
+}
+
+func TestClassifyRevert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		Revert   string
+		GasUsed  uint64
+		Expected failureReason
+	}{
+		{Revert: "OmniPortal: wrong offset", GasUsed: 1, Expected: reasonWrongOffset},
+		{Revert: "OmniPortal: unknown val set", GasUsed: 1, Expected: reasonValSetUnknown},
+		{Revert: "OmniPortal: no quorum", GasUsed: 1, Expected: reasonBadSigs},
+		{Revert: "ECDSAInvalidSignature", GasUsed: 1, Expected: reasonBadSigs},
+		{Revert: "out of gas", GasUsed: 1, Expected: reasonOutOfGas},
+		{Revert: "", GasUsed: 100, Expected: reasonOutOfGas},
+		{Revert: "", GasUsed: 1, Expected: reasonUnknown},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.Expected, classifyRevert(test.Revert, test.GasUsed, 100), test.Revert)
+	}
+}
+
+func TestFailedXMsgs(t *testing.T) {
+	t.Parallel()
+
+	portal := tutil.RandomAddress()
+	typ, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	packed, err := abi.Arguments{{Type: typ}}.Pack("insufficient balance")
+	require.NoError(t, err)
+	reverted := append(crypto.Keccak256([]byte("Error(string)"))[:4], packed...)
+
+	rec := &ethclient.Receipt{Status: ethtypes.ReceiptStatusSuccessful}
+	rec.Logs = []*ethtypes.Log{
+		xreceiptLog(t, portal, 1, 1, nil),
+		xreceiptLog(t, portal, 1, 2, reverted),
+		xreceiptLog(t, portal, 2, 1, []byte{}),
+		xreceiptLog(t, tutil.RandomAddress(), 3, 1, reverted), // Not emitted by the portal
+	}
+
+	require.Equal(t, []failedXMsg{
+		{SourceChainID: 1, ShardID: uint64(xchain.ShardFinalized0), Offset: 2, Reason: "insufficient balance"},
+		{SourceChainID: 2, ShardID: uint64(xchain.ShardFinalized0), Offset: 1, Reason: "0x"},
+	}, failedXMsgs(portal, rec))
+}
+
+func TestGasBoost(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	var nilBoost *gasBoost
+	require.EqualValues(t, 100, nilBoost.Apply(100))
+
+	boost := new(gasBoost)
+	require.EqualValues(t, 100, boost.Apply(100))
+
+	boost.Raise(ctx)
+	require.EqualValues(t, 125, boost.Apply(100))
+
+	for range 10 {
+		boost.Raise(ctx)
+	}
+	require.EqualValues(t, 200, boost.Apply(100))
+
+	boost.Decay()
+	require.EqualValues(t, 199, boost.Apply(100))
+}
+
+func TestWorkerRecover(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	var awaited []uint64
+	w := &Worker{awaitValSet: func(_ context.Context, valsetID uint64) error {
+		awaited = append(awaited, valsetID)
+		return nil
+	}}
+
+	wrap := func(reason failureReason) error {
+		return errors.Wrap(submissionError{Reason: reason, ValSetID: 7, err: errors.New("failed")}, "send submission")
+	}
+
+	require.True(t, w.recover(ctx, wrap(reasonWrongOffset)))
+	require.True(t, w.recover(ctx, wrap(reasonOutOfGas)))
+	require.Empty(t, awaited)
+
+	require.True(t, w.recover(ctx, wrap(reasonValSetUnknown)))
+	require.Equal(t, []uint64{7}, awaited)
+
+	require.False(t, w.recover(ctx, wrap(reasonBadSigs)))
+	require.False(t, w.recover(ctx, errors.New("other")))
+}
@@ -2,7 +2,6 @@ package relayer
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"math/big"
 	"slices"
 	"strings"
@@ -15,7 +14,9 @@ import (
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/tokens"
 	"github.com/omni-network/omni/lib/txmgr"
+	"github.com/omni-network/omni/lib/umath"
 	"github.com/omni-network/omni/lib/xchain"
+	"github.com/omni-network/omni/relayer/app/gasmodel"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -24,8 +25,9 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
-// Sender uses txmgr to send transactions a specific destination chain.
-type onSubmitFunc func(context.Context, *ethtypes.Transaction, *ethclient.Receipt, xchain.Submission)
+// onSubmitFunc is called with each mined transaction, the submissions it contains and
+// those of them that reverted within a successful batch.
+type onSubmitFunc func(ctx context.Context, tx *ethtypes.Transaction, rec *ethclient.Receipt, subs []xchain.Submission, failed []xchain.Submission)
 
 // Sender uses txmgr to send transactions to a specific destination chain.
 type Sender struct {
@@ -38,32 +40,47 @@ type Sender struct {
 	chainNames   map[xchain.ChainVersion]string
 	ethCl        ethclient.Client
 	onSubmit     onSubmitFunc
+	multicall    *common.Address // Multicall3 address used to batch submissions, nil if batching is disabled or not deployed.
+	gasModel     *gasmodel.Store // Learned gas model, nil if disabled.
+	simulator    *simulator      // Simulates instead of sending transactions if dry-run, nil otherwise.
+	gasBoost     *gasBoost       // Raises gas limits after out-of-gas failures, shared by senders of the chain.
 }
 
 // NewSender returns a new sender.
 func NewSender(
+	ctx context.Context,
 	network netconf.ID,
 	chain netconf.Chain,
 	rpcClient ethclient.Client,
-	privateKey ecdsa.PrivateKey,
+	key senderKey,
 	chainNames map[xchain.ChainVersion]string,
 	onSubmit onSubmitFunc,
+	journal *txmgr.Journal,
+	gasModel *gasmodel.Store,
+	boost *gasBoost,
+	dryRun bool,
+	batch bool,
 ) (Sender, error) {
 	const receiptPollFreq = 3 // Query receipts every 1/3 of the block time
-	cfg, err := txmgr.NewConfig(
+	cfg, err := key.txMgrConfig(
 		txmgr.NewCLIConfig(
 			chain.ID,
 			chain.BlockPeriod/receiptPollFreq,
 			txmgr.DefaultSenderFlagValues,
 		),
-		&privateKey,
 		rpcClient,
 	)
 	if err != nil {
 		return Sender{}, err
 	}
 
-	txMgr, err := txmgr.NewSimple(chain.Name, cfg)
+	var txMgr txmgr.TxManager
+	if dryRun {
+		// Dry-run doesn't resume journaled transactions.
+		txMgr, err = txmgr.NewSimple(chain.Name, cfg)
+	} else {
+		txMgr, err = txmgr.NewSimpleWithJournal(ctx, chain.Name, cfg, journal)
+	}
 	if err != nil {
 		return Sender{}, errors.Wrap(err, "create tx mgr")
 	}
@@ -79,6 +96,25 @@ func NewSender(
 		return Sender{}, errors.New("chain metadata not found", "chain_id", chain.ID)
 	}
 
+	var multicall *common.Address
+	if !batch { //nolint:revive // Empty block skips multicall detection below.
+		// Batching disabled.
+	} else if addr, ok, err := multicallAddress(ctx, rpcClient); err != nil {
+		return Sender{}, err
+	} else if ok {
+		multicall = &addr
+	}
+
+	var sim *simulator
+	if dryRun {
+		sim = &simulator{
+			ethCl:   rpcClient,
+			chainID: chain.ID,
+			from:    cfg.From,
+			privKey: key.privKey,
+		}
+	}
+
 	return Sender{
 		network:      network,
 		txMgr:        txMgr,
@@ -89,14 +125,51 @@ func NewSender(
 		chainNames:   chainNames,
 		ethCl:        rpcClient,
 		onSubmit:     onSubmit,
+		multicall:    multicall,
+		gasModel:     gasModel,
+		simulator:    sim,
+		gasBoost:     boost,
 	}, nil
 }
 
-// SendAsync sends the submission to the destination chain asynchronously.
-// It returns a channel that will receive an error if the submission fails or nil when it succeeds.
+// SendAsync sends the submissions to the destination chain asynchronously in a single transaction.
+// Multiple submissions are batched via Multicall3, or sent as separate transactions if it isn't deployed.
+// It returns a channel that will receive an error if the submissions fail or nil when they succeed.
 // Nonces are however reserved synchronously, so ordering of submissions
 // is preserved.
-func (s Sender) SendAsync(ctx context.Context, sub xchain.Submission) <-chan error {
+func (s Sender) SendAsync(ctx context.Context, subs ...xchain.Submission) <-chan error {
+	if len(subs) <= 1 || s.multicall != nil {
+		return s.sendAsync(ctx, subs)
+	}
+
+	// Multicall not available, send each submission as a separate transaction.
+	var resps []<-chan error
+	for _, sub := range subs {
+		resps = append(resps, s.sendAsync(ctx, []xchain.Submission{sub}))
+	}
+
+	return firstErr(resps)
+}
+
+// firstErr returns a channel that will receive the first error of all async responses
+// or nil when they all succeed.
+func firstErr(resps []<-chan error) <-chan error {
+	asyncResp := make(chan error, 1)
+	go func() {
+		var first error
+		for _, resp := range resps {
+			if err := <-resp; err != nil && first == nil {
+				first = err
+			}
+		}
+		asyncResp <- first
+	}()
+
+	return asyncResp
+}
+
+// sendAsync sends the submissions to the destination chain asynchronously in a single transaction.
+func (s Sender) sendAsync(ctx context.Context, subs []xchain.Submission) <-chan error {
 	// Helper function to return error "synchronously".
 	returnErr := func(err error) chan error {
 		resp := make(chan error, 1)
@@ -106,50 +179,83 @@ func (s Sender) SendAsync(ctx context.Context, sub xchain.Submission) <-chan err
 	}
 
 	if s.txMgr == nil {
-		return returnErr(errors.New("tx mgr not found [BUG]", "dest_chain_id", sub.DestChainID))
-	} else if sub.DestChainID != s.chain.ID {
-		return returnErr(errors.New("unexpected destination chain [BUG]",
-			"got", sub.DestChainID, "expect", s.chain.ID))
+		return returnErr(errors.New("tx mgr not found [BUG]", "dest_chain_id", s.chain.ID))
+	} else if len(subs) == 0 {
+		return returnErr(errors.New("no submissions [BUG]"))
 	}
 
-	// Get some info for logging
-	var startOffset uint64
-	if len(sub.Msgs) > 0 {
-		startOffset = sub.Msgs[0].StreamOffset
+	for _, sub := range subs {
+		if sub.DestChainID != s.chain.ID {
+			return returnErr(errors.New("unexpected destination chain [BUG]",
+				"got", sub.DestChainID, "expect", s.chain.ID))
+		}
 	}
 
 	dstChain := s.chain.Name
-	srcChain := s.chainNames[sub.AttHeader.ChainVersion]
+	srcChains := make([]string, 0, len(subs))
+	var numMsgs int
+	for _, sub := range subs {
+		srcChains = append(srcChains, s.chainNames[sub.AttHeader.ChainVersion])
+		numMsgs += len(sub.Msgs)
+	}
 
 	// Request attributes added to context (for downstream logging) and manually added to errors (for upstream logging).
 	reqAttrs := []any{
 		"req_id", randomHex7(),
-		"src_chain", srcChain,
+		"src_chain", strings.Join(srcChains, ","),
 	}
 
 	ctx = log.WithCtx(ctx, reqAttrs...)
-	log.Debug(ctx, "Received submission",
-		"attest_offset", sub.AttHeader.AttestOffset,
-		"start_msg_offset", startOffset,
-		"msgs", len(sub.Msgs),
-	)
+	for _, sub := range subs {
+		// Get some info for logging
+		var startOffset uint64
+		if len(sub.Msgs) > 0 {
+			startOffset = sub.Msgs[0].StreamOffset
+		}
+
+		log.Debug(ctx, "Received submission",
+			"attest_offset", sub.AttHeader.AttestOffset,
+			"start_msg_offset", startOffset,
+			"msgs", len(sub.Msgs),
+		)
+	}
 
-	txData, err := xchain.EncodeXSubmit(xchain.SubmissionToBinding(sub))
+	to := s.chain.PortalAddress
+	var txData []byte
+	var err error
+	if len(subs) == 1 {
+		txData, err = xchain.EncodeXSubmit(xchain.SubmissionToBinding(subs[0]))
+	} else if s.multicall == nil {
+		return returnErr(errors.New("multicall not available [BUG]"))
+	} else {
+		to = *s.multicall
+		txData, err = encodeXSubmitBatch(s.chain.PortalAddress, subs)
+	}
 	if err != nil {
 		return returnErr(err)
 	}
 
+	features := gasFeatures(subs, txData)
+	estimatedGas := s.gasBoost.Apply(s.estimateGas(ctx, subs, features))
+
+	if s.simulator != nil {
+		return s.simulateAsync(ctx, subs, txmgr.TxCandidate{
+			TxData:   txData,
+			To:       &to,
+			GasLimit: estimatedGas,
+			Value:    big.NewInt(0),
+		}, srcChains, reqAttrs)
+	}
+
 	// Reserve a nonce here to ensure correctly ordered submissions.
 	nonce, err := s.txMgr.ReserveNextNonce(ctx)
 	if err != nil {
 		return returnErr(err)
 	}
 
-	estimatedGas := s.gasEstimator(s.chain.ID, sub.Msgs)
-
 	candidate := txmgr.TxCandidate{
 		TxData:   txData,
-		To:       &s.chain.PortalAddress,
+		To:       &to,
 		GasLimit: estimatedGas,
 		Value:    big.NewInt(0),
 		Nonce:    &nonce,
@@ -159,41 +265,92 @@ func (s Sender) SendAsync(ctx context.Context, sub xchain.Submission) <-chan err
 	go func() {
 		tx, rec, err := s.txMgr.Send(ctx, candidate)
 		if err != nil {
-			asyncResp <- errors.Wrap(err, "failed to send tx", reqAttrs...)
+			submissionFailures.WithLabelValues(dstChain, string(reasonSendError)).Inc()
+			asyncResp <- submissionError{
+				Reason:   reasonSendError,
+				ValSetID: maxValSetID(subs),
+				err:      errors.Wrap(err, "failed to send tx", reqAttrs...),
+			}
+
 			return
 		}
 
-		submissionTotal.WithLabelValues(srcChain, dstChain).Inc()
-		msgTotal.WithLabelValues(srcChain, dstChain).Add(float64(len(sub.Msgs)))
+		for i, sub := range subs {
+			submissionTotal.WithLabelValues(srcChains[i], dstChain).Inc()
+			msgTotal.WithLabelValues(srcChains[i], dstChain).Add(float64(len(sub.Msgs)))
+		}
 		gasEstimated.WithLabelValues(dstChain).Observe(float64(estimatedGas))
+		gasUsedRatio.WithLabelValues(dstChain).Observe(float64(rec.GasUsed) / float64(tx.Gas()))
 
 		receiptAttrs := []any{
-			"valset_id", sub.ValidatorSetID,
+			"valset_id", subs[0].ValidatorSetID,
 			"status", rec.Status,
 			"nonce", tx.Nonce(),
 			"height", rec.BlockNumber.Uint64(),
 			"gas_used", rec.GasUsed,
 			"tx_hash", rec.TxHash,
+			"batch", len(subs),
+			"msgs", numMsgs,
 		}
 
+		// Batched submissions revert independently, detect those without xreceipts.
+		failed := failedSubmissions(s.chain.PortalAddress, rec, subs)
+
 		if s.onSubmit != nil {
-			go s.onSubmit(ctx, tx, rec, sub)
+			go s.onSubmit(ctx, tx, rec, subs, failed)
+		}
+
+		if rec.Status == ethtypes.ReceiptStatusSuccessful && len(failed) == 0 {
+			s.observeGas(ctx, subs, features, rec)
+			s.gasBoost.Decay()
+		}
+
+		// Delivered xmsgs may still fail execution on the destination chain.
+		for _, xmsg := range failedXMsgs(s.chain.PortalAddress, rec) {
+			xmsgFailures.WithLabelValues(dstChain).Inc()
+			log.Info(ctx, "Delivered xmsg execution failed",
+				"src_chain_id", xmsg.SourceChainID,
+				"shard", xmsg.ShardID,
+				"offset", xmsg.Offset,
+				"revert_reason", xmsg.Reason,
+				"tx_hash", rec.TxHash,
+			)
 		}
 
 		const statusReverted = 0
-		if rec.Status == statusReverted {
-			// Try and get debug information of the reverted transaction
-			resp, err := s.ethCl.CallContract(ctx, callFromTx(s.txMgr.From(), tx), rec.BlockNumber)
+		if rec.Status == statusReverted || len(failed) > 0 {
+			// Try and get debug information of the reverted transaction or submissions
+			resp, revert, err := s.debugRevert(ctx, tx, rec, subs)
+			gasUsed := rec.GasUsed
+			if len(failed) > 0 {
+				gasUsed = 0 // Batch transaction succeeded, so it didn't run out of gas.
+				receiptAttrs = append(receiptAttrs, "failed_subs", len(failed))
+			}
+			reason := classifyRevert(revert, gasUsed, tx.Gas())
 
 			errAttrs := slices.Concat(receiptAttrs, reqAttrs, []any{
 				"call_resp", hexutil.Encode(resp),
 				"call_err", err,
 				"gas_limit", tx.Gas(),
+				"revert_reason", revert,
+				"failure_reason", reason,
 			})
 
-			revertedSubmissionTotal.WithLabelValues(srcChain, dstChain).Inc()
+			for _, srcChain := range srcChains {
+				revertedSubmissionTotal.WithLabelValues(srcChain, dstChain).Inc()
+			}
+			submissionFailures.WithLabelValues(dstChain, string(reason)).Inc()
 
-			asyncResp <- errors.New("submission reverted", errAttrs...)
+			if reason == reasonOutOfGas {
+				s.gasBoost.Raise(ctx)
+				s.observeOutOfGas(ctx, subs, features, tx.Gas())
+			}
+
+			asyncResp <- submissionError{
+				Reason:   reason,
+				ValSetID: maxValSetID(subs),
+				err:      errors.New("submission reverted", errAttrs...),
+			}
 
 			return
 		}
@@ -205,6 +362,152 @@ func (s Sender) SendAsync(ctx context.Context, sub xchain.Submission) <-chan err
 	return asyncResp
 }
 
+// learnsGas returns true if the learned gas model applies to the submissions. It only applies if enabled
+// and if the static model is used, since destinations using proper (RPC) gas estimation don't need it.
+func (s Sender) learnsGas(subs []xchain.Submission) bool {
+	if s.gasModel == nil || batchGas(s.gasEstimator, s.chain.ID, subs) == properGasEstimation {
+		return false
+	}
+
+	for _, sub := range subs {
+		if netconf.IsOmniConsensus(s.network, sub.BlockHeader.ChainID) {
+			return false // Consensus chain xmsgs do not have a gas limit.
+		}
+	}
+
+	return true
+}
+
+// xmsgGasLimit returns the sum of the submissions' xmsg gas limits.
+func xmsgGasLimit(subs []xchain.Submission) uint64 {
+	var resp uint64
+	for _, sub := range subs {
+		for _, msg := range sub.Msgs {
+			resp += msg.DestGasLimit
+		}
+	}
+
+	return resp
+}
+
+// estimateGas returns the gas limit of a transaction submitting the submissions.
+// It uses the learned gas model if ready, falling back to the static model otherwise.
+func (s Sender) estimateGas(ctx context.Context, subs []xchain.Submission, features gasmodel.Features) uint64 {
+	static := batchGas(s.gasEstimator, s.chain.ID, subs)
+	if !s.learnsGas(subs) {
+		return static
+	}
+
+	overhead, ok, err := s.gasModel.Estimate(ctx, s.chain.ID, features)
+	if err != nil {
+		log.Warn(ctx, "Failed estimating gas using learned model (will use static)", err)
+		return static
+	} else if !ok {
+		return static
+	}
+
+	gasModelUsed.WithLabelValues(s.chain.Name).Inc()
+
+	return overhead + xmsgGasLimit(subs)
+}
+
+// observeGas updates the learned gas model with the overhead gas used by a successful transaction,
+// i.e., the gas used excluding xmsg execution as reported by portal XReceipt events.
+func (s Sender) observeGas(ctx context.Context, subs []xchain.Submission, features gasmodel.Features, rec *ethclient.Receipt) {
+	if !s.learnsGas(subs) {
+		return
+	}
+
+	var xmsgGas uint64
+	for _, l := range rec.Logs {
+		if l.Address != s.chain.PortalAddress || len(l.Topics) == 0 || l.Topics[0] != s.abi.Events["XReceipt"].ID {
+			continue
+		}
+
+		xreceipt, err := xreceiptFilterer.ParseXReceipt(*l)
+		if err != nil {
+			log.Warn(ctx, "Failed parsing xreceipt (will not observe gas)", err)
+			return
+		}
+
+		xmsgGas += xreceipt.GasUsed.Uint64()
+	}
+
+	if xmsgGas >= rec.GasUsed {
+		return // Sanity check, shouldn't happen.
+	}
+
+	if err := s.gasModel.Observe(ctx, s.chain.ID, features, rec.GasUsed-xmsgGas); err != nil {
+		log.Warn(ctx, "Failed observing gas used by learned model", err)
+	}
+}
+
+// observeOutOfGas updates the learned gas model with the overhead gas limit of a transaction that ran out of gas,
+// i.e., the gas limit excluding the xmsg gas limits.
+func (s Sender) observeOutOfGas(ctx context.Context, subs []xchain.Submission, features gasmodel.Features, gasLimit uint64) {
+	if !s.learnsGas(subs) {
+		return
+	}
+
+	overheadLimit := umath.SubtractOrZero(gasLimit, xmsgGasLimit(subs))
+	if overheadLimit == 0 {
+		return // Sanity check, shouldn't happen.
+	}
+
+	if err := s.gasModel.ObserveOutOfGas(ctx, s.chain.ID, features, overheadLimit); err != nil {
+		log.Warn(ctx, "Failed observing out-of-gas by learned model", err)
+	}
+}
+
+// maxValSetID returns the highest validator set ID of the submissions.
+func maxValSetID(subs []xchain.Submission) uint64 {
+	var resp uint64
+	for _, sub := range subs {
+		resp = max(resp, sub.ValidatorSetID)
+	}
+
+	return resp
+}
+
+// debugRevert returns the call response and revert reason of the reverted transaction.
+// For batched submissions, it returns the revert data and reason of the first failed call, decoded
+// from the Multicall3 aggregate3 results, since the transaction itself doesn't revert.
+func (s Sender) debugRevert(ctx context.Context, tx *ethtypes.Transaction, rec *ethclient.Receipt, subs []xchain.Submission) ([]byte, string, error) {
+	if s.multicall == nil || tx.To() == nil || *tx.To() != *s.multicall {
+		resp, err := s.ethCl.CallContract(ctx, callFromTx(s.txMgr.From(), tx), rec.BlockNumber)
+		if err != nil {
+			revert, _ := revertReason(err)
+			return resp, revert, err
+		}
+
+		return resp, "", nil
+	}
+
+	// Re-simulate the whole batch on top of the parent block, so preceding submissions of the batch
+	// are applied before each call (as in the transaction), and decode the per-call results.
+	height := new(big.Int).Sub(rec.BlockNumber, big.NewInt(1))
+	resp, err := s.ethCl.CallContract(ctx, callFromTx(s.txMgr.From(), tx), height)
+	if err != nil {
+		revert, _ := revertReason(err)
+		return resp, revert, err
+	}
+
+	results, err := decodeXSubmitBatch(resp)
+	if err != nil {
+		return resp, "", err
+	} else if len(results) != len(subs) {
+		return resp, "", errors.New("unexpected batch results", "results", len(results), "subs", len(subs))
+	}
+
+	for _, result := range results {
+		if !result.Success {
+			return result.ReturnData, decodeRevert(result.ReturnData), nil
+		}
+	}
+
+	return resp, "", nil
+}
+
 func callFromTx(from common.Address, tx *ethtypes.Transaction) ethereum.CallMsg {
 	resp := ethereum.CallMsg{
 		From:          from,
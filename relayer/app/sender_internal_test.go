@@ -7,6 +7,7 @@ import (
 
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/tokens"
 	"github.com/omni-network/omni/lib/txmgr"
@@ -25,7 +26,7 @@ var errSentAsync = errors.New("sent async")
 
 func TestSendAsync(t *testing.T) {
 	t.Parallel()
-	ctx := context.Background()
+	ctx := log.WithNoopLogger(context.Background())
 	fuzzer := fuzz.New().NilChance(0).NumElements(1, 8)
 	mockGasEstimator := func(destChain uint64, msgs []xchain.Msg) uint64 {
 		return 0
@@ -107,6 +108,10 @@ func (m *mockTxMgr) From() common.Address {
 	panic("implement me")
 }
 
+func (m *mockTxMgr) Pending() []txmgr.PendingTx {
+	panic("implement me")
+}
+
 func (m *mockTxMgr) ReservedNonces() uint64 {
 	m.Lock()
 	defer m.Unlock()
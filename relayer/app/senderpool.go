@@ -0,0 +1,273 @@
+package relayer
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ShardMode defines how submissions to a destination chain are sharded across sender keys.
+type ShardMode string
+
+const (
+	// ShardModeStream pins each stream to a key, preserving per-stream submission order.
+	ShardModeStream ShardMode = "stream"
+	// ShardModeRoundRobin rotates transactions across keys. It maximizes throughput, but may reorder
+	// submissions of a stream which results in reverts and worker resets.
+	ShardModeRoundRobin ShardMode = "round-robin"
+)
+
+// Verify returns an error if the shard mode is not supported.
+func (m ShardMode) Verify() error {
+	switch m {
+	case ShardModeStream, ShardModeRoundRobin:
+		return nil
+	default:
+		return errors.New("invalid sender shard mode", "mode", m)
+	}
+}
+
+const (
+	// stuckBlocks is the number of destination chain blocks after which a pending nonce is considered stuck.
+	stuckBlocks = 50
+	// minStuckTimeout is the minimum duration after which a pending nonce is considered stuck.
+	minStuckTimeout = 2 * time.Minute
+	// balancePeriod is the period at which sender key balances are queried.
+	balancePeriod = time.Minute
+)
+
+// senderPool shards submissions to a destination chain across multiple senders (one per key),
+// so that a single stuck nonce doesn't block all streams.
+//
+// A sender is stuck if its oldest pending nonce was reserved longer than stuckTimeout ago.
+// Stuck senders are excluded from selection, with their submissions sent by the next sender
+// that isn't stuck. If all senders are stuck, the preferred sender is used regardless.
+//
+// In stream mode, a stream only moves to another sender once none of its submissions are in-flight,
+// since later offsets sent by another sender revert while earlier offsets are still pending.
+type senderPool struct {
+	chainName    string
+	mode         ShardMode
+	senders      []Sender
+	stuckTimeout time.Duration
+	next         *atomic.Uint64 // Next round-robin sender
+	inflight     *inflightStreams
+	now          func() time.Time
+}
+
+// inflightStreams tracks the sender and number of in-flight submissions per stream.
+type inflightStreams struct {
+	mu      sync.Mutex
+	streams map[xchain.StreamID]inflightStream
+}
+
+type inflightStream struct {
+	Sender int
+	Count  int
+}
+
+// Sender returns the sender of the stream's in-flight submissions, or false if none are in-flight.
+func (s *inflightStreams) Sender(streamID xchain.StreamID) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stream, ok := s.streams[streamID]
+
+	return stream.Sender, ok
+}
+
+// Add adds in-flight submissions of the stream to the sender.
+// It returns a function that removes them again.
+func (s *inflightStreams) Add(streamID xchain.StreamID, sender, count int) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stream := s.streams[streamID]
+	stream.Sender = sender
+	stream.Count += count
+	s.streams[streamID] = stream
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		stream := s.streams[streamID]
+		stream.Count -= count
+		if stream.Count <= 0 {
+			delete(s.streams, streamID)
+		} else {
+			s.streams[streamID] = stream
+		}
+	}
+}
+
+// newSenderPool returns a new sender pool of the senders to the destination chain.
+func newSenderPool(chain netconf.Chain, mode ShardMode, senders []Sender) (senderPool, error) {
+	if len(senders) == 0 {
+		return senderPool{}, errors.New("no senders")
+	} else if err := mode.Verify(); err != nil {
+		return senderPool{}, err
+	}
+
+	return senderPool{
+		chainName:    chain.Name,
+		mode:         mode,
+		senders:      senders,
+		stuckTimeout: max(chain.BlockPeriod*stuckBlocks, minStuckTimeout),
+		next:         new(atomic.Uint64),
+		inflight:     &inflightStreams{streams: make(map[xchain.StreamID]inflightStream)},
+		now:          time.Now,
+	}, nil
+}
+
+// SendAsync shards the submissions across the pool's senders and sends them asynchronously.
+// It returns a channel that will receive the first error or nil when all submissions succeed.
+func (p senderPool) SendAsync(ctx context.Context, subs ...xchain.Submission) <-chan error {
+	order, shards := p.shard(subs)
+	if p.mode == ShardModeRoundRobin {
+		return p.senders[order[0]].SendAsync(ctx, subs...)
+	}
+
+	var resps []<-chan error
+	for _, i := range order {
+		resps = append(resps, p.trackInflight(i, shards[i], p.senders[i].SendAsync(ctx, shards[i]...)))
+	}
+
+	return firstErr(resps)
+}
+
+// trackInflight tracks the submissions sent by the sender as in-flight until the response is received.
+// It returns a channel forwarding the response.
+func (p senderPool) trackInflight(sender int, subs []xchain.Submission, resp <-chan error) <-chan error {
+	counts := make(map[xchain.StreamID]int)
+	for _, sub := range subs {
+		counts[submissionStream(sub)]++
+	}
+
+	var removes []func()
+	for streamID, count := range counts {
+		removes = append(removes, p.inflight.Add(streamID, sender, count))
+	}
+
+	forward := make(chan error, 1)
+	go func() {
+		err := <-resp
+		for _, remove := range removes {
+			remove()
+		}
+		forward <- err
+	}()
+
+	return forward
+}
+
+// shard returns the indexes of the senders to use (in order of first use)
+// and the submissions to send with each.
+func (p senderPool) shard(subs []xchain.Submission) ([]int, map[int][]xchain.Submission) {
+	stuck := p.stuck()
+
+	if p.mode == ShardModeRoundRobin {
+		preferred := int(p.next.Add(1)-1) % len(p.senders) //nolint:gosec // Modulo of counter doesn't need to be exact.
+		i := selectSender(preferred, stuck)
+
+		return []int{i}, map[int][]xchain.Submission{i: subs}
+	}
+
+	var order []int
+	shards := make(map[int][]xchain.Submission)
+	for _, sub := range subs {
+		// Keep streams with in-flight submissions on their sender, even if stuck.
+		// Note that streams are keyed by stream ID, not attestation chain version, since
+		// finalized attestations also prove fuzzy streams, which must not be split across senders.
+		streamID := submissionStream(sub)
+		i, ok := p.inflight.Sender(streamID)
+		if !ok {
+			i = selectSender(streamSender(streamID, len(p.senders)), stuck)
+		}
+		if _, ok := shards[i]; !ok {
+			order = append(order, i)
+		}
+		shards[i] = append(shards[i], sub)
+	}
+
+	return order, shards
+}
+
+// stuck returns whether each sender is stuck, updating the stuck metric.
+func (p senderPool) stuck() []bool {
+	resp := make([]bool, len(p.senders))
+	for i, sender := range p.senders {
+		pending := sender.txMgr.Pending()
+		resp[i] = len(pending) > 0 && p.now().Sub(pending[0].ReservedAt) > p.stuckTimeout
+
+		var isStuck float64
+		if resp[i] {
+			isStuck = 1
+		}
+		senderKeyStuck.WithLabelValues(p.chainName, sender.txMgr.From().Hex()).Set(isStuck)
+	}
+
+	return resp
+}
+
+// selectSender returns the preferred sender if not stuck, otherwise the next sender that isn't stuck.
+// It returns the preferred sender if all are stuck.
+func selectSender(preferred int, stuck []bool) int {
+	for i := range stuck {
+		idx := (preferred + i) % len(stuck)
+		if !stuck[idx] {
+			return idx
+		}
+	}
+
+	return preferred
+}
+
+// streamSender returns the stable preferred sender index of the stream.
+func streamSender(streamID xchain.StreamID, senders int) int {
+	h := fnv.New64a()
+	_, _ = h.Write(binary.BigEndian.AppendUint64(nil, streamID.SourceChainID))
+	_, _ = h.Write(binary.BigEndian.AppendUint64(nil, streamID.DestChainID))
+	_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(streamID.ShardID)))
+
+	return int(h.Sum64() % uint64(senders)) //nolint:gosec // Modulo of senders fits in int.
+}
+
+// monitorBalancesForever blocks and periodically updates the balance metric of the sender keys on the destination chain.
+func monitorBalancesForever(ctx context.Context, chain netconf.Chain, client ethclient.Client, addrs []common.Address) {
+	ticker := time.NewTicker(balancePeriod)
+	defer ticker.Stop()
+
+	for {
+		for _, addr := range addrs {
+			balance, err := client.BalanceAt(ctx, addr, nil)
+			if ctx.Err() != nil {
+				return
+			} else if err != nil {
+				log.Warn(ctx, "Failed querying sender key balance (will retry)", err, "chain", chain.Name, "address", addr)
+				continue
+			}
+
+			bf, _ := balance.Float64()
+			senderKeyBalance.WithLabelValues(chain.Name, addr.Hex()).Set(bf / params.Ether)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
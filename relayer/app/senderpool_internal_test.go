@@ -0,0 +1,155 @@
+package relayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/netconf"
+	"github.com/omni-network/omni/lib/txmgr"
+	"github.com/omni-network/omni/lib/xchain"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSenderPoolShard(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	txMgrs := []*pendingTxMgr{{from: common.Address{1}}, {from: common.Address{2}}, {from: common.Address{3}}}
+	var senders []Sender
+	for _, txMgr := range txMgrs {
+		senders = append(senders, Sender{txMgr: txMgr})
+	}
+
+	pool, err := newSenderPool(netconf.Chain{Name: "test", BlockPeriod: time.Second}, ShardModeStream, senders)
+	require.NoError(t, err)
+	pool.now = func() time.Time { return now }
+
+	newSub := func(chainID uint64) xchain.Submission {
+		return xchain.Submission{
+			AttHeader: xchain.AttestHeader{
+				ChainVersion: xchain.ChainVersion{ID: chainID, ConfLevel: xchain.ConfFinalized},
+			},
+			BlockHeader: xchain.BlockHeader{ChainID: chainID},
+			Msgs:        []xchain.Msg{{MsgID: xchain.MsgID{StreamID: xchain.StreamID{SourceChainID: chainID, ShardID: xchain.ShardFinalized0}}}},
+		}
+	}
+
+	// Streams are pinned to the same sender.
+	var subs []xchain.Submission
+	for id := range uint64(10) {
+		subs = append(subs, newSub(id))
+	}
+	order, shards := pool.shard(subs)
+	for _, sub := range subs {
+		i := streamSender(submissionStream(sub), len(senders))
+		require.Contains(t, shards[i], sub)
+	}
+	var total int
+	for _, i := range order {
+		total += len(shards[i])
+	}
+	require.Len(t, subs, total)
+
+	// Stuck senders are excluded.
+	sub := newSub(1)
+	preferred := streamSender(submissionStream(sub), len(senders))
+	txMgrs[preferred].pending = []txmgr.PendingTx{{ReservedAt: now.Add(-pool.stuckTimeout - time.Second)}}
+	order, _ = pool.shard([]xchain.Submission{sub})
+	require.Equal(t, []int{(preferred + 1) % len(senders)}, order)
+
+	// Streams with in-flight submissions stay on their (stuck) sender until they clear.
+	remove := pool.inflight.Add(submissionStream(sub), preferred, 1)
+	order, _ = pool.shard([]xchain.Submission{sub})
+	require.Equal(t, []int{preferred}, order)
+	remove()
+	order, _ = pool.shard([]xchain.Submission{sub})
+	require.Equal(t, []int{(preferred + 1) % len(senders)}, order)
+
+	// In-flight submissions are tracked until their response.
+	resp := make(chan error, 1)
+	forward := pool.trackInflight(preferred, []xchain.Submission{sub, sub}, resp)
+	inflightSender, ok := pool.inflight.Sender(submissionStream(sub))
+	require.True(t, ok)
+	require.Equal(t, preferred, inflightSender)
+	resp <- nil
+	require.NoError(t, <-forward)
+	_, ok = pool.inflight.Sender(submissionStream(sub))
+	require.False(t, ok)
+
+	// Pending but not stuck senders are used.
+	txMgrs[preferred].pending = []txmgr.PendingTx{{ReservedAt: now}}
+	order, _ = pool.shard([]xchain.Submission{sub})
+	require.Equal(t, []int{preferred}, order)
+
+	// Round-robin rotates across senders, skipping stuck senders.
+	pool.mode = ShardModeRoundRobin
+	txMgrs[1].pending = []txmgr.PendingTx{{ReservedAt: now.Add(-pool.stuckTimeout - time.Second)}}
+	var used []int
+	for range 3 {
+		order, shards := pool.shard(subs)
+		require.Len(t, order, 1)
+		require.Equal(t, subs, shards[order[0]])
+		used = append(used, order[0])
+	}
+	require.Equal(t, []int{0, 2, 2}, used)
+
+	// All stuck uses the preferred sender.
+	require.Equal(t, 1, selectSender(1, []bool{true, true, true}))
+}
+
+func TestSenderPoolMixedConfLevels(t *testing.T) {
+	t.Parallel()
+
+	var senders []Sender
+	for i := range byte(8) {
+		senders = append(senders, Sender{txMgr: &pendingTxMgr{from: common.Address{i}}})
+	}
+
+	pool, err := newSenderPool(netconf.Chain{Name: "test", BlockPeriod: time.Second}, ShardModeStream, senders)
+	require.NoError(t, err)
+
+	// Finalized attestations also prove the latest shard, so a single stream is submitted with both.
+	streamID := xchain.StreamID{SourceChainID: 1, DestChainID: 2, ShardID: xchain.ShardLatest0}
+	newSub := func(conf xchain.ConfLevel) xchain.Submission {
+		return xchain.Submission{
+			AttHeader:   xchain.AttestHeader{ChainVersion: xchain.ChainVersion{ID: streamID.SourceChainID, ConfLevel: conf}},
+			BlockHeader: xchain.BlockHeader{ChainID: streamID.SourceChainID},
+			DestChainID: streamID.DestChainID,
+			Msgs:        []xchain.Msg{{MsgID: xchain.MsgID{StreamID: streamID}}},
+		}
+	}
+	latest := newSub(xchain.ConfLatest)
+	finalized := newSub(xchain.ConfFinalized)
+
+	// Both are sharded to the same sender.
+	order, shards := pool.shard([]xchain.Submission{latest, finalized})
+	require.Len(t, order, 1)
+	require.Equal(t, []xchain.Submission{latest, finalized}, shards[order[0]])
+
+	// In-flight latest submissions keep finalized submissions of the stream on the same sender.
+	other := (order[0] + 1) % len(senders)
+	resp := make(chan error, 1)
+	forward := pool.trackInflight(other, []xchain.Submission{latest}, resp)
+	order, _ = pool.shard([]xchain.Submission{finalized})
+	require.Equal(t, []int{other}, order)
+	resp <- nil
+	require.NoError(t, <-forward)
+}
+
+// pendingTxMgr is a txmgr.TxManager only implementing From and Pending.
+type pendingTxMgr struct {
+	txmgr.TxManager
+
+	from    common.Address
+	pending []txmgr.PendingTx
+}
+
+func (m *pendingTxMgr) From() common.Address {
+	return m.from
+}
+
+func (m *pendingTxMgr) Pending() []txmgr.PendingTx {
+	return m.pending
+}
@@ -2,6 +2,8 @@ package relayer
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -21,6 +23,9 @@ import (
 const (
 	// mempoolLimit is the maximum number of transactions we want to submit to the mempool at once.
 	mempoolLimit = 16
+
+	// maxBatchSize is the maximum number of submissions packed into a single transaction.
+	maxBatchSize = 8
 )
 
 type Worker struct {
@@ -32,6 +37,17 @@ type Worker struct {
 	sendProvider func() (SendAsync, error)
 	awaitValSet  awaitValSet
 	cursors      *cursor.Store
+	gate         profitGate
+	policy       bufferPolicy
+	filter       relayFilter
+	batch        batchLimits
+
+	mu           sync.Mutex
+	paused       chan struct{}                  // Non-nil while paused, closed on resume
+	restart      bool                           // True if the current run was stopped by an admin request
+	cancelRun    context.CancelFunc             // Cancels the current run
+	buf          *activeBuffer                  // Buffer of the current run
+	restartFroms map[xchain.ChainVersion]uint64 // Attest offsets to stream from on next run
 }
 
 // NewWorker creates a new worker for a single destination chain.
@@ -44,6 +60,10 @@ func NewWorker(
 	sendProvider func() (SendAsync, error),
 	awaitValSet awaitValSet,
 	cursors *cursor.Store,
+	gate profitGate,
+	policy bufferPolicy,
+	filter relayFilter,
+	batch batchLimits,
 ) *Worker {
 	return &Worker{
 		destChain:    destChain,
@@ -54,16 +74,33 @@ func NewWorker(
 		sendProvider: sendProvider,
 		awaitValSet:  awaitValSet,
 		cursors:      cursors,
+		gate:         gate,
+		policy:       policy,
+		filter:       filter,
+		batch:        batch,
 	}
 }
 
 func (w *Worker) Run(ctx context.Context) {
 	ctx = log.WithCtx(ctx, "dst_chain", w.destChain.Name)
 	backoff := expbackoff.NewWithAutoReset(ctx)
+	var recovered bool // True if the previous failure was recovered (without backoff)
 	for ctx.Err() == nil {
-		err := w.runOnce(ctx)
+		if !w.awaitResumed(ctx) {
+			return
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		w.setRun(cancel, nil)
+		err := w.runOnce(runCtx)
+		cancel()
+		w.setRun(nil, nil)
+
 		if ctx.Err() != nil {
 			return
+		} else if w.takeRestart() {
+			log.Info(ctx, "Worker stopped by admin request")
+			continue
 		} else if errors.Is(err, chaos.ErrChaos) {
 			log.InfoErr(ctx, "Worker failed due to chaos testing, resetting", err)
 		} else {
@@ -71,10 +108,47 @@ func (w *Worker) Run(ctx context.Context) {
 		}
 
 		workerResets.WithLabelValues(w.destChain.Name).Inc()
+
+		// Recover from specific submission failures without backoff, unless the previous failure was also recovered.
+		if !recovered && w.recover(ctx, err) {
+			recovered = true
+			continue
+		}
+
+		recovered = false
 		backoff()
 	}
 }
 
+// recover takes recovery actions specific to the submission failure reason.
+// It returns true if the worker can reset immediately.
+func (w *Worker) recover(ctx context.Context, err error) bool {
+	var subErr submissionError
+	if !errors.As(err, &subErr) {
+		return false
+	}
+
+	switch subErr.Reason {
+	case reasonWrongOffset:
+		// Resetting refetches the submitted cursors from the destination chain.
+		log.Info(ctx, "Submission failed with wrong offset, refetching cursors")
+		return true
+	case reasonValSetUnknown:
+		log.Info(ctx, "Submission failed with unknown validator set, awaiting it", "valset_id", subErr.ValSetID)
+		if err := w.awaitValSet(ctx, subErr.ValSetID); err != nil {
+			log.Warn(ctx, "Failed awaiting validator set", err, "valset_id", subErr.ValSetID)
+			return false
+		}
+
+		return true
+	case reasonOutOfGas:
+		// Gas limits are raised by the sender.
+		return true
+	default:
+		return false
+	}
+}
+
 func (w *Worker) runOnce(ctx context.Context) error {
 	log.Info(ctx, "Worker starting")
 
@@ -99,7 +173,20 @@ func (w *Worker) runOnce(ctx context.Context) error {
 		return err
 	}
 
-	buf := newActiveBuffer(w.destChain.Name, mempoolLimit, sender)
+	buf := newActiveBuffer(w.destChain.Name, mempoolLimit, w.batch, sender, w.policy, w.network.StreamName)
+	w.setRun(cancel, buf)
+
+	// Hold unprofitable submissions before adding them to the buffer.
+	addInput := buf.AddInput
+	if w.gate.enabled() {
+		input := w.gate.Wrap(buf.AddInput, w.network.StreamName)
+		go func() {
+			if err := input.Run(ctx); err != nil {
+				buf.submitErr(errors.Wrap(err, "profit gate"))
+			}
+		}()
+		addInput = input.AddInput
+	}
 
 	attestOffsets, err := fromChainVersionOffsets(cursors, w.network.ChainVersionsTo(w.destChain.ID))
 	if err != nil {
@@ -139,22 +226,41 @@ func (w *Worker) runOnce(ctx context.Context) error {
 		}
 	}
 
+	for chainVer, offset := range w.takeRestartFroms() {
+		if _, ok := attestOffsets[chainVer]; !ok {
+			log.Warn(ctx, "Ignoring admin restart offset of unknown chain version", nil, "chain_version", w.network.ChainVersionName(chainVer))
+			continue
+		}
+
+		log.Info(ctx, "Worker using admin restart attest offset",
+			"chain_version", w.network.ChainVersionName(chainVer),
+			"prev", attestOffsets[chainVer],
+			"restart", offset,
+		)
+		attestOffsets[chainVer] = offset
+	}
+
 	msgFilter, err := newMsgOffsetFilter(cursors)
 	if err != nil {
 		return err
 	}
 
+	deferred := newDeferredUpdates(w.filter, w.submittedOffset, w.refetchUpdate, w.network.StreamName)
+
 	var logAttrs []any //nolint:prealloc // Not worth it
 	for chainVer, fromOffset := range attestOffsets {
 		if chainVer.ID == w.destChain.ID { // Sanity check
 			return errors.New("unexpected chain version [BUG]")
+		} else if !w.filter.srcChains.Allowed(chainVer.ID) {
+			continue // Skip source chains not relayed.
 		}
 
 		callback := w.newCallback(
 			msgFilter,
-			buf.AddInput,
+			addInput,
 			newMsgStreamMapper(w.network),
 			chainVer,
+			deferred,
 		)
 
 		w.cProvider.StreamAsync(ctx, chainVer, fromOffset, w.destChain.Name, callback)
@@ -167,6 +273,136 @@ func (w *Worker) runOnce(ctx context.Context) error {
 	return buf.Run(ctx)
 }
 
+// WorkerStatus is the status of a worker.
+type WorkerStatus struct {
+	Paused   bool  // True if the worker is paused
+	Running  bool  // True if the worker is currently running
+	Waiting  int64 // Number of submissions queued or waiting to be queued in the buffer
+	Inflight int64 // Number of transactions in the mempool
+}
+
+// Status returns the current status of the worker.
+func (w *Worker) Status() WorkerStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	resp := WorkerStatus{
+		Paused:  w.paused != nil,
+		Running: w.cancelRun != nil,
+	}
+	if w.buf != nil {
+		resp.Waiting, resp.Inflight = w.buf.Occupancy()
+	}
+
+	return resp
+}
+
+// Pause stops the current run and doesn't restart the worker until resumed.
+func (w *Worker) Pause() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.paused == nil {
+		w.paused = make(chan struct{})
+	}
+	w.stopRunUnsafe()
+}
+
+// Resume resumes a paused worker.
+func (w *Worker) Resume() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.paused != nil {
+		close(w.paused)
+		w.paused = nil
+	}
+}
+
+// RestartFrom restarts the worker, streaming attestations of the source chain version from the provided offset.
+// This re-submits any messages of those attestations not yet submitted to the destination chain.
+func (w *Worker) RestartFrom(chainVer xchain.ChainVersion, attestOffset uint64) error {
+	if attestOffset < initialAttestOffset {
+		return errors.New("invalid attest offset", "offset", attestOffset)
+	} else if !slices.Contains(w.network.ChainVersionsTo(w.destChain.ID), chainVer) {
+		return errors.New("unknown chain version", "chain_version", w.network.ChainVersionName(chainVer))
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.restartFroms == nil {
+		w.restartFroms = make(map[xchain.ChainVersion]uint64)
+	}
+	w.restartFroms[chainVer] = attestOffset
+	w.stopRunUnsafe()
+
+	return nil
+}
+
+// awaitResumed blocks while the worker is paused. It returns false if the context is canceled.
+func (w *Worker) awaitResumed(ctx context.Context) bool {
+	w.mu.Lock()
+	paused := w.paused
+	w.mu.Unlock()
+
+	if paused == nil {
+		return true
+	}
+
+	log.Info(ctx, "Worker paused")
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-paused:
+		log.Info(ctx, "Worker resumed")
+		return true
+	}
+}
+
+// setRun sets the cancel function and buffer of the current run.
+func (w *Worker) setRun(cancel context.CancelFunc, buf *activeBuffer) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.cancelRun = cancel
+	w.buf = buf
+}
+
+// stopRunUnsafe stops the current run, if any.
+// It is unsafe since it assumes the lock is held.
+func (w *Worker) stopRunUnsafe() {
+	if w.cancelRun == nil {
+		return
+	}
+
+	w.restart = true
+	w.cancelRun()
+}
+
+// takeRestart returns true if the previous run was stopped by an admin request, and resets it.
+func (w *Worker) takeRestart() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	resp := w.restart
+	w.restart = false
+
+	return resp
+}
+
+// takeRestartFroms returns and resets the attest offsets to restart from.
+func (w *Worker) takeRestartFroms() map[xchain.ChainVersion]uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	resp := w.restartFroms
+	w.restartFroms = nil
+
+	return resp
+}
+
 // awaitValSet blocks until the portal is aware of this validator set ID.
 type awaitValSet func(ctx context.Context, valsetID uint64) error
 
@@ -240,6 +476,7 @@ func (w *Worker) newCallback(
 	sendBuffer func(context.Context, xchain.Submission) error,
 	msgStreamMapper msgStreamMapper,
 	streamerChainVer xchain.ChainVersion, // Use streamer chain version for cursors since fuzzy overrides otherwise store latest streamed offsets to finalized streamer.
+	deferred *deferredUpdates,
 ) cchain.ProviderCallback {
 	var cachedValSetID uint64
 	var cachedValSet []cchain.PortalValidator
@@ -249,6 +486,30 @@ func (w *Worker) newCallback(
 			return w.cursors.Insert(ctx, streamerChainVer, w.destChain.ID, att.AttestOffset, streamMsgs)
 		}
 
+		submit := func(updates []StreamUpdate) error {
+			for _, update := range updates {
+				submissions, err := w.creator(update)
+				if err != nil {
+					return err
+				}
+
+				for _, subs := range submissions {
+					if err := sendBuffer(ctx, subs); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if !w.filter.SponsorsAll() {
+			// Drop unsponsored msgs of this streamer's streams deferred for too long
+			deferred.DropExpired(ctx, func(streamID xchain.StreamID) bool {
+				return streamID.SourceChainID == att.ChainID && attestationForShard(att, streamID.ShardID)
+			})
+		}
+
 		block, ok, err := fetchXBlock(ctx, w.xProvider, att)
 		if err != nil {
 			return err
@@ -283,6 +544,8 @@ func (w *Worker) newCallback(
 				continue // Skip streams not destined for this worker.
 			} else if !attestationForShard(att, streamID.ShardID) {
 				continue // Skip streams not applicable to this attestation conf level.
+			} else if !w.filter.AllowStream(streamID) {
+				continue // Skip streams not relayed.
 			}
 
 			applicable[streamID] = msgs
@@ -307,22 +570,75 @@ func (w *Worker) newCallback(
 				ValSet:      cachedValSet,
 			}
 
-			submissions, err := w.creator(update)
-			if err != nil {
-				return err
-			}
-
-			for _, subs := range submissions {
-				if err := sendBuffer(ctx, subs); err != nil {
+			updates := []StreamUpdate{update}
+			if !w.filter.SponsorsAll() {
+				// Defer unsponsored msgs
+				updates, err = deferred.Sponsor(ctx, update)
+				if err != nil {
 					return err
 				}
 			}
+
+			if err := submit(updates); err != nil {
+				return err
+			}
 		}
 
 		return saveCursors(applicable)
 	}
 }
 
+// submittedOffset returns the latest submitted msg offset of the stream on the destination chain.
+func (w *Worker) submittedOffset(ctx context.Context, stream xchain.StreamID) (uint64, error) {
+	cursor, ok, err := w.xProvider.GetSubmittedCursor(ctx, xchain.LatestRef, stream)
+	if err != nil {
+		return 0, errors.Wrap(err, "get submitted cursor")
+	} else if !ok {
+		return 0, nil
+	}
+
+	return cursor.MsgOffset, nil
+}
+
+// refetchUpdate returns the stream update of the dropped deferred update's attestation, re-fetching
+// the attestation, xblock and validator set.
+func (w *Worker) refetchUpdate(ctx context.Context, stream xchain.StreamID, ref droppedRef) (StreamUpdate, error) {
+	atts, err := w.cProvider.AttestationsFrom(ctx, ref.ChainVersion, ref.AttestOffset)
+	if err != nil {
+		return StreamUpdate{}, errors.Wrap(err, "fetch attestation")
+	} else if len(atts) == 0 || atts[0].AttestOffset != ref.AttestOffset {
+		return StreamUpdate{}, errors.New("attestation not found", "attest_offset", ref.AttestOffset)
+	}
+	att := atts[0]
+
+	block, ok, err := fetchXBlock(ctx, w.xProvider, att)
+	if err != nil {
+		return StreamUpdate{}, err
+	} else if !ok {
+		return StreamUpdate{}, errors.New("attestation block mismatch", "attest_offset", ref.AttestOffset)
+	}
+
+	msgTree, err := xchain.NewMsgTree(block.Msgs)
+	if err != nil {
+		return StreamUpdate{}, err
+	}
+
+	valSet, ok, err := w.cProvider.PortalValidatorSet(ctx, att.ValidatorSetID)
+	if err != nil {
+		return StreamUpdate{}, errors.Wrap(err, "fetch validator set")
+	} else if !ok {
+		return StreamUpdate{}, errors.New("validator set not found [BUG]", "valset", att.ValidatorSetID)
+	}
+
+	return StreamUpdate{
+		StreamID:    stream,
+		Attestation: att,
+		Msgs:        newMsgStreamMapper(w.network)(block.Msgs)[stream],
+		MsgTree:     msgTree,
+		ValSet:      valSet,
+	}, nil
+}
+
 // fetchXBlock gets the xblock from the source chain (retry up to 10s if block-not-finalized).
 func fetchXBlock(rootCtx context.Context, xProvider xchain.Provider, att xchain.Attestation) (xchain.Block, bool, error) {
 	ctx, cancel := context.WithTimeout(rootCtx, 10*time.Second)
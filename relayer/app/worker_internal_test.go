@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/omni-network/omni/lib/cchain"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/netconf"
 	"github.com/omni-network/omni/lib/xchain"
 	"github.com/omni-network/omni/relayer/app/cursor"
@@ -16,7 +17,7 @@ import (
 
 func TestWorker_Run(t *testing.T) {
 	t.Parallel()
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(log.WithNoopLogger(context.Background()))
 
 	const (
 		srcChain         = 1
@@ -90,7 +91,7 @@ func TestWorker_Run(t *testing.T) {
 
 	// mockSender should never be called, since we return empty slices from the creator.
 	mockSender := &mockSender{
-		SendTransactionFn: func(ctx context.Context, submission xchain.Submission) <-chan error {
+		SendTransactionFn: func(ctx context.Context, submissions ...xchain.Submission) <-chan error {
 			require.Fail(t, "should not be called")
 			return nil
 		},
@@ -164,7 +165,11 @@ func TestWorker_Run(t *testing.T) {
 			mockCreateFunc,
 			func() (SendAsync, error) { return mockSender.SendTransaction, nil },
 			noAwait,
-			cursors)
+			cursors,
+			profitGate{},
+			bufferPolicy{},
+			relayFilter{},
+			batchLimits{MaxSize: 1})
 		go w.Run(ctx)
 	}
 
@@ -0,0 +1,61 @@
+package relayer
+
+import (
+	"context"
+	"io"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	xprovider "github.com/omni-network/omni/lib/xchain/provider"
+)
+
+// ExportXBlocks writes a snapshot of the xblock cache to the writer.
+func ExportXBlocks(ctx context.Context, cfg Config, w io.Writer) error {
+	cache, err := newBlockCache(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	n, err := cache.Export(w)
+	if err != nil {
+		return errors.Wrap(err, "export xblocks")
+	}
+
+	log.Info(ctx, "Exported xblock cache", "blocks", n)
+
+	return nil
+}
+
+// ImportXBlocks imports a snapshot of xblocks from the reader into the xblock cache.
+// This bootstraps a new relayer, avoiding refetching all xblocks over RPC.
+func ImportXBlocks(ctx context.Context, cfg Config, r io.Reader) error {
+	cache, err := newBlockCache(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	n, err := cache.Import(r)
+	if err != nil {
+		return errors.Wrap(err, "import xblocks")
+	}
+
+	log.Info(ctx, "Imported xblock cache", "blocks", n)
+
+	return nil
+}
+
+// newBlockCache returns the persistent xblock cache.
+func newBlockCache(ctx context.Context, cfg Config) (*xprovider.BlockCache, error) {
+	if cfg.XBlockCacheSize == 0 {
+		return nil, errors.New("xblock cache disabled, set --xblock-cache-size")
+	} else if cfg.DBDir == "" {
+		return nil, errors.New("xblock cache requires --db-dir")
+	}
+
+	db, err := initializeDB(ctx, cfg, "xblocks")
+	if err != nil {
+		return nil, err
+	}
+
+	return xprovider.NewBlockCache(db, cfg.XBlockCacheSize)
+}
@@ -16,6 +16,7 @@ func New() *cobra.Command {
 		"relayer",
 		"Relayer is a service that relays txs between the omni network and rollups",
 		buildinfo.NewVersionCmd(),
+		newXBlocksCmd(),
 	)
 
 	cfg := relayer.DefaultConfig()
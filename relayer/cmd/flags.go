@@ -11,9 +11,49 @@ import (
 func bindRunFlags(flags *pflag.FlagSet, cfg *relayer.Config) {
 	netconf.BindFlag(flags, &cfg.Network)
 	xchain.BindFlags(flags, &cfg.RPCEndpoints)
+	flags.IntVar(&cfg.RPCQuorum, "xchain-rpc-quorum", cfg.RPCQuorum, "Number of RPC endpoints that must agree on critical reads of chains with multiple endpoints")
 	flags.StringVar(&cfg.PrivateKey, "private-key", cfg.PrivateKey, "The path to the private key e.g path/private.key")
+	flags.StringSliceVar(&cfg.ExtraPrivateKeys, "extra-private-keys", cfg.ExtraPrivateKeys, "Paths to additional private keys to shard submissions across")
+	flags.StringVar(&cfg.FireAPIKey, "fireblocks-api-key", cfg.FireAPIKey, "FireBlocks API key. Empty disables fireblocks sender keys")
+	flags.StringVar(&cfg.FireKeyPath, "fireblocks-key-path", cfg.FireKeyPath, "FireBlocks RSA private key path")
+	flags.StringSliceVar(&cfg.FireAddresses, "fireblocks-addresses", cfg.FireAddresses, "FireBlocks account addresses to shard submissions across")
+	flags.StringVar(&cfg.SenderShardMode, "sender-shard-mode", cfg.SenderShardMode, "How submissions are sharded across sender keys: stream or round-robin")
+	flags.BoolVar(&cfg.BatchSubmissions, "batch-submissions", cfg.BatchSubmissions, "Batch queued submissions into a single transaction via Multicall3. Note the portal then attributes xreceipts to Multicall3 instead of the sender key")
 	flags.StringVar(&cfg.HaloCometURL, "halo-url", cfg.HaloCometURL, "The URL of the halo node e.g localhost:26657")
 	flags.StringVar(&cfg.HaloGRPCURL, "halo-grpc-url", cfg.HaloGRPCURL, "The gRPC URL of the halo node e.g localhost:9999")
 	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
+	flags.StringVar(&cfg.AdminAddr, "admin-addr", cfg.AdminAddr, "The address to bind the admin API server. Empty disables the admin API. Do not expose publicly")
+	flags.BoolVar(&cfg.DryRun, "dry-run", cfg.DryRun, "Simulate submissions against destination chains without broadcasting transactions, spending no gas")
 	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	bindXBlockCacheFlag(flags, cfg)
+	flags.StringVar(&cfg.ProfitPolicy, "profit-policy", cfg.ProfitPolicy, "Policy for sending submissions based on predicted profitability: always, ratio, or batch")
+	flags.Float64Var(&cfg.ProfitMinRatio, "profit-min-ratio", cfg.ProfitMinRatio, "Minimum percentage of predicted cost that xmsg fees must cover to send")
+	flags.DurationVar(&cfg.ProfitMaxDelay, "profit-max-delay", cfg.ProfitMaxDelay, "Maximum duration unprofitable submissions are held before being sent regardless")
+	flags.StringToInt64Var(&cfg.StreamPriorities, "stream-priorities", cfg.StreamPriorities, "Buffer scheduling priority per source chain, higher first, default 0. e.g. \"omni_consensus=1\"")
+	flags.StringToInt64Var(&cfg.StreamWeights, "stream-weights", cfg.StreamWeights, "Buffer fair scheduling weight per source chain within the same priority, default 1. e.g. \"ethereum=2,base=4\"")
+	bindFilterFlags(flags, &cfg.Filter)
+}
+
+func bindFilterFlags(flags *pflag.FlagSet, cfg *relayer.FilterConfig) {
+	flags.StringSliceVar(&cfg.AllowSrcChains, "filter-allow-src-chains", cfg.AllowSrcChains, "Source chain names to relay, empty allows all")
+	flags.StringSliceVar(&cfg.DenySrcChains, "filter-deny-src-chains", cfg.DenySrcChains, "Source chain names not to relay")
+	flags.StringSliceVar(&cfg.AllowDstChains, "filter-allow-dst-chains", cfg.AllowDstChains, "Destination chain names to relay, empty allows all")
+	flags.StringSliceVar(&cfg.DenyDstChains, "filter-deny-dst-chains", cfg.DenyDstChains, "Destination chain names not to relay")
+	flags.StringSliceVar(&cfg.AllowShards, "filter-allow-shards", cfg.AllowShards, "Shard labels (F, L, B) or IDs to relay, empty allows all")
+	flags.StringSliceVar(&cfg.DenyShards, "filter-deny-shards", cfg.DenyShards, "Shard labels (F, L, B) or IDs not to relay")
+	flags.StringSliceVar(&cfg.AllowSenders, "filter-allow-senders", cfg.AllowSenders, "Source msg sender addresses to sponsor, empty allows all")
+	flags.StringSliceVar(&cfg.DenySenders, "filter-deny-senders", cfg.DenySenders, "Source msg sender addresses not to sponsor")
+	flags.StringSliceVar(&cfg.AllowDestAddrs, "filter-allow-dest-addrs", cfg.AllowDestAddrs, "Destination addresses to sponsor, empty allows all")
+	flags.StringSliceVar(&cfg.DenyDestAddrs, "filter-deny-dest-addrs", cfg.DenyDestAddrs, "Destination addresses not to sponsor")
+	flags.DurationVar(&cfg.MaxDeferral, "filter-max-deferral", cfg.MaxDeferral, "Maximum duration unsponsored msgs are deferred before being dropped (zero disables it)")
+}
+
+func bindXBlockCacheFlag(flags *pflag.FlagSet, cfg *relayer.Config) {
+	flags.Uint64Var(&cfg.XBlockCacheSize, "xblock-cache-size", cfg.XBlockCacheSize, "Maximum number of finalized xblocks cached per chain in the database directory. Zero disables the cache")
+}
+
+func bindXBlocksFlags(flags *pflag.FlagSet, cfg *relayer.Config, file *string) {
+	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	bindXBlockCacheFlag(flags, cfg)
+	flags.StringVar(file, "file", *file, "The path to the xblock snapshot file")
 }
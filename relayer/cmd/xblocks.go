@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	relayer "github.com/omni-network/omni/relayer/app"
+
+	"github.com/spf13/cobra"
+)
+
+// newXBlocksCmd returns a new cobra command that exports or imports xblock cache snapshots.
+func newXBlocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "xblocks",
+		Short: "Export or import xblock cache snapshots",
+	}
+
+	cmd.AddCommand(
+		newXBlocksExportCmd(),
+		newXBlocksImportCmd(),
+	)
+
+	return cmd
+}
+
+func newXBlocksExportCmd() *cobra.Command {
+	cfg := relayer.DefaultConfig()
+	file := "xblocks.jsonl"
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the xblock cache to a snapshot file",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := os.Create(file)
+			if err != nil {
+				return errors.Wrap(err, "create file")
+			}
+			defer f.Close()
+
+			if err := relayer.ExportXBlocks(cmd.Context(), cfg, f); err != nil {
+				return err
+			}
+
+			if err := f.Close(); err != nil {
+				return errors.Wrap(err, "close file")
+			}
+
+			log.Info(cmd.Context(), "Wrote xblock snapshot", "file", file)
+
+			return nil
+		},
+	}
+
+	bindXBlocksFlags(cmd.Flags(), &cfg, &file)
+
+	return cmd
+}
+
+func newXBlocksImportCmd() *cobra.Command {
+	cfg := relayer.DefaultConfig()
+	file := "xblocks.jsonl"
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports a snapshot file into the xblock cache",
+		Long:  "Imports a snapshot file into the xblock cache. Imported xblocks are not verified, so only import snapshots from trusted sources.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			f, err := os.Open(file)
+			if err != nil {
+				return errors.Wrap(err, "open file")
+			}
+			defer f.Close()
+
+			return relayer.ImportXBlocks(cmd.Context(), cfg, f)
+		},
+	}
+
+	bindXBlocksFlags(cmd.Flags(), &cfg, &file)
+
+	return cmd
+}
@@ -34,13 +34,13 @@ do
 done
 
 echo "Generating gogo protos for cosmos module types"
-for DIR in halo/*/types/ octane/*/types/ halo/genutil/genserve
+for DIR in halo/*/types/ octane/*/types/ halo/genutil/genserve halo/attest/attestserve
 do
   bufgen gogo "${DIR}"
 done
 
 echo "Generating orm protos for cosmos keeper orm"
-for DIR in halo/*/keeper/ octane/*/keeper/ monitor/xmonitor/* solver/app relayer/app/cursor
+for DIR in halo/*/keeper/ octane/*/keeper/ monitor/xmonitor/* solver/app relayer/app/cursor relayer/app/gasmodel lib/txmgr
 do
   bufgen orm "${DIR}"
 done
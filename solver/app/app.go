@@ -103,27 +103,55 @@ func Run(ctx context.Context, cfg Config) error {
 		return errors.Wrap(err, "create cursor store")
 	}
 
+	orders, err := newOrderStore(db)
+	if err != nil {
+		return errors.Wrap(err, "create order store")
+	}
+
 	addrs, err := contracts.GetAddresses(ctx, network.ID)
 	if err != nil {
 		return errors.Wrap(err, "get contract addresses")
 	}
 
-	err = startEventStreams(ctx, network, xprov, backends, solverAddr, addrs, cursors)
+	fees := cfg.FeeSchedule()
+	if err := fees.Verify(); err != nil {
+		return err
+	}
+
+	engine := quoteEngine{
+		pricer:   newPricer(ctx, network.ID),
+		fees:     fees,
+		fillCost: newFillCoster(backends),
+	}
+
+	if cfg.FirmQuoteTTL > 0 {
+		signer := newQuoteSigner(backends, network.ID.Static().OmniExecutionChainID, solverAddr)
+		domain := quoteDomain{ChainID: network.ID.Static().OmniExecutionChainID, Solver: solverAddr}
+		engine.book = newQuoteBook(cfg.FirmQuoteTTL, domain, signer, newBalancer(backends, solverAddr))
+	}
+
+	err = startEventStreams(ctx, network, xprov, backends, engine, solverAddr, addrs, cursors, orders)
 	if err != nil {
 		return errors.Wrap(err, "start event streams")
 	}
 
 	log.Info(ctx, "Serving API", "address", cfg.APIAddr)
 	apiChan := serveAPI(cfg.APIAddr, map[string]http.Handler{
-		"/api/v1/quote":     newQuoteHandler(quoter),
-		"/api/v1/check":     newCheckHandler(newChecker(backends, solverAddr, addrs.SolverNetInbox, addrs.SolverNetOutbox)),
-		"/api/v1/contracts": newContractsHandler(addrs),
+		"/api/v1/quote":       newQuoteHandler(newQuoter(engine), cfg.FirmQuoteAPIKeys),
+		"/api/v1/check":       newCheckHandler(newChecker(backends, engine, solverAddr, addrs.SolverNetInbox, addrs.SolverNetOutbox)),
+		"/api/v1/contracts":   newContractsHandler(addrs),
+		"/api/v1/orders":      newOrdersHandler(orders),
+		"/api/v1/orders/{id}": newOrderHandler(orders),
 	})
 
 	if err := approveOutboxes(ctx, network, backends, solverAddr); err != nil {
 		return errors.Wrap(err, "approve outboxes")
 	}
 
+	if err := startRebalancer(ctx, cfg.Rebalance, network, backends, solverAddr, addrs.L1Bridge); err != nil {
+		return errors.Wrap(err, "start rebalancer")
+	}
+
 	select {
 	case <-ctx.Done():
 		log.Info(ctx, "Shutdown detected, stopping...")
@@ -183,6 +211,50 @@ func makePortalRegistry(network netconf.ID, endpoints xchain.RPCEndpoints) (*bin
 	return resp, nil
 }
 
+// startRebalancer starts the inventory rebalancer if any bands are configured.
+func startRebalancer(
+	ctx context.Context,
+	cfg RebalanceConfig,
+	network netconf.Network,
+	backends ethbackend.Backends,
+	solverAddr common.Address,
+	l1BridgeAddr common.Address,
+) error {
+	bands, err := parseRebalanceBands(network, cfg.Bands)
+	if err != nil {
+		return err
+	} else if len(bands) == 0 {
+		log.Debug(ctx, "Inventory rebalancing disabled, no bands configured")
+		return nil
+	} else if cfg.Interval <= 0 {
+		return errors.New("invalid rebalance interval", "interval", cfg.Interval)
+	}
+
+	ethBridges, err := parseETHBridges(network, cfg.ETHBridges)
+	if err != nil {
+		return err
+	}
+
+	log.Info(ctx, "Starting inventory rebalancer", "bands", len(bands), "interval", cfg.Interval, "dry_run", cfg.DryRun)
+
+	r := rebalancer{
+		backends:   backends,
+		solverAddr: solverAddr,
+		bands:      bands,
+		bridger: combineBridgers(
+			newL1Bridger(network.ID, backends, solverAddr, l1BridgeAddr),
+			newETHBridger(network.ID, backends, solverAddr, ethBridges),
+		),
+		dryRun:   cfg.DryRun,
+		interval: cfg.Interval,
+		pending:  newPendingTransfers(),
+		now:      time.Now,
+	}
+	go r.Run(ctx)
+
+	return nil
+}
+
 // startEventStreams starts the event streams for the solver.
 // TODO(corver): Make this robust against chains not be available on startup.
 func startEventStreams(
@@ -190,9 +262,11 @@ func startEventStreams(
 	network netconf.Network,
 	xprov xchain.Provider,
 	backends ethbackend.Backends,
+	engine quoteEngine,
 	solverAddr common.Address,
 	addrs contracts.Addresses,
 	cursors *cursors,
+	orders *orderStore,
 ) error {
 	inboxChains, err := detectContractChains(ctx, network, backends, addrs.SolverNetInbox)
 	if err != nil {
@@ -275,14 +349,18 @@ func startEventStreams(
 	deps := procDeps{
 		ParseID:      newIDParser(inboxContracts),
 		GetOrder:     newOrderGetter(inboxContracts),
-		ShouldReject: newShouldRejector(backends, solverAddr, addrs.SolverNetOutbox),
+		ShouldReject: newShouldRejector(backends, engine, solverAddr, addrs.SolverNetOutbox),
 		DidFill:      newDidFiller(outboxContracts),
 		Reject:       newRejector(inboxContracts, backends, solverAddr),
 		Fill:         newFiller(outboxContracts, backends, solverAddr, addrs.SolverNetOutbox),
 		Claim:        newClaimer(inboxContracts, backends, solverAddr),
+		RecordOrder:  newOrderRecorder(orders, engine, addrs.SolverNetOutbox),
 		SetCursor:    cursorSetter,
 		ChainName:    network.ChainName,
 		TargetName:   targetName,
+
+		BlockHeader:    newHeaderFetcher(backends),
+		RollbackOrders: orders.Rollback,
 	}
 
 	for _, chain := range inboxChains {
@@ -303,6 +381,7 @@ func streamEventsForever(
 	inboxAddr common.Address,
 ) {
 	backoff := expbackoff.New(ctx, expbackoff.WithPeriodicConfig(time.Second*5))
+	blocks := newBlockTracker() // Tracked across restarts to detect reorgs
 	for {
 		from, ok, err := cursors.Get(ctx, xchain.ChainVersion{ID: chainID, ConfLevel: confLevel})
 		if !ok || err != nil {
@@ -319,7 +398,7 @@ func streamEventsForever(
 			FilterAddress: inboxAddr,
 			FilterTopics:  solvernet.AllEventTopics(),
 		}
-		err = xprov.StreamEventLogs(ctx, req, newEventProcessor(deps, chainID))
+		err = xprov.StreamEventLogs(ctx, req, newEventProcessor(deps, chainID, blocks))
 		if ctx.Err() != nil {
 			return
 		}
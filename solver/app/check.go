@@ -30,7 +30,7 @@ type (
 
 // newChecker returns a checkFunc that can be used to see if an order would be accepted or rejected.
 // It is the logic behind the /check endpoint.
-func newChecker(backends ethbackend.Backends, solverAddr, inboxAddr, outboxAddr common.Address) checkFunc {
+func newChecker(backends ethbackend.Backends, engine quoteEngine, solverAddr, inboxAddr, outboxAddr common.Address) checkFunc {
 	return func(ctx context.Context, req CheckRequest) error {
 		if req.SourceChainID == req.DestinationChainID {
 			return newRejection(rejectSameChain, errors.New("source and destination chain are the same"))
@@ -56,17 +56,12 @@ func newChecker(backends ethbackend.Backends, solverAddr, inboxAddr, outboxAddr
 			return err
 		}
 
-		quote, err := getQuote([]Token{deposit.Token}, expenses)
+		quoteID, err := checkQuote(ctx, engine, req.Owner, []Payment{deposit}, expenses, nil)
 		if err != nil {
 			return err
 		}
 
-		err = coversQuote([]Payment{deposit}, quote)
-		if err != nil {
-			return err
-		}
-
-		if err := checkLiquidity(ctx, expenses, dstBackend, solverAddr); err != nil {
+		if err := checkLiquidity(ctx, expenses, dstBackend, solverAddr, engine.book, quoteID); err != nil {
 			return err
 		}
 
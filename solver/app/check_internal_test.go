@@ -31,7 +31,7 @@ func TestCheck(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			backends, clients := testBackends(t)
 
-			handler := newCheckHandler(newChecker(backends, solver, inbox, outbox))
+			handler := newCheckHandler(newChecker(backends, quoteEngine{fees: DefaultFeeSchedule()}, solver, inbox, outbox))
 
 			if tt.mock != nil {
 				tt.mock(clients)
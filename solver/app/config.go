@@ -3,6 +3,7 @@ package app
 import (
 	"bytes"
 	"text/template"
+	"time"
 
 	"github.com/omni-network/omni/lib/buildinfo"
 	"github.com/omni-network/omni/lib/errors"
@@ -25,6 +26,23 @@ type Config struct {
 	LoadGenPrivKey string
 	DBDir          string
 	FeatureFlags   feature.Flags
+	FeeBips        int64
+	RouteFeeBips   map[string]int64
+	FirmQuoteTTL   time.Duration
+	// FirmQuoteAPIKeys authenticate callers allowed to request firm quotes, which reserve solver inventory.
+	FirmQuoteAPIKeys []string
+	Rebalance        RebalanceConfig
+}
+
+// RebalanceConfig configures solver inventory rebalancing.
+type RebalanceConfig struct {
+	// Bands defines target inventory bands keyed by "<chain_name>:<token_symbol>", with values "<min>-<max>" in token units.
+	// Rebalancing is disabled if empty.
+	Bands map[string]string
+	// ETHBridges defines OP-stack L1StandardBridge addresses used to deposit L1 ETH to L2s, keyed by L2 chain name.
+	ETHBridges map[string]string
+	Interval   time.Duration
+	DryRun     bool
 }
 
 func DefaultConfig() Config {
@@ -34,6 +52,23 @@ func DefaultConfig() Config {
 		APIAddr:        ":26661",
 		DBDir:          "./db",
 		FeatureFlags:   feature.Flags{}, // Zero enabled flags by default (note not nil).
+		FeeBips:        defaultFeeBips,
+		RouteFeeBips:   map[string]int64{},
+		FirmQuoteTTL:   0, // Firm quotes disabled by default.
+		Rebalance: RebalanceConfig{
+			Bands:      map[string]string{},
+			ETHBridges: map[string]string{},
+			Interval:   5 * time.Minute,
+			DryRun:     true,
+		},
+	}
+}
+
+// FeeSchedule returns the configured fee schedule.
+func (c Config) FeeSchedule() FeeSchedule {
+	return FeeSchedule{
+		DefaultBips: c.FeeBips,
+		RouteBips:   c.RouteFeeBips,
 	}
 }
 
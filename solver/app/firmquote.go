@@ -0,0 +1,258 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxCallerQuotes is the maximum number of outstanding (unused and unexpired) firm quotes per caller.
+const maxCallerQuotes = 16
+
+// errQuoteRateLimited is returned when a caller has too many outstanding firm quotes.
+var errQuoteRateLimited = errors.New("too many outstanding firm quotes")
+
+// firmQuote is a signed quote binding the solver to fill an order matching its terms until expiry.
+// Quotes are bound to the order owner, so only orders opened by the owner reference a quote.
+// Since orders do not carry a quote ID on-chain, orders reference a firm quote by matching its owner and terms:
+// same chains and tokens, a deposit of at least the quoted deposit and an expense of at most the quoted expense.
+type firmQuote struct {
+	ID          common.Hash
+	Owner       common.Address
+	SrcChainID  uint64
+	DestChainID uint64
+	Deposit     Payment
+	Expense     Payment
+	Expiry      time.Time
+	Nonce       common.Hash // Random nonce making quote IDs unique
+	Signature   [65]byte
+
+	// caller is the authenticated API caller that requested the quote.
+	caller string
+	// usedBy is the order that the quote was honored for, it no longer reserves inventory once used.
+	usedBy *OrderID
+}
+
+// matches returns true if the order owner, deposit and expense meet the quote terms.
+func (q firmQuote) matches(owner common.Address, deposit, expense Payment) bool {
+	return owner == q.Owner &&
+		deposit.Token.ChainID == q.SrcChainID &&
+		expense.Token.ChainID == q.DestChainID &&
+		deposit.Token.Address == q.Deposit.Token.Address &&
+		expense.Token.Address == q.Expense.Token.Address &&
+		deposit.Amount.Cmp(q.Deposit.Amount) >= 0 &&
+		expense.Amount.Cmp(q.Expense.Amount) <= 0
+}
+
+// quoteDomain separates firm quote signatures of different solvers and networks.
+type quoteDomain struct {
+	ChainID uint64         // Chain ID of the signing key, i.e., the Omni EVM.
+	Solver  common.Address // Address of the signing solver.
+}
+
+// firmQuoteID returns the quote ID committing to the domain and all terms of the quote.
+// It is the digest signed by the solver, see types.FirmQuoteTerms.ID for the preimage.
+func firmQuoteID(domain quoteDomain, owner common.Address, deposit, expense Payment, expiry time.Time, nonce common.Hash) common.Hash {
+	return types.FirmQuoteTerms{
+		SignerChainID: domain.ChainID,
+		Solver:        domain.Solver,
+		Owner:         owner,
+		SourceChainID: deposit.Token.ChainID,
+		DestChainID:   expense.Token.ChainID,
+		Deposit:       QuoteUnit{Token: deposit.Token.Address, Amount: deposit.Amount},
+		Expense:       QuoteUnit{Token: expense.Token.Address, Amount: expense.Amount},
+		Expiry:        expiry.Unix(),
+		Nonce:         nonce,
+	}.ID()
+}
+
+// quoteBook issues firm quotes and tracks the inventory they reserve until used or expired.
+// Quotes are kept in memory only, so outstanding quotes are not honored after a restart.
+type quoteBook struct {
+	ttl     time.Duration
+	domain  quoteDomain
+	sign    func(ctx context.Context, digest [32]byte) ([65]byte, error)
+	balance func(ctx context.Context, tkn Token) (*big.Int, error)
+	now     func() time.Time
+
+	mu     sync.Mutex
+	quotes map[common.Hash]*firmQuote
+}
+
+func newQuoteBook(
+	ttl time.Duration,
+	domain quoteDomain,
+	sign func(ctx context.Context, digest [32]byte) ([65]byte, error),
+	balance func(ctx context.Context, tkn Token) (*big.Int, error),
+) *quoteBook {
+	return &quoteBook{
+		ttl:     ttl,
+		domain:  domain,
+		sign:    sign,
+		balance: balance,
+		now:     time.Now,
+		quotes:  make(map[common.Hash]*firmQuote),
+	}
+}
+
+// Issue returns a new signed firm quote for orders by owner, reserving the expense inventory until expiry.
+// Callers are limited to maxCallerQuotes outstanding quotes.
+func (b *quoteBook) Issue(ctx context.Context, caller string, owner common.Address, deposit, expense Payment) (firmQuote, error) {
+	bal, err := b.balance(ctx, expense.Token)
+	if err != nil {
+		return firmQuote{}, errors.Wrap(err, "get balance")
+	}
+
+	var nonce common.Hash
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return firmQuote{}, errors.Wrap(err, "random nonce")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneUnsafe()
+
+	var outstanding int
+	for _, quote := range b.quotes {
+		if quote.caller == caller && quote.usedBy == nil {
+			outstanding++
+		}
+	}
+	if outstanding >= maxCallerQuotes {
+		return firmQuote{}, errQuoteRateLimited
+	}
+
+	available := new(big.Int).Sub(bal, b.reservedUnsafe(expense.Token, common.Hash{}))
+	if available.Cmp(expense.Amount) < 0 {
+		return firmQuote{}, newRejection(rejectInsufficientInventory, errors.New("insufficient unreserved inventory", "available", available))
+	}
+
+	expiry := b.now().Add(b.ttl).Truncate(time.Second)
+	id := firmQuoteID(b.domain, owner, deposit, expense, expiry, nonce)
+
+	sig, err := b.sign(ctx, id)
+	if err != nil {
+		return firmQuote{}, errors.Wrap(err, "sign quote")
+	}
+
+	quote := &firmQuote{
+		ID:          id,
+		Owner:       owner,
+		SrcChainID:  deposit.Token.ChainID,
+		DestChainID: expense.Token.ChainID,
+		Deposit:     deposit,
+		Expense:     expense,
+		Expiry:      expiry,
+		Nonce:       nonce,
+		Signature:   sig,
+		caller:      caller,
+	}
+	b.quotes[id] = quote
+
+	return *quote, nil
+}
+
+// Match returns the ID of an unexpired firm quote of the owner matching the single deposit and expense, or false.
+// If an order ID is provided, the matched quote is used by that order and no longer matches other orders.
+//
+// Note that the quote is used when matched, before the order is filled, so a failed fill burns the quote:
+// only retries of the same order still match it, and it no longer reserves inventory.
+func (b *quoteBook) Match(owner common.Address, deposits, expenses []Payment, orderID *OrderID) (common.Hash, bool) {
+	if b == nil || len(deposits) != 1 || len(expenses) != 1 {
+		return common.Hash{}, false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneUnsafe()
+
+	for id, quote := range b.quotes {
+		if quote.usedBy != nil && (orderID == nil || *quote.usedBy != *orderID) {
+			continue // Used by another order
+		} else if !quote.matches(owner, deposits[0], expenses[0]) {
+			continue
+		}
+
+		if orderID != nil {
+			used := *orderID
+			quote.usedBy = &used
+		}
+
+		return id, true
+	}
+
+	return common.Hash{}, false
+}
+
+// Reserved returns the inventory of the token reserved by unused unexpired quotes, excluding the provided quote.
+func (b *quoteBook) Reserved(tkn Token, exclude common.Hash) *big.Int {
+	if b == nil {
+		return big.NewInt(0)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneUnsafe()
+
+	return b.reservedUnsafe(tkn, exclude)
+}
+
+func (b *quoteBook) reservedUnsafe(tkn Token, exclude common.Hash) *big.Int {
+	resp := big.NewInt(0)
+	for id, quote := range b.quotes {
+		if id == exclude || quote.usedBy != nil {
+			continue
+		} else if quote.Expense.Token.ChainID != tkn.ChainID || quote.Expense.Token.Address != tkn.Address {
+			continue
+		}
+
+		resp.Add(resp, quote.Expense.Amount)
+	}
+
+	return resp
+}
+
+// pruneUnsafe deletes expired quotes. It is unsafe since it assumes the lock is held.
+func (b *quoteBook) pruneUnsafe() {
+	now := b.now()
+	for id, quote := range b.quotes {
+		if now.After(quote.Expiry) {
+			delete(b.quotes, id)
+		}
+	}
+}
+
+// newQuoteSigner returns a function signing quote digests with the solver key.
+func newQuoteSigner(backends ethbackend.Backends, chainID uint64, solverAddr common.Address) func(context.Context, [32]byte) ([65]byte, error) {
+	return func(ctx context.Context, digest [32]byte) ([65]byte, error) {
+		backend, err := backends.Backend(chainID)
+		if err != nil {
+			return [65]byte{}, err
+		}
+
+		return backend.Sign(ctx, solverAddr, digest)
+	}
+}
+
+// newBalancer returns a function returning the solver balance of a token.
+func newBalancer(backends ethbackend.Backends, solverAddr common.Address) func(context.Context, Token) (*big.Int, error) {
+	return func(ctx context.Context, tkn Token) (*big.Int, error) {
+		backend, err := backends.Backend(tkn.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return balanceOf(ctx, tkn, backend, solverAddr)
+	}
+}
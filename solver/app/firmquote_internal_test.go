@@ -0,0 +1,199 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/k1util"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteBook(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	privKey := secp256k1.GenPrivKey()
+	solverAddr, err := k1util.PubKeyToAddress(privKey.PubKey())
+	require.NoError(t, err)
+
+	sign := func(_ context.Context, digest [32]byte) ([65]byte, error) {
+		return k1util.Sign(privKey, digest)
+	}
+	balance := func(context.Context, Token) (*big.Int, error) {
+		return ether(3), nil
+	}
+
+	now := time.Unix(1_000_000, 0)
+	domain := quoteDomain{ChainID: evmchain.IDOmniOmega, Solver: solverAddr}
+	book := newQuoteBook(time.Minute, domain, sign, balance)
+	book.now = func() time.Time { return now }
+
+	// Quote 1 OMNI (deposit) for 1 ETH (expense), which is never profitable at current prices.
+	deposit := Payment{Token: nativeOMNI(evmchain.IDOmniOmega), Amount: ether(1)}
+	expense := Payment{Token: nativeETH(evmchain.IDHolesky), Amount: ether(2)}
+
+	owner, other := tutil.RandomAddress(), tutil.RandomAddress()
+	const caller = "caller"
+
+	quote, err := book.Issue(ctx, caller, owner, deposit, expense)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Minute), quote.Expiry)
+
+	// Quote ID is domain separated
+	otherDomain := quoteDomain{ChainID: evmchain.IDOmniOmega, Solver: tutil.RandomAddress()}
+	require.NotEqual(t, firmQuoteID(domain, owner, deposit, expense, quote.Expiry, common.Hash{}), firmQuoteID(otherDomain, owner, deposit, expense, quote.Expiry, common.Hash{}))
+
+	// Signature is by the solver over the quote ID
+	ok, err := k1util.Verify(solverAddr, quote.ID, quote.Signature)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Inventory is reserved
+	require.Equal(t, ether(2), book.Reserved(expense.Token, tutil.RandomHash()))
+	require.Equal(t, ether(0), book.Reserved(expense.Token, quote.ID))
+	_, err = book.Issue(ctx, caller, owner, deposit, expense)
+	r := new(RejectionError)
+	require.ErrorAs(t, err, &r)
+	require.Equal(t, rejectInsufficientInventory, r.Reason)
+
+	// Firm quote is honored by checkQuote, even though the engine would reject the order.
+	engine := quoteEngine{fees: DefaultFeeSchedule(), book: book}
+	quoteID, err := checkQuote(ctx, engine, owner, []Payment{deposit}, []Payment{expense}, nil)
+	require.NoError(t, err)
+	require.Equal(t, quote.ID, quoteID)
+
+	// Orders by other owners are not honored
+	_, ok = book.Match(other, []Payment{deposit}, []Payment{expense}, nil)
+	require.False(t, ok)
+
+	// Orders with worse terms are not honored
+	lessDeposit := Payment{Token: deposit.Token, Amount: ether(0)}
+	_, err = checkQuote(ctx, engine, owner, []Payment{lessDeposit}, []Payment{expense}, nil)
+	require.Error(t, err)
+
+	// Used by an order, it no longer reserves inventory nor matches other orders.
+	orderID := OrderID(tutil.RandomHash())
+	_, ok = book.Match(owner, []Payment{deposit}, []Payment{expense}, &orderID)
+	require.True(t, ok)
+	_, ok = book.Match(owner, []Payment{deposit}, []Payment{expense}, &orderID) // Idempotent for the same order
+	require.True(t, ok)
+	otherID := OrderID(tutil.RandomHash())
+	_, ok = book.Match(owner, []Payment{deposit}, []Payment{expense}, &otherID)
+	require.False(t, ok)
+	require.Equal(t, ether(0), book.Reserved(expense.Token, [32]byte{}))
+
+	// Expired quotes are pruned
+	quote2, err := book.Issue(ctx, caller, owner, deposit, expense)
+	require.NoError(t, err)
+	now = now.Add(time.Minute + time.Second)
+	_, ok = book.Match(owner, []Payment{deposit}, []Payment{expense}, nil)
+	require.False(t, ok)
+	require.Equal(t, ether(0), book.Reserved(expense.Token, [32]byte{}))
+	require.NotEqual(t, quote.ID, quote2.ID)
+}
+
+// TestFirmQuoteResponse ensures clients can rebuild and verify the firm quote ID from the quote response alone.
+func TestFirmQuoteResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	privKey := secp256k1.GenPrivKey()
+	solverAddr, err := k1util.PubKeyToAddress(privKey.PubKey())
+	require.NoError(t, err)
+
+	sign := func(_ context.Context, digest [32]byte) ([65]byte, error) {
+		return k1util.Sign(privKey, digest)
+	}
+	balance := func(context.Context, Token) (*big.Int, error) {
+		return ether(3), nil
+	}
+
+	domain := quoteDomain{ChainID: evmchain.IDOmniMainnet, Solver: solverAddr}
+	engine := quoteEngine{fees: DefaultFeeSchedule(), book: newQuoteBook(time.Minute, domain, sign, balance)}
+
+	owner := tutil.RandomAddress()
+	resp := quoteFor(ctx, engine, QuoteRequest{
+		SourceChainID:      evmchain.IDEthereum,
+		DestinationChainID: evmchain.IDArbitrumOne,
+		Expense:            JSONQuoteUnit{Amount: (*hexutil.Big)(ether(1))},
+		Owner:              owner,
+	}, "caller")
+	require.Nil(t, resp.Error)
+	require.NotNil(t, resp.QuoteID)
+
+	// Round-trip the response through JSON, as received by clients.
+	bz, err := json.Marshal(resp)
+	require.NoError(t, err)
+	var received QuoteResponse
+	require.NoError(t, json.Unmarshal(bz, &received))
+
+	terms, ok := received.FirmQuoteTerms()
+	require.True(t, ok)
+	require.Equal(t, owner, terms.Owner)
+	require.Equal(t, *received.QuoteID, terms.ID())
+
+	var sig [65]byte
+	copy(sig[:], received.Signature)
+	ok, err = k1util.Verify(solverAddr, terms.ID(), sig)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// Indicative quotes have no firm terms
+	_, ok = quoteFor(ctx, engine, QuoteRequest{
+		SourceChainID:      evmchain.IDEthereum,
+		DestinationChainID: evmchain.IDArbitrumOne,
+		Expense:            JSONQuoteUnit{Amount: (*hexutil.Big)(ether(1))},
+	}, "caller").FirmQuoteTerms()
+	require.False(t, ok)
+}
+
+func TestNilQuoteBook(t *testing.T) {
+	t.Parallel()
+
+	var book *quoteBook
+	_, ok := book.Match(common.Address{}, []Payment{{Amount: ether(1)}}, []Payment{{Amount: ether(1)}}, nil)
+	require.False(t, ok)
+	require.Equal(t, big.NewInt(0), book.Reserved(Token{}, [32]byte{}))
+}
+
+func TestQuoteBookRateLimit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	sign := func(context.Context, [32]byte) ([65]byte, error) { return [65]byte{}, nil }
+	balance := func(context.Context, Token) (*big.Int, error) { return ether(1000), nil }
+	book := newQuoteBook(time.Minute, quoteDomain{}, sign, balance)
+
+	deposit := Payment{Token: nativeETH(evmchain.IDBaseSepolia), Amount: ether(1)}
+	expense := Payment{Token: nativeETH(evmchain.IDHolesky), Amount: ether(1)}
+	owner := tutil.RandomAddress()
+
+	for range maxCallerQuotes {
+		_, err := book.Issue(ctx, "spammer", owner, deposit, expense)
+		require.NoError(t, err)
+	}
+
+	_, err := book.Issue(ctx, "spammer", owner, deposit, expense)
+	require.ErrorIs(t, err, errQuoteRateLimited)
+
+	// Other callers are not affected
+	_, err = book.Issue(ctx, "other", tutil.RandomAddress(), deposit, expense)
+	require.NoError(t, err)
+
+	// Used quotes no longer count towards the limit
+	orderID := OrderID(tutil.RandomHash())
+	_, ok := book.Match(owner, []Payment{deposit}, []Payment{expense}, &orderID)
+	require.True(t, ok)
+	_, err = book.Issue(ctx, "spammer", owner, deposit, expense)
+	require.NoError(t, err)
+}
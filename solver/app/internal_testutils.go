@@ -398,7 +398,7 @@ func orderTestCases(t *testing.T, solver common.Address) []orderTestCase {
 				srcChainID: evmchain.IDBaseSepolia,
 				dstChainID: evmchain.IDHolesky,
 				// includes fee
-				deposits: []Deposit{{Amount: depositFor(ether(1), standardFeeBips)}},
+				deposits: []Deposit{{Amount: depositFor(ether(1), defaultFeeBips)}},
 				calls:    []Call{{Value: ether(1)}},
 				expenses: []Expense{{Amount: ether(1)}},
 			},
@@ -413,8 +413,8 @@ func orderTestCases(t *testing.T, solver common.Address) []orderTestCase {
 				dstChainID: evmchain.IDHolesky,
 				deposits: []Deposit{{
 					Amount: new(big.Int).Add(
-						depositFor(ether(1), standardFeeBips), // required deposit
-						gwei(1),                               // a little more
+						depositFor(ether(1), defaultFeeBips), // required deposit
+						gwei(1),                              // a little more
 					),
 				}},
 				calls:    []Call{{Value: ether(1)}},
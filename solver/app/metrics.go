@@ -33,6 +33,20 @@ var (
 		Help:      "Total number of rejected orders by chain and reason",
 	}, []string{"src_chain", "dest_chain", "target", "reason"})
 
+	reorgsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "solver_v2",
+		Subsystem: "processor",
+		Name:      "reorgs_total",
+		Help:      "Total number of reorgs detected by chain",
+	}, []string{"chain"})
+
+	reorgedOrders = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "solver_v2",
+		Subsystem: "processor",
+		Name:      "reorged_orders_total",
+		Help:      "Total number of orders rolled back due to reorgs by chain",
+	}, []string{"chain"})
+
 	tokenBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "solver_v2",
 		Subsystem: "liquidity",
@@ -40,6 +54,27 @@ var (
 		Help:      "Token balance of solver",
 	}, []string{"chain", "solver_addr", "token_addr", "token_symbol", "is_native"})
 
+	inventoryBandStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "solver_v2",
+		Subsystem: "rebalance",
+		Name:      "band_status",
+		Help:      "Inventory status relative to its rebalance band by chain and token: -1 below, 0 within, 1 above",
+	}, []string{"chain", "token_symbol"})
+
+	rebalanceTransfers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "solver_v2",
+		Subsystem: "rebalance",
+		Name:      "transfers_total",
+		Help:      "Total number of rebalance transfers by source and destination chain, token and status",
+	}, []string{"src_chain", "dst_chain", "token_symbol", "status"})
+
+	rebalanceUnrouted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "solver_v2",
+		Subsystem: "rebalance",
+		Name:      "unrouted_total",
+		Help:      "Total number of times inventory outside its band could not be rebalanced due to no supported route by chain and token",
+	}, []string{"chain", "token_symbol"})
+
 	apiLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: "solver_v2",
 		Subsystem: "api",
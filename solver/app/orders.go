@@ -0,0 +1,493 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/lib/contracts/solvernet"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/solver/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"cosmossdk.io/orm/model/ormlist"
+	"cosmossdk.io/orm/types/ormerrors"
+	db "github.com/cosmos/cosmos-db"
+)
+
+const (
+	defaultOrdersLimit = 100
+	maxOrdersLimit     = 1000
+)
+
+type (
+	OrderResponse  = types.OrderResponse
+	OrdersResponse = types.OrdersResponse
+)
+
+// orderUpdate is the result of processing an order event.
+type orderUpdate struct {
+	Status       solvernet.OrderStatus // Status of the processed event
+	Height       uint64                // Source chain height of the processed event
+	TxHash       common.Hash           // Source chain tx hash of the processed event
+	RejectReason rejectReason          // Reason if rejected by this solver
+	FillTxHash   common.Hash           // Destination chain tx hash if filled by this solver
+}
+
+// orderStore provides a thread-safe persisted order book of orders processed by the solver.
+type orderStore struct {
+	mu    sync.Mutex
+	table OrderRecordTable
+	now   func() time.Time
+}
+
+func newOrderStore(db db.DB) (*orderStore, error) {
+	dbStore, err := newSolverStore(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderStore{
+		table: dbStore.OrderRecordTable(),
+		now:   time.Now,
+	}, nil
+}
+
+// Record records the order update, appending a status transition.
+// It is idempotent, since events may be re-processed.
+func (s *orderStore) Record(ctx context.Context, order Order, u orderUpdate, fee *Payment) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now().Unix()
+
+	rec, err := s.table.Get(ctx, order.ID[:])
+	if ormerrors.IsNotFound(err) {
+		rec = &OrderRecord{
+			Id:          order.ID[:],
+			SrcChainId:  order.SourceChainID,
+			DestChainId: order.DestinationChainID,
+			CreatedAt:   now,
+		}
+	} else if err != nil {
+		return errors.Wrap(err, "get order")
+	}
+
+	t := &OrderTransition{
+		Status:    uint32(u.Status),
+		Height:    u.Height,
+		TxHash:    u.TxHash[:],
+		Timestamp: now,
+	}
+
+	if u.RejectReason != rejectNone {
+		t.RejectReason = u.RejectReason.String()
+	}
+
+	if u.FillTxHash != (common.Hash{}) {
+		t.FillTxHash = u.FillTxHash[:]
+	}
+
+	if fee != nil {
+		t.FeeAmount = fee.Amount.String()
+		t.FeeToken = fee.Token.Symbol
+	}
+
+	if !hasTransition(rec, u) {
+		rec.Transitions = append(rec.Transitions, t)
+	}
+
+	rec.Status = t.GetStatus()
+	rec.UpdatedAt = now
+	applyTransition(rec, t)
+
+	if err := s.table.Save(ctx, rec); err != nil {
+		return errors.Wrap(err, "save order")
+	}
+
+	return nil
+}
+
+// applyTransition sets the record fields set by the transition.
+func applyTransition(rec *OrderRecord, t *OrderTransition) {
+	if t.GetRejectReason() != "" {
+		rec.RejectReason = t.GetRejectReason()
+	}
+
+	if len(t.GetFillTxHash()) > 0 {
+		rec.FillTxHash = t.GetFillTxHash()
+	}
+
+	if t.GetFeeAmount() != "" {
+		rec.FeeAmount = t.GetFeeAmount()
+		rec.FeeToken = t.GetFeeToken()
+	}
+}
+
+// resetTransition clears the record fields set by the (removed) transition.
+func resetTransition(rec *OrderRecord, t *OrderTransition) {
+	if t.GetRejectReason() != "" {
+		rec.RejectReason = ""
+	}
+
+	if len(t.GetFillTxHash()) > 0 {
+		rec.FillTxHash = nil
+	}
+
+	if t.GetFeeAmount() != "" {
+		rec.FeeAmount = ""
+		rec.FeeToken = ""
+	}
+}
+
+// hasTransition returns true if the update's status transition was already recorded.
+func hasTransition(rec *OrderRecord, u orderUpdate) bool {
+	for _, t := range rec.GetTransitions() {
+		if t.GetStatus() == uint32(u.Status) && common.BytesToHash(t.GetTxHash()) == u.TxHash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Rollback removes the transitions of orders from the source chain recorded after the provided height,
+// since they were reorged out. Record fields set by removed transitions (e.g. reject reason or fill tx hash)
+// are reset to those of the remaining transitions. Orders without remaining transitions are deleted.
+// It returns the number of orders rolled back.
+func (s *orderStore) Rollback(ctx context.Context, srcChainID uint64, height uint64) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	iter, err := s.table.List(ctx, OrderRecordPrimaryKey{})
+	if err != nil {
+		return 0, errors.Wrap(err, "list orders")
+	}
+
+	var reorged []*OrderRecord
+	for iter.Next() {
+		rec, err := iter.Value()
+		if err != nil {
+			iter.Close()
+			return 0, errors.Wrap(err, "order value")
+		}
+
+		if rec.GetSrcChainId() != srcChainID {
+			continue
+		}
+
+		var keep, removed []*OrderTransition
+		for _, t := range rec.GetTransitions() {
+			if t.GetHeight() <= height {
+				keep = append(keep, t)
+			} else {
+				removed = append(removed, t)
+			}
+		}
+
+		if len(removed) == 0 {
+			continue
+		}
+
+		for _, t := range removed {
+			resetTransition(rec, t)
+		}
+		for _, t := range keep {
+			applyTransition(rec, t)
+		}
+
+		rec.Transitions = keep
+		reorged = append(reorged, rec)
+	}
+	iter.Close()
+
+	for _, rec := range reorged {
+		if len(rec.GetTransitions()) == 0 {
+			if err := s.table.Delete(ctx, rec); err != nil {
+				return 0, errors.Wrap(err, "delete order")
+			}
+
+			continue
+		}
+
+		rec.Status = rec.GetTransitions()[len(rec.GetTransitions())-1].GetStatus()
+		rec.UpdatedAt = s.now().Unix()
+
+		if err := s.table.Save(ctx, rec); err != nil {
+			return 0, errors.Wrap(err, "save order")
+		}
+	}
+
+	return len(reorged), nil
+}
+
+// Get returns the order record by ID, or false if not found.
+func (s *orderStore) Get(ctx context.Context, id OrderID) (*OrderRecord, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rec, err := s.table.Get(ctx, id[:])
+	if ormerrors.IsNotFound(err) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, errors.Wrap(err, "get order")
+	}
+
+	return rec, true, nil
+}
+
+// orderFilter filters listed orders. Zero fields match all orders.
+type orderFilter struct {
+	Status      solvernet.OrderStatus
+	SrcChainID  uint64
+	DestChainID uint64
+	Limit       int
+}
+
+func (f orderFilter) match(rec *OrderRecord) bool {
+	if f.Status != solvernet.StatusInvalid && rec.GetStatus() != uint32(f.Status) {
+		return false
+	} else if f.SrcChainID != 0 && rec.GetSrcChainId() != f.SrcChainID {
+		return false
+	} else if f.DestChainID != 0 && rec.GetDestChainId() != f.DestChainID {
+		return false
+	}
+
+	return true
+}
+
+// List returns the most recently updated orders matching the filter.
+func (s *orderStore) List(ctx context.Context, filter orderFilter) ([]*OrderRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	iter, err := s.table.List(ctx, OrderRecordUpdatedAtIndexKey{}, ormlist.Reverse())
+	if err != nil {
+		return nil, errors.Wrap(err, "list orders")
+	}
+	defer iter.Close()
+
+	var resp []*OrderRecord
+	for iter.Next() && len(resp) < filter.Limit {
+		rec, err := iter.Value()
+		if err != nil {
+			return nil, errors.Wrap(err, "order value")
+		}
+
+		if filter.match(rec) {
+			resp = append(resp, rec)
+		}
+	}
+
+	return resp, nil
+}
+
+// newOrderRecorder returns a function recording order updates, including the fee earned by fills.
+func newOrderRecorder(store *orderStore, engine quoteEngine, outboxAddr common.Address) func(ctx context.Context, order Order, u orderUpdate) error {
+	return func(ctx context.Context, order Order, u orderUpdate) error {
+		var fee *Payment
+		if u.FillTxHash != (common.Hash{}) {
+			if f, err := orderFee(engine, order, outboxAddr); err != nil {
+				log.Warn(ctx, "Failed calculating order fee (will record without)", err)
+			} else {
+				fee = &f
+			}
+		}
+
+		return store.Record(ctx, order, u, fee)
+	}
+}
+
+// orderFee returns the fee earned by filling the order, denominated in the deposit token.
+func orderFee(engine quoteEngine, order Order, outboxAddr common.Address) (Payment, error) {
+	deposits, err := parseMinReceived(order)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	expenses, err := parseMaxSpent(order, outboxAddr)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	if len(deposits) != 1 || len(expenses) != 1 {
+		return Payment{}, errors.New("only single deposit and expense supported")
+	}
+
+	deposit := deposits[0]
+	bips := engine.fees.bipsFor(deposit.Token, expenses[0].Token)
+
+	return Payment{
+		Token:  deposit.Token,
+		Amount: new(big.Int).Sub(deposit.Amount, expenseFor(deposit.Amount, bips)),
+	}, nil
+}
+
+// newOrderHandler returns a http handler that returns an order by ID.
+func newOrderHandler(store *orderStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, rr *http.Request) {
+		ctx := rr.Context()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		writeError := func(statusCode int, err error) {
+			log.DebugErr(ctx, "Error handling /orders/{id} request", err)
+
+			writeJSON(ctx, w, OrderResponse{
+				Error: &JSONErrorResponse{
+					Code:    statusCode,
+					Status:  http.StatusText(statusCode),
+					Message: removeBUG(err.Error()),
+				},
+			})
+		}
+
+		idBz, err := hexutil.Decode(rr.PathValue("id"))
+		if err != nil || len(idBz) != len(OrderID{}) {
+			writeError(http.StatusBadRequest, errors.New("invalid order id, expect 0x-prefixed 32 byte hex"))
+			return
+		}
+
+		rec, ok, err := store.Get(ctx, OrderID(idBz))
+		if err != nil {
+			writeError(http.StatusInternalServerError, err)
+			return
+		} else if !ok {
+			writeError(http.StatusNotFound, errors.New("order not found"))
+			return
+		}
+
+		order := toJSONOrder(rec)
+		writeJSON(ctx, w, OrderResponse{Order: &order})
+	})
+}
+
+// newOrdersHandler returns a http handler that returns the most recently updated orders.
+// Orders can be filtered by `status`, `srcChainId` and `destChainId` query parameters, and limited by `limit`.
+func newOrdersHandler(store *orderStore) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, rr *http.Request) {
+		ctx := rr.Context()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		writeError := func(statusCode int, err error) {
+			log.DebugErr(ctx, "Error handling /orders request", err)
+
+			writeJSON(ctx, w, OrdersResponse{
+				Error: &JSONErrorResponse{
+					Code:    statusCode,
+					Status:  http.StatusText(statusCode),
+					Message: removeBUG(err.Error()),
+				},
+			})
+		}
+
+		filter, err := parseOrderFilter(rr)
+		if err != nil {
+			writeError(http.StatusBadRequest, err)
+			return
+		}
+
+		recs, err := store.List(ctx, filter)
+		if err != nil {
+			writeError(http.StatusInternalServerError, err)
+			return
+		}
+
+		orders := make([]types.JSONOrder, 0, len(recs))
+		for _, rec := range recs {
+			orders = append(orders, toJSONOrder(rec))
+		}
+
+		writeJSON(ctx, w, OrdersResponse{Orders: orders})
+	})
+}
+
+// parseOrderFilter parses the order filter from the request query parameters.
+func parseOrderFilter(rr *http.Request) (orderFilter, error) {
+	query := rr.URL.Query()
+	filter := orderFilter{Limit: defaultOrdersLimit}
+
+	if status := query.Get("status"); status != "" {
+		var ok bool
+		for s := solvernet.StatusPending; s <= solvernet.StatusClaimed; s++ {
+			if s.String() == status {
+				filter.Status, ok = s, true
+			}
+		}
+		if !ok {
+			return orderFilter{}, errors.New("invalid status", "status", status)
+		}
+	}
+
+	parseUint := func(key string) (uint64, error) {
+		val := query.Get(key)
+		if val == "" {
+			return 0, nil
+		}
+
+		resp, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return 0, errors.New("invalid query parameter", "key", key, "value", val)
+		}
+
+		return resp, nil
+	}
+
+	var err error
+	if filter.SrcChainID, err = parseUint("srcChainId"); err != nil {
+		return orderFilter{}, err
+	} else if filter.DestChainID, err = parseUint("destChainId"); err != nil {
+		return orderFilter{}, err
+	}
+
+	if limit, err := parseUint("limit"); err != nil {
+		return orderFilter{}, err
+	} else if limit > maxOrdersLimit {
+		return orderFilter{}, errors.New("limit too large", "max", maxOrdersLimit)
+	} else if limit > 0 {
+		filter.Limit = int(limit)
+	}
+
+	return filter, nil
+}
+
+func toJSONOrder(rec *OrderRecord) types.JSONOrder {
+	resp := types.JSONOrder{
+		ID:                 common.BytesToHash(rec.GetId()),
+		SourceChainID:      rec.GetSrcChainId(),
+		DestinationChainID: rec.GetDestChainId(),
+		Status:             solvernet.OrderStatus(rec.GetStatus()).String(),
+		RejectReason:       rec.GetRejectReason(),
+		FeeToken:           rec.GetFeeToken(),
+		CreatedAt:          time.Unix(rec.GetCreatedAt(), 0).UTC(),
+		UpdatedAt:          time.Unix(rec.GetUpdatedAt(), 0).UTC(),
+		Transitions:        make([]types.JSONOrderTransition, 0, len(rec.GetTransitions())),
+	}
+
+	if len(rec.GetFillTxHash()) > 0 {
+		hash := common.BytesToHash(rec.GetFillTxHash())
+		resp.FillTxHash = &hash
+	}
+
+	if fee, ok := new(big.Int).SetString(rec.GetFeeAmount(), 10); ok {
+		resp.FeeAmount = (*hexutil.Big)(fee)
+	}
+
+	for _, t := range rec.GetTransitions() {
+		resp.Transitions = append(resp.Transitions, types.JSONOrderTransition{
+			Status:    solvernet.OrderStatus(t.GetStatus()).String(),
+			Height:    t.GetHeight(),
+			TxHash:    common.BytesToHash(t.GetTxHash()),
+			Timestamp: time.Unix(t.GetTimestamp(), 0).UTC(),
+		})
+	}
+
+	return resp
+}
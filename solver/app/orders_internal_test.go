@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/contracts/solvernet"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderStore(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store, err := newOrderStore(db.NewMemDB())
+	require.NoError(t, err)
+
+	var now int64
+	store.now = func() time.Time {
+		now++
+		return time.Unix(now, 0)
+	}
+
+	order1 := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 1, DestinationChainID: 2}
+	order2 := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 3, DestinationChainID: 2}
+
+	opened1 := orderUpdate{Status: solvernet.StatusPending, Height: 10, TxHash: tutil.RandomHash(), FillTxHash: tutil.RandomHash()}
+	fee := &Payment{Token: nativeETH(1), Amount: ether(1)}
+	require.NoError(t, store.Record(ctx, order1, opened1, fee))
+	require.NoError(t, store.Record(ctx, order1, opened1, fee)) // Idempotent
+
+	rejected2 := orderUpdate{Status: solvernet.StatusPending, Height: 20, TxHash: tutil.RandomHash(), RejectReason: rejectInsufficientInventory}
+	require.NoError(t, store.Record(ctx, order2, rejected2, nil))
+
+	filled1 := orderUpdate{Status: solvernet.StatusFilled, Height: 30, TxHash: tutil.RandomHash()}
+	require.NoError(t, store.Record(ctx, order1, filled1, nil))
+
+	rec, ok, err := store.Get(ctx, order1.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, solvernet.StatusFilled, rec.GetStatus())
+	require.Len(t, rec.GetTransitions(), 2)
+	require.Equal(t, opened1.FillTxHash[:], rec.GetFillTxHash())
+	require.Equal(t, ether(1).String(), rec.GetFeeAmount())
+	require.Equal(t, "ETH", rec.GetFeeToken())
+	require.EqualValues(t, 1, rec.GetCreatedAt())
+	require.EqualValues(t, 4, rec.GetUpdatedAt())
+
+	rec, ok, err = store.Get(ctx, order2.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, rejectInsufficientInventory.String(), rec.GetRejectReason())
+
+	_, ok, err = store.Get(ctx, OrderID(tutil.RandomHash()))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// List most recently updated first.
+	recs, err := store.List(ctx, orderFilter{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, recs, 2)
+	require.Equal(t, order1.ID[:], recs[0].GetId())
+	require.Equal(t, order2.ID[:], recs[1].GetId())
+
+	recs, err = store.List(ctx, orderFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+
+	recs, err = store.List(ctx, orderFilter{Status: solvernet.StatusPending, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.Equal(t, order2.ID[:], recs[0].GetId())
+
+	recs, err = store.List(ctx, orderFilter{SrcChainID: 1, DestChainID: 2, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, recs, 1)
+	require.Equal(t, order1.ID[:], recs[0].GetId())
+}
+
+func TestOrderHandlers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store, err := newOrderStore(db.NewMemDB())
+	require.NoError(t, err)
+
+	order := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 1, DestinationChainID: 2}
+	update := orderUpdate{Status: solvernet.StatusPending, Height: 10, TxHash: tutil.RandomHash(), RejectReason: rejectSameChain}
+	require.NoError(t, store.Record(ctx, order, update, nil))
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/v1/orders", newOrdersHandler(store))
+	mux.Handle("/api/v1/orders/{id}", newOrderHandler(store))
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/orders/"+common.Hash(order.ID).Hex(), nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var orderResp OrderResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&orderResp))
+	require.NotNil(t, orderResp.Order)
+	require.Equal(t, common.Hash(order.ID), orderResp.Order.ID)
+	require.Equal(t, "pending", orderResp.Order.Status)
+	require.Equal(t, rejectSameChain.String(), orderResp.Order.RejectReason)
+	require.Len(t, orderResp.Order.Transitions, 1)
+	require.Equal(t, update.TxHash, orderResp.Order.Transitions[0].TxHash)
+
+	rr = httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/api/v1/orders?status=pending&srcChainId=1", nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	var ordersResp OrdersResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&ordersResp))
+	require.Len(t, ordersResp.Orders, 1)
+	require.Equal(t, common.Hash(order.ID), ordersResp.Orders[0].ID)
+}
+
+func TestParseOrderFilter(t *testing.T) {
+	t.Parallel()
+
+	parse := func(query string) (orderFilter, error) {
+		return parseOrderFilter(httptest.NewRequest(http.MethodGet, "/api/v1/orders?"+query, nil))
+	}
+
+	filter, err := parse("")
+	require.NoError(t, err)
+	require.Equal(t, orderFilter{Limit: defaultOrdersLimit}, filter)
+
+	filter, err = parse("status=filled&srcChainId=1&destChainId=2&limit=5")
+	require.NoError(t, err)
+	require.Equal(t, orderFilter{Status: solvernet.StatusFilled, SrcChainID: 1, DestChainID: 2, Limit: 5}, filter)
+
+	for _, invalid := range []string{"status=foo", "srcChainId=x", "limit=-1", "limit=1001"} {
+		_, err := parse(invalid)
+		require.Error(t, err, invalid)
+	}
+}
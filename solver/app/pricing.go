@@ -0,0 +1,331 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/netconf"
+	tokenslib "github.com/omni-network/omni/lib/tokens"
+	"github.com/omni-network/omni/lib/tokens/coingecko"
+)
+
+const (
+	// defaultFeeBips is the default fee charged by the solver (0.3%).
+	defaultFeeBips = 30
+
+	// fillGasEstimate is the estimated gas used by a fill on the destination chain, excluding calls.
+	fillGasEstimate = 250_000
+
+	// routeWildcard matches any chain in a fee schedule route.
+	routeWildcard = "*"
+
+	// priceCacheEvictInterval is the interval at which cached token prices are evicted.
+	priceCacheEvictInterval = time.Minute
+)
+
+// FeeSchedule defines the fees charged by the solver per route.
+type FeeSchedule struct {
+	// DefaultBips is the fee in bips charged for routes without an override.
+	DefaultBips int64
+	// RouteBips overrides the fee in bips per route, keyed by "<src_chain>:<dest_chain>" names.
+	// Either chain may be the "*" wildcard. Exact matches take precedence over wildcards.
+	RouteBips map[string]int64
+}
+
+// DefaultFeeSchedule returns the default fee schedule.
+func DefaultFeeSchedule() FeeSchedule {
+	return FeeSchedule{
+		DefaultBips: defaultFeeBips,
+		RouteBips:   map[string]int64{},
+	}
+}
+
+// Verify returns an error if the fee schedule is invalid.
+func (s FeeSchedule) Verify() error {
+	if s.DefaultBips < 0 || s.DefaultBips >= 10_000 {
+		return errors.New("invalid default fee bips", "bips", s.DefaultBips)
+	}
+
+	for route, bips := range s.RouteBips {
+		src, dst, ok := strings.Cut(route, ":")
+		if !ok || src == "" || dst == "" {
+			return errors.New("invalid fee route, expect <src_chain>:<dest_chain>", "route", route)
+		} else if bips < 0 || bips >= 10_000 {
+			return errors.New("invalid route fee bips", "route", route, "bips", bips)
+		}
+	}
+
+	return nil
+}
+
+// bipsFor returns the fee in bips for a deposit and expense token pair.
+func (s FeeSchedule) bipsFor(deposit, expense Token) int64 {
+	// if OMNI for OMNI, charge no fee
+	if deposit.IsOMNI() && expense.IsOMNI() {
+		return 0
+	}
+
+	src := chainName(deposit.ChainID)
+	dst := chainName(expense.ChainID)
+
+	for _, route := range []string{
+		src + ":" + dst,
+		src + ":" + routeWildcard,
+		routeWildcard + ":" + dst,
+	} {
+		if bips, ok := s.RouteBips[route]; ok {
+			return bips
+		}
+	}
+
+	return s.DefaultBips
+}
+
+// chainName returns the chain name if known, otherwise the chain ID.
+// Unknown chains therefore only match wildcard routes, not routes of other chains.
+func chainName(chainID uint64) string {
+	meta, ok := evmchain.MetadataByID(chainID)
+	if !ok {
+		return strconv.FormatUint(chainID, 10)
+	}
+
+	return meta.Name
+}
+
+// fillCostFunc returns the native token cost of a fill on the destination chain.
+type fillCostFunc func(ctx context.Context, chainID uint64) (*big.Int, error)
+
+// newFillCoster returns a fillCostFunc estimating fill cost using the destination chain's suggested gas price.
+func newFillCoster(backends ethbackend.Backends) fillCostFunc {
+	return func(ctx context.Context, chainID uint64) (*big.Int, error) {
+		backend, err := backends.Backend(chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		gasPrice, err := backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "suggest gas price")
+		}
+
+		return new(big.Int).Mul(gasPrice, big.NewInt(fillGasEstimate)), nil
+	}
+}
+
+// quoteEngine quotes deposits and expenses, including across different tokens.
+type quoteEngine struct {
+	// pricer provides USD token prices for cross-token quotes. If nil, only same-token quotes are supported.
+	pricer tokenslib.Pricer
+	// fees defines the fees charged per route.
+	fees FeeSchedule
+	// fillCost returns the destination gas cost of a fill. If nil, gas costs are not included in quotes.
+	fillCost fillCostFunc
+	// book issues and honors firm quotes. If nil, quotes are indicative only.
+	book *quoteBook
+}
+
+// getQuote returns payment in `depositTkns` required to pay for `expenses`.
+func (e quoteEngine) getQuote(ctx context.Context, depositTkns []Token, expenses []Payment) ([]Payment, error) {
+	if len(depositTkns) != 1 {
+		return nil, newRejection(rejectInvalidDeposit, errors.New("only single deposit token supported"))
+	}
+
+	if len(expenses) != 1 {
+		return nil, newRejection(rejectInvalidExpense, errors.New("only single expense supported"))
+	}
+
+	expense := expenses[0]
+	depositTkn := depositTkns[0]
+
+	deposit, err := e.quoteDeposit(ctx, depositTkn, expense)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Payment{deposit}, nil
+}
+
+// quoteDeposit returns the deposit required to cover `expense`.
+func (e quoteEngine) quoteDeposit(ctx context.Context, tkn Token, expense Payment) (Payment, error) {
+	if err := e.checkPair(tkn, expense.Token); err != nil {
+		return Payment{}, err
+	}
+
+	prices, err := e.prices(ctx, tkn, expense.Token)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	gas, err := e.gasCost(ctx, expense.Token.ChainID, tkn, prices)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	// deposit = depositFor(convert(expense), bips) + gas
+	value := convert(expense.Amount, expense.Token, tkn, prices)
+	amount := depositFor(value, e.fees.bipsFor(tkn, expense.Token))
+
+	return Payment{
+		Token:  tkn,
+		Amount: amount.Add(amount, gas),
+	}, nil
+}
+
+// quoteExpense returns the expense allowed for `deposit`.
+func (e quoteEngine) quoteExpense(ctx context.Context, tkn Token, deposit Payment) (Payment, error) {
+	if err := e.checkPair(deposit.Token, tkn); err != nil {
+		return Payment{}, err
+	}
+
+	prices, err := e.prices(ctx, deposit.Token, tkn)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	gas, err := e.gasCost(ctx, tkn.ChainID, deposit.Token, prices)
+	if err != nil {
+		return Payment{}, err
+	}
+
+	// expense = convert(expenseFor(deposit - gas, bips))
+	net := new(big.Int).Sub(deposit.Amount, gas)
+	if net.Sign() <= 0 {
+		return Payment{}, newRejection(rejectInsufficientDeposit, errors.New("deposit does not cover fill gas cost"))
+	}
+
+	value := expenseFor(net, e.fees.bipsFor(deposit.Token, tkn))
+
+	return Payment{
+		Token:  tkn,
+		Amount: convert(value, deposit.Token, tkn, prices),
+	}, nil
+}
+
+// checkPair returns a rejection if the deposit and expense tokens cannot be quoted.
+func (e quoteEngine) checkPair(deposit, expense Token) error {
+	if deposit.ChainClass != expense.ChainClass {
+		// we should reject with UnsupportedDestChain before quoting tokens of different chain classes.
+		return newRejection(rejectInvalidDeposit, errors.New("deposit and expense must be of the same chain class (e.g. mainnet, testnet)"))
+	}
+
+	if deposit.Symbol != expense.Symbol && e.pricer == nil {
+		return newRejection(rejectInvalidDeposit, errors.New("deposit token must match expense token"))
+	}
+
+	return nil
+}
+
+// prices returns the USD prices required to quote the deposit and expense tokens,
+// including the destination native token used for gas.
+// It returns nil if no prices are required, i.e., for same-token quotes excluding gas.
+func (e quoteEngine) prices(ctx context.Context, deposit, expense Token) (map[tokenslib.Token]float64, error) {
+	required := make(map[tokenslib.Token]bool)
+	if deposit.Token != expense.Token {
+		required[deposit.Token] = true
+		required[expense.Token] = true
+	}
+
+	if e.fillCost != nil {
+		if native, ok := nativeToken(expense.ChainID); ok && native != deposit.Token {
+			required[native] = true
+			required[deposit.Token] = true
+		}
+	}
+
+	if len(required) == 0 {
+		return nil, nil
+	} else if e.pricer == nil {
+		return nil, newRejection(rejectInvalidDeposit, errors.New("deposit token must match expense token"))
+	}
+
+	tkns := make([]tokenslib.Token, 0, len(required))
+	for tkn := range required {
+		tkns = append(tkns, tkn)
+	}
+
+	prices, err := e.pricer.Price(ctx, tkns...)
+	if err != nil {
+		return nil, errors.Wrap(err, "get prices")
+	}
+
+	for _, tkn := range tkns {
+		if prices[tkn] <= 0 {
+			return nil, errors.New("missing token price", "token", tkn)
+		}
+	}
+
+	return prices, nil
+}
+
+// gasCost returns the destination gas cost of a fill, denominated in `tkn`.
+func (e quoteEngine) gasCost(ctx context.Context, destChainID uint64, tkn Token, prices map[tokenslib.Token]float64) (*big.Int, error) {
+	if e.fillCost == nil {
+		return big.NewInt(0), nil
+	}
+
+	native, ok := nativeToken(destChainID)
+	if !ok {
+		return nil, errors.New("unknown destination chain", "chain_id", destChainID)
+	}
+
+	cost, err := e.fillCost(ctx, destChainID)
+	if err != nil {
+		return nil, errors.Wrap(err, "fill cost")
+	}
+
+	return convert(cost, Token{Token: native}, tkn, prices), nil
+}
+
+// nativeToken returns the native token of the chain.
+func nativeToken(chainID uint64) (tokenslib.Token, bool) {
+	meta, ok := evmchain.MetadataByID(chainID)
+	if !ok {
+		return tokenslib.Token{}, false
+	}
+
+	return meta.NativeToken, true
+}
+
+// convert returns the amount of `from` tokens denominated in `to` tokens using USD prices.
+// Same tokens are converted 1:1 and do not require prices.
+func convert(amount *big.Int, from, to Token, prices map[tokenslib.Token]float64) *big.Int {
+	if from.Token == to.Token {
+		return new(big.Int).Set(amount)
+	}
+
+	// value = amount * price(from) / price(to) * 10^(to.decimals - from.decimals)
+	val := new(big.Float).SetInt(amount)
+	val.Mul(val, big.NewFloat(prices[from.Token]))
+	val.Quo(val, big.NewFloat(prices[to.Token]))
+
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(to.Decimals)), nil))
+	scale.Quo(scale, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(from.Decimals)), nil)))
+	val.Mul(val, scale)
+
+	resp, _ := val.Int(nil)
+
+	return resp
+}
+
+// newPricer returns a cached coingecko pricer, or a mock pricer with static prices for ephemeral networks.
+func newPricer(ctx context.Context, network netconf.ID) tokenslib.Pricer {
+	if network.IsEphemeral() {
+		return tokenslib.NewMockPricer(map[tokenslib.Token]float64{
+			tokenslib.OMNI:   5,
+			tokenslib.ETH:    3000,
+			tokenslib.STETH:  3000,
+			tokenslib.WSTETH: 3500,
+		})
+	}
+
+	pricer := tokenslib.NewCachedPricer(coingecko.New())
+	go pricer.ClearCacheForever(ctx, priceCacheEvictInterval)
+
+	return pricer
+}
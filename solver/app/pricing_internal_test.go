@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/netconf"
+	tokenslib "github.com/omni-network/omni/lib/tokens"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteEngine(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	pricer := tokenslib.NewMockPricer(map[tokenslib.Token]float64{
+		tokenslib.ETH:    2000,
+		tokenslib.WSTETH: 2400,
+		tokenslib.OMNI:   4,
+	})
+
+	holeskyETH := nativeETH(evmchain.IDHolesky)
+	holeskyWSTETH := wstETH(evmchain.IDHolesky, common.HexToAddress("0x8d09a4502cc8cf1547ad300e066060d043f6982d"))
+	baseETH := nativeETH(evmchain.IDBaseSepolia)
+	omegaOMNI := nativeOMNI(evmchain.IDOmniOmega)
+
+	tests := []struct {
+		name     string
+		engine   quoteEngine
+		deposit  Token
+		expense  Payment
+		expected *big.Int
+	}{
+		{
+			name:     "same token",
+			engine:   quoteEngine{fees: DefaultFeeSchedule()},
+			deposit:  baseETH,
+			expense:  Payment{Token: holeskyETH, Amount: ether(1)},
+			expected: depositFor(ether(1), defaultFeeBips),
+		},
+		{
+			name:     "cross token",
+			engine:   quoteEngine{pricer: pricer, fees: DefaultFeeSchedule()},
+			deposit:  baseETH,
+			expense:  Payment{Token: holeskyWSTETH, Amount: ether(1)},
+			expected: depositFor(mustBig("1200000000000000000"), defaultFeeBips), // 1 wstETH = 1.2 ETH
+		},
+		{
+			name:     "cross token to OMNI charges fee",
+			engine:   quoteEngine{pricer: pricer, fees: DefaultFeeSchedule()},
+			deposit:  holeskyETH,
+			expense:  Payment{Token: omegaOMNI, Amount: ether(500)},
+			expected: depositFor(ether(1), defaultFeeBips), // 500 OMNI = 1 ETH
+		},
+		{
+			name: "route override",
+			engine: quoteEngine{fees: FeeSchedule{
+				DefaultBips: defaultFeeBips,
+				RouteBips:   map[string]int64{"base_sepolia:holesky": 10, "*:holesky": 20},
+			}},
+			deposit:  baseETH,
+			expense:  Payment{Token: holeskyETH, Amount: ether(1)},
+			expected: depositFor(ether(1), 10),
+		},
+		{
+			name: "wildcard route override",
+			engine: quoteEngine{fees: FeeSchedule{
+				DefaultBips: defaultFeeBips,
+				RouteBips:   map[string]int64{"*:holesky": 20},
+			}},
+			deposit:  baseETH,
+			expense:  Payment{Token: holeskyETH, Amount: ether(1)},
+			expected: depositFor(ether(1), 20),
+		},
+		{
+			name: "includes gas",
+			engine: quoteEngine{
+				pricer: pricer,
+				fees:   DefaultFeeSchedule(),
+				fillCost: func(context.Context, uint64) (*big.Int, error) {
+					return gwei(1000), nil
+				},
+			},
+			deposit:  baseETH,
+			expense:  Payment{Token: omegaOMNI, Amount: ether(500)},
+			expected: new(big.Int).Add(depositFor(ether(1), defaultFeeBips), gwei(2)), // 1000 gwei OMNI = 2 gwei ETH
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			deposit, err := tt.engine.quoteDeposit(ctx, tt.deposit, tt.expense)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected.String(), deposit.Amount.String())
+
+			// Quoting the expense for the deposit results in the original expense (within rounding).
+			expense, err := tt.engine.quoteExpense(ctx, tt.expense.Token, deposit)
+			require.NoError(t, err)
+			diff := new(big.Int).Sub(tt.expense.Amount, expense.Amount)
+			require.LessOrEqual(t, diff.CmpAbs(big.NewInt(10_000)), 0, "expense=%s", expense.Amount)
+		})
+	}
+}
+
+func TestQuoteEngineCrossTokenWithoutPricer(t *testing.T) {
+	t.Parallel()
+
+	engine := quoteEngine{fees: DefaultFeeSchedule()}
+	_, err := engine.quoteDeposit(context.Background(), nativeETH(evmchain.IDBaseSepolia), Payment{
+		Token:  nativeOMNI(evmchain.IDOmniOmega),
+		Amount: ether(1),
+	})
+	require.ErrorContains(t, err, "deposit token must match expense token")
+}
+
+func TestFeeScheduleVerify(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DefaultFeeSchedule().Verify())
+	require.NoError(t, FeeSchedule{DefaultBips: 10, RouteBips: map[string]int64{"*:holesky": 0}}.Verify())
+	require.Error(t, FeeSchedule{DefaultBips: -1}.Verify())
+	require.Error(t, FeeSchedule{DefaultBips: 10, RouteBips: map[string]int64{"holesky": 0}}.Verify())
+	require.Error(t, FeeSchedule{DefaultBips: 10, RouteBips: map[string]int64{"*:holesky": 10_000}}.Verify())
+}
+
+func TestNewPricerEphemeral(t *testing.T) {
+	t.Parallel()
+
+	prices, err := newPricer(context.Background(), netconf.Devnet).Price(context.Background(), tokenslib.ETH)
+	require.NoError(t, err)
+	require.Positive(t, prices[tokenslib.ETH])
+}
+
+func TestFeeScheduleBips(t *testing.T) {
+	t.Parallel()
+
+	const unknownChain = 999_999_999
+	token := func(chainID uint64) Token {
+		return Token{Token: tokenslib.ETH, ChainID: chainID}
+	}
+
+	schedule := FeeSchedule{
+		DefaultBips: defaultFeeBips,
+		RouteBips: map[string]int64{
+			"*:holesky":   20,
+			"holesky:*":   40,
+			"999999999:*": 60,
+		},
+	}
+
+	// Route overrides don't apply to OMNI for OMNI.
+	require.EqualValues(t, 0, schedule.bipsFor(nativeOMNI(evmchain.IDOmniOmega), nativeOMNI(evmchain.IDOmniOmega)))
+
+	// Unknown chains only match wildcard routes (or routes by chain ID).
+	require.EqualValues(t, 20, schedule.bipsFor(token(unknownChain+1), token(evmchain.IDHolesky)))
+	require.EqualValues(t, 40, schedule.bipsFor(token(evmchain.IDHolesky), token(unknownChain)))
+	require.EqualValues(t, defaultFeeBips, schedule.bipsFor(token(evmchain.IDBaseSepolia), token(unknownChain+1)))
+	require.EqualValues(t, 60, schedule.bipsFor(token(unknownChain), token(evmchain.IDBaseSepolia)))
+}
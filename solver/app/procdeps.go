@@ -25,9 +25,17 @@ type procDeps struct {
 
 	Accept func(ctx context.Context, order Order) error
 	Reject func(ctx context.Context, order Order, reason rejectReason) error
-	Fill   func(ctx context.Context, order Order) error
+	Fill   func(ctx context.Context, order Order) (common.Hash, error)
 	Claim  func(ctx context.Context, order Order) error
 
+	// RecordOrder persists the result of processing an order event in the order book.
+	RecordOrder func(ctx context.Context, order Order, u orderUpdate) error
+
+	// BlockHeader returns the canonical block header of the chain height, used to detect reorgs.
+	BlockHeader func(ctx context.Context, chainID uint64, height uint64) (*types.Header, error)
+	// RollbackOrders reconciles the order book after a reorg, removing transitions after the height.
+	RollbackOrders func(ctx context.Context, chainID uint64, height uint64) (int, error)
+
 	// Monitoring helpers
 	TargetName func(Order) string
 	ChainName  func(chainID uint64) string
@@ -71,34 +79,34 @@ func newFiller(
 	outboxContracts map[uint64]*bindings.SolverNetOutbox,
 	backends ethbackend.Backends,
 	solverAddr, outboxAddr common.Address,
-) func(ctx context.Context, order Order) error {
-	return func(ctx context.Context, order Order) error {
+) func(ctx context.Context, order Order) (common.Hash, error) {
+	return func(ctx context.Context, order Order) (common.Hash, error) {
 		if order.DestinationSettler != outboxAddr {
-			return errors.New("destination settler mismatch [BUG] ", "got", order.DestinationSettler.Hex(), "expected", outboxAddr.Hex())
+			return common.Hash{}, errors.New("destination settler mismatch [BUG] ", "got", order.DestinationSettler.Hex(), "expected", outboxAddr.Hex())
 		}
 
 		destChainID := order.DestinationChainID
 		outbox, ok := outboxContracts[destChainID]
 		if !ok {
-			return errors.New("unknown chain")
+			return common.Hash{}, errors.New("unknown chain")
 		}
 
 		backend, err := backends.Backend(destChainID)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		callOpts := &bind.CallOpts{Context: ctx}
 		txOpts, err := backend.BindOpts(ctx, solverAddr)
 		if err != nil {
-			return err
+			return common.Hash{}, err
 		}
 
 		if ok, err := outbox.DidFill(callOpts, order.ID, order.FillOriginData); err != nil {
-			return errors.Wrap(err, "did fill")
+			return common.Hash{}, errors.Wrap(err, "did fill")
 		} else if ok {
 			log.Info(ctx, "Skipping already filled order", "order_id", order.ID)
-			return nil
+			return common.Hash{}, nil
 		}
 
 		nativeValue := big.NewInt(0)
@@ -107,7 +115,7 @@ func newFiller(
 				// We error on this case for now, as our contracts only allow single dest chain orders
 				// ERC7683 allows for orders with multiple destination chains, so continue-ing here
 				// would also be appropriate.
-				return errors.New("destination chain mismatch [BUG] ")
+				return common.Hash{}, errors.New("destination chain mismatch [BUG] ")
 			}
 
 			// zero token address means native token
@@ -119,16 +127,16 @@ func newFiller(
 			tknAddr := toEthAddr(output.Token)
 			tkn, ok := tokens.Find(destChainID, tknAddr)
 			if !ok {
-				return errors.New("unsupported token, should have been rejected [BUG]", "addr", tknAddr.Hex(), "chain_id", destChainID)
+				return common.Hash{}, errors.New("unsupported token, should have been rejected [BUG]", "addr", tknAddr.Hex(), "chain_id", destChainID)
 			}
 
 			isAppproved, err := isAppproved(ctx, tknAddr, backend, solverAddr, outboxAddr, output.Amount)
 			if err != nil {
-				return errors.Wrap(err, "is approved")
+				return common.Hash{}, errors.Wrap(err, "is approved")
 			}
 
 			if !isAppproved {
-				return errors.New("outbox not approved to spend token",
+				return common.Hash{}, errors.New("outbox not approved to spend token",
 					"token", tkn.Symbol,
 					"chain_id", destChainID,
 					"addr", tknAddr.Hex(),
@@ -140,25 +148,25 @@ func newFiller(
 		// xcall fee
 		fee, err := outbox.FillFee(callOpts, order.FillOriginData)
 		if err != nil {
-			return errors.Wrap(err, "get fulfill fee")
+			return common.Hash{}, errors.Wrap(err, "get fulfill fee")
 		}
 
 		txOpts.Value = new(big.Int).Add(nativeValue, fee)
 		fillerData := []byte{} // fillerData is optional ERC7683 custom filler specific data, unused in our contracts
 		tx, err := outbox.Fill(txOpts, order.ID, order.FillOriginData, fillerData)
 		if err != nil {
-			return errors.Wrap(err, "fill order", "custom", solvernet.DetectCustomError(err))
+			return common.Hash{}, errors.Wrap(err, "fill order", "custom", solvernet.DetectCustomError(err))
 		} else if _, err := backend.WaitMined(ctx, tx); err != nil {
-			return errors.Wrap(err, "wait mined")
+			return common.Hash{}, errors.Wrap(err, "wait mined")
 		}
 
 		if ok, err := outbox.DidFill(callOpts, order.ID, order.FillOriginData); err != nil {
-			return errors.Wrap(err, "did fill")
+			return common.Hash{}, errors.Wrap(err, "did fill")
 		} else if !ok {
-			return errors.New("fill failed [BUG]")
+			return common.Hash{}, errors.New("fill failed [BUG]")
 		}
 
-		return nil
+		return tx.Hash(), nil
 	}
 }
 
@@ -22,8 +22,17 @@ const (
 
 // newEventProcessor returns a callback provided to xchain.Provider::StreamEventLogs processing
 // all inbox contract events and driving order lifecycle.
-func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
+// Processed block hashes are tracked to detect reorgs, in which case the cursor and order book are
+// rolled back to the common ancestor and an error is returned, restarting the stream.
+func newEventProcessor(deps procDeps, chainID uint64, blocks *blockTracker) xchain.EventLogsCallback {
 	return func(ctx context.Context, height uint64, elogs []types.Log) error {
+		header, reorg, err := detectReorg(ctx, deps, chainID, blocks, height, elogs)
+		if err != nil {
+			return errors.Wrap(err, "detect reorg")
+		} else if reorg {
+			return rollback(ctx, deps, chainID, blocks, height)
+		}
+
 		for _, elog := range elogs {
 			event, ok := solvernet.EventByTopic(elog.Topics[0])
 			if !ok {
@@ -79,6 +88,12 @@ func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 				continue
 			}
 
+			update := orderUpdate{
+				Status: event.Status,
+				Height: height,
+				TxHash: elog.TxHash,
+			}
+
 			alreadyFilled := func() bool {
 				// ignore err. maybeReject will handle unsupported dest chain
 				filled, _ := deps.DidFill(ctx, order)
@@ -103,6 +118,8 @@ func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 					return false, errors.Wrap(err, "reject order")
 				}
 
+				update.RejectReason = reason
+
 				rejectedOrders.WithLabelValues(
 					deps.ChainName(order.SourceChainID),
 					deps.ChainName(order.DestinationChainID),
@@ -122,13 +139,19 @@ func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 				if didReject, err := maybeReject(); err != nil {
 					return err
 				} else if didReject {
+					if err := deps.RecordOrder(ctx, order, update); err != nil {
+						return errors.Wrap(err, "record order")
+					}
+
 					continue
 				}
 
 				log.Info(ctx, "Filling order")
-				if err := deps.Fill(ctx, order); err != nil {
+				fillTx, err := deps.Fill(ctx, order)
+				if err != nil {
 					return errors.Wrap(err, "fill order")
 				}
+				update.FillTxHash = fillTx
 			case statusFilled:
 				log.Info(ctx, "Claiming order")
 				if err := deps.Claim(ctx, order); err != nil {
@@ -140,9 +163,46 @@ func newEventProcessor(deps procDeps, chainID uint64) xchain.EventLogsCallback {
 				return errors.New("unknown status [BUG]")
 			}
 
+			if err := deps.RecordOrder(ctx, order, update); err != nil {
+				return errors.Wrap(err, "record order")
+			}
+
 			processedEvents.WithLabelValues(deps.ChainName(chainID), target, event.Status.String()).Inc()
 		}
 
+		blocks.Add(height, header.Hash())
+
 		return deps.SetCursor(ctx, chainID, height)
 	}
 }
+
+// rollback rolls back the cursor, order book and tracked blocks to the common ancestor of the reorg.
+// It returns an error so the stream is restarted from the rolled back cursor.
+func rollback(ctx context.Context, deps procDeps, chainID uint64, blocks *blockTracker, height uint64) error {
+	ancestor, err := findCommonAncestor(ctx, deps, chainID, blocks, height)
+	if err != nil {
+		return errors.Wrap(err, "find common ancestor")
+	}
+
+	rolledBack, err := deps.RollbackOrders(ctx, chainID, ancestor)
+	if err != nil {
+		return errors.Wrap(err, "rollback orders")
+	}
+
+	blocks.Rollback(ancestor)
+
+	// Re-process from the first height after the common ancestor.
+	if err := deps.SetCursor(ctx, chainID, ancestor+1); err != nil {
+		return errors.Wrap(err, "rollback cursor")
+	}
+
+	chain := deps.ChainName(chainID)
+	reorgsTotal.WithLabelValues(chain).Inc()
+	reorgedOrders.WithLabelValues(chain).Add(float64(rolledBack))
+
+	return errors.New("reorg detected, rolled back",
+		"height", height,
+		"ancestor", ancestor,
+		"orders", rolledBack,
+	)
+}
@@ -2,10 +2,12 @@ package app
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/omni-network/omni/contracts/bindings"
 	"github.com/omni-network/omni/lib/contracts/solvernet"
+	"github.com/omni-network/omni/lib/log"
 	"github.com/omni-network/omni/lib/tutil"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -76,7 +78,10 @@ func TestEventProcessor(t *testing.T) {
 			const chainID = 321
 			const height = 123
 			orderID := tutil.RandomHash()
+			fillTx := tutil.RandomHash()
+			header := &types.Header{Number: big.NewInt(height)}
 			actual := ignored
+			var recorded orderUpdate
 
 			deps := procDeps{
 				ParseID: func(_ uint64, log types.Log) (OrderID, error) {
@@ -108,12 +113,12 @@ func TestEventProcessor(t *testing.T) {
 
 					return nil
 				},
-				Fill: func(ctx context.Context, order Order) error {
+				Fill: func(ctx context.Context, order Order) (common.Hash, error) {
 					actual = fill
 					require.Equal(t, test.getStatus, order.Status)
 					require.EqualValues(t, orderID, order.ID)
 
-					return nil
+					return fillTx, nil
 				},
 				Claim: func(ctx context.Context, order Order) error {
 					actual = claim
@@ -128,15 +133,33 @@ func TestEventProcessor(t *testing.T) {
 
 					return nil
 				},
+				RecordOrder: func(ctx context.Context, order Order, u orderUpdate) error {
+					require.EqualValues(t, orderID, order.ID)
+					recorded = u
+
+					return nil
+				},
+				BlockHeader: func(ctx context.Context, c uint64, h uint64) (*types.Header, error) {
+					return header, nil
+				},
 				ChainName:  func(uint64) string { return "" },
 				TargetName: func(Order) string { return "" },
 			}
 
-			processor := newEventProcessor(deps, chainID)
+			processor := newEventProcessor(deps, chainID, newBlockTracker())
 
-			err := processor(context.Background(), height, []types.Log{{Topics: []common.Hash{test.event, orderID}}})
+			err := processor(log.WithNoopLogger(context.Background()), height, []types.Log{{Topics: []common.Hash{test.event, orderID}, BlockHash: header.Hash()}})
 			require.NoError(t, err)
 			require.Equal(t, test.expect, actual)
+
+			require.Equal(t, test.getStatus, recorded.Status)
+			require.EqualValues(t, height, recorded.Height)
+			require.Equal(t, test.rejectReason, recorded.RejectReason)
+			if test.expect == fill {
+				require.Equal(t, fillTx, recorded.FillTxHash)
+			} else {
+				require.Zero(t, recorded.FillTxHash)
+			}
 		})
 	}
 }
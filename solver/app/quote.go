@@ -1,28 +1,41 @@
 package app
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"math/big"
 	"net/http"
 
 	"github.com/omni-network/omni/lib/errors"
 	"github.com/omni-network/omni/solver/types"
-)
 
-// standardFeeBips is the standard fee charged by the solver (0.3%).
-const standardFeeBips = 30
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type (
 	QuoteRequest  = types.QuoteRequest
 	QuoteResponse = types.QuoteResponse
 	QuoteUnit     = types.QuoteUnit
 
-	quoteFunc func(QuoteRequest) QuoteResponse
+	// quoteFunc returns a quote for the request, caller is the authenticated API caller or empty.
+	quoteFunc func(ctx context.Context, req QuoteRequest, caller string) QuoteResponse
 )
 
-// quoter is quoteFunc that can be used to quoter an expense or deposit.
-// It is the logic behind the /quoter endpoint.
-func quoter(req QuoteRequest) QuoteResponse {
+// apiKeyHeader is the HTTP header authenticating API callers.
+const apiKeyHeader = "X-Api-Key"
+
+// newQuoter returns a quoteFunc that can be used to quote an expense or deposit.
+// It is the logic behind the /quote endpoint.
+func newQuoter(engine quoteEngine) quoteFunc {
+	return func(ctx context.Context, req QuoteRequest, caller string) QuoteResponse {
+		return quoteFor(ctx, engine, req, caller)
+	}
+}
+
+// quoteFor returns a quote for an expense or deposit.
+// Firm quotes are only issued to authenticated callers specifying the order owner, other quotes are indicative only.
+func quoteFor(ctx context.Context, engine quoteEngine, req QuoteRequest, caller string) QuoteResponse {
 	deposit := req.Deposit.Parse()
 	expense := req.Expense.Parse()
 
@@ -54,7 +67,7 @@ func quoter(req QuoteRequest) QuoteResponse {
 	}
 
 	returnQuote := func(depositAmt, expenseAmt *big.Int) QuoteResponse {
-		return QuoteResponse{
+		resp := QuoteResponse{
 			Deposit: QuoteUnit{
 				Token:  deposit.Token,
 				Amount: depositAmt,
@@ -64,10 +77,35 @@ func quoter(req QuoteRequest) QuoteResponse {
 				Amount: expenseAmt,
 			}.ToJSON(),
 		}
+
+		if engine.book == nil || caller == "" || req.Owner == (common.Address{}) {
+			return resp // Indicative quote only
+		}
+
+		firm, err := engine.book.Issue(ctx, caller, req.Owner, Payment{Token: depositTkn, Amount: depositAmt}, Payment{Token: expenseTkn, Amount: expenseAmt})
+		if errors.Is(err, errQuoteRateLimited) {
+			return returnErr(http.StatusTooManyRequests, err.Error())
+		} else if r := new(RejectionError); errors.As(err, &r) {
+			return returnErr(http.StatusBadRequest, r.Err.Error())
+		} else if err != nil {
+			return returnErr(http.StatusInternalServerError, removeBUG(err.Error()))
+		}
+
+		resp.QuoteID = &firm.ID
+		resp.Expiry = firm.Expiry.Unix()
+		resp.Signature = firm.Signature[:]
+		resp.SignerChainID = engine.book.domain.ChainID
+		resp.Solver = &engine.book.domain.Solver
+		resp.Owner = &firm.Owner
+		resp.SourceChainID = firm.SrcChainID
+		resp.DestChainID = firm.DestChainID
+		resp.Nonce = &firm.Nonce
+
+		return resp
 	}
 
 	if isDepositQuote {
-		quoted, err := quoteDeposit(depositTkn, Payment{Token: expenseTkn, Amount: expense.Amount})
+		quoted, err := engine.quoteDeposit(ctx, depositTkn, Payment{Token: expenseTkn, Amount: expense.Amount})
 		if err != nil {
 			return returnErr(http.StatusBadRequest, err.Error())
 		}
@@ -75,7 +113,7 @@ func quoter(req QuoteRequest) QuoteResponse {
 		return returnQuote(quoted.Amount, expense.Amount)
 	}
 
-	quoted, err := quoteExpense(expenseTkn, Payment{Token: depositTkn, Amount: deposit.Amount})
+	quoted, err := engine.quoteExpense(ctx, expenseTkn, Payment{Token: depositTkn, Amount: deposit.Amount})
 	if err != nil {
 		return returnErr(http.StatusBadRequest, err.Error())
 	}
@@ -83,13 +121,32 @@ func quoter(req QuoteRequest) QuoteResponse {
 	return returnQuote(deposit.Amount, quoted.Amount)
 }
 
+// containsAPIKey returns true if the key matches one of the apiKeys, comparing in constant time
+// to avoid leaking keys via timing.
+func containsAPIKey(apiKeys []string, key string) bool {
+	var found bool
+	for _, apiKey := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+			found = true
+		}
+	}
+
+	return found
+}
+
 // newQuoteHandler returns a handler for the /quote endpoint.
 // It is responsible to http request / response handling, and delegates
 // logic to a quoteFunc.
-func newQuoteHandler(quoteFunc quoteFunc) http.Handler {
+// Requests with an API key header matching one of the apiKeys are authenticated.
+func newQuoteHandler(quoteFunc quoteFunc, apiKeys []string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, rr *http.Request) {
 		ctx := rr.Context()
 
+		var caller string
+		if key := rr.Header.Get(apiKeyHeader); key != "" && containsAPIKey(apiKeys, key) {
+			caller = key
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 
 		var req QuoteRequest
@@ -105,76 +162,11 @@ func newQuoteHandler(quoteFunc quoteFunc) http.Handler {
 			return
 		}
 
-		res := quoteFunc(req)
+		res := quoteFunc(ctx, req, caller)
 		writeJSON(ctx, w, res)
 	})
 }
 
-// getQuote returns payment in `depositTkns` required to pay for `expenses`.
-func getQuote(depositTkns []Token, expenses []Payment) ([]Payment, error) {
-	if len(depositTkns) != 1 {
-		return nil, newRejection(rejectInvalidDeposit, errors.New("only single deposit token supported"))
-	}
-
-	if len(expenses) != 1 {
-		return nil, newRejection(rejectInvalidExpense, errors.New("only single expense supported"))
-	}
-
-	expense := expenses[0]
-	depositTkn := depositTkns[0]
-
-	deposit, err := quoteDeposit(depositTkn, expense)
-	if err != nil {
-		return nil, err
-	}
-
-	return []Payment{deposit}, nil
-}
-
-// quoteDeposit returns the deposit required to cover `expense`.
-func quoteDeposit(tkn Token, expense Payment) (Payment, error) {
-	if expense.Token.Symbol != tkn.Symbol {
-		return Payment{}, newRejection(rejectInvalidDeposit, errors.New("deposit token must match expense token"))
-	}
-
-	if expense.Token.ChainClass != tkn.ChainClass {
-		// we should reject with UnsupportedDestChain before quoting tokens of different chain classes.
-		return Payment{}, newRejection(rejectInvalidDeposit, errors.New("deposit and expense must be of the same chain class (e.g. mainnet, testnet)"))
-	}
-
-	return Payment{
-		Token:  tkn,
-		Amount: depositFor(expense.Amount, feeBipsFor(tkn)),
-	}, nil
-}
-
-// quoteExpense returns the expense allowed for `deposit`.
-func quoteExpense(tkn Token, deposit Payment) (Payment, error) {
-	if deposit.Token.Symbol != tkn.Symbol {
-		return Payment{}, newRejection(rejectInvalidDeposit, errors.New("deposit token must match expense token"))
-	}
-
-	if deposit.Token.ChainClass != tkn.ChainClass {
-		// we should reject with UnsupportedDestChain before quoting tokens of different chain classes.
-		return Payment{}, newRejection(rejectInvalidDeposit, errors.New("deposit and expense must be of the same chain class (e.g. mainnet, testnet)"))
-	}
-
-	return Payment{
-		Token:  tkn,
-		Amount: expenseFor(deposit.Amount, feeBipsFor(tkn)),
-	}, nil
-}
-
-// feeBipsFor returns the fee in bips for a given token.
-func feeBipsFor(tkn Token) int64 {
-	// if OMNI, charge no fee
-	if tkn.IsOMNI() {
-		return 0
-	}
-
-	return standardFeeBips
-}
-
 // depositFor returns the deposit required to cover `expense` with a fee in bips.
 func depositFor(expense *big.Int, bips int64) *big.Int {
 	// deposit = expense + (expense * bips / 10_000)
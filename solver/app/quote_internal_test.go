@@ -196,7 +196,7 @@ func TestQuote(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
-		handler := newQuoteHandler(quoter)
+		handler := newQuoteHandler(newQuoter(quoteEngine{fees: DefaultFeeSchedule()}), nil)
 
 		body, err := json.Marshal(tt.req)
 		require.NoError(t, err)
@@ -215,6 +215,17 @@ func TestQuote(t *testing.T) {
 	}
 }
 
+func TestContainsAPIKey(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{"key1", "key2"}
+	require.True(t, containsAPIKey(keys, "key1"))
+	require.True(t, containsAPIKey(keys, "key2"))
+	require.False(t, containsAPIKey(keys, "key"))
+	require.False(t, containsAPIKey(keys, "key12"))
+	require.False(t, containsAPIKey(nil, "key1"))
+}
+
 func parseInt(s string) *hexutil.Big {
 	b, ok := new(big.Int).SetString(s, 10)
 	if !ok {
@@ -0,0 +1,647 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/omni-network/omni/contracts/bindings"
+	"github.com/omni-network/omni/halo/genutil/evm/predeploys"
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Rebalance transfer statuses, used as metric labels.
+const (
+	rebalanceDryRun  = "dry_run"
+	rebalanceSuccess = "success"
+	rebalanceFailed  = "failed"
+)
+
+// opStandardBridgeABI is the subset of the OP-stack L1StandardBridge ABI used to deposit ETH to L2.
+const opStandardBridgeABI = `[{"type":"function","name":"depositETHTo","stateMutability":"payable","inputs":[{"name":"_to","type":"address"},{"name":"_minGasLimit","type":"uint32"},{"name":"_extraData","type":"bytes"}],"outputs":[]}]`
+
+// opDepositGasLimit is the minimum gas limit of OP-stack L2 ETH deposits to EOAs.
+const opDepositGasLimit = 200_000
+
+// pendingTransferTimeout is the duration after which initiated transfers no longer count toward their sink,
+// even if not observed landing (e.g. since sink inventory was spent in the meantime).
+const pendingTransferTimeout = 30 * time.Minute
+
+// rebalanceBand defines the target inventory band of a token on a chain.
+// Inventory is moved into the chain when its balance drops below Min,
+// and out of the chain when its balance exceeds Max. Transfers target the band midpoint.
+type rebalanceBand struct {
+	Token Token
+	Min   *big.Int
+	Max   *big.Int
+}
+
+// target returns the band midpoint.
+func (b rebalanceBand) target() *big.Int {
+	sum := new(big.Int).Add(b.Min, b.Max)
+	return sum.Div(sum, big.NewInt(2))
+}
+
+// parseRebalanceBands parses rebalance bands from config, keyed by "<chain_name>:<token_symbol>",
+// with values "<min>-<max>" in token units (e.g. "holesky:ETH" = "1.5-10").
+// Token symbols are case-insensitive, since config keys are lowercased.
+func parseRebalanceBands(network netconf.Network, cfg map[string]string) ([]rebalanceBand, error) {
+	var resp []rebalanceBand
+	for key, val := range cfg {
+		chain, symbol, ok := strings.Cut(key, ":")
+		if !ok {
+			return nil, errors.New("invalid rebalance band key, expect <chain>:<symbol>", "key", key)
+		}
+
+		meta, ok := network.ChainByName(chain)
+		if !ok {
+			return nil, errors.New("unknown rebalance band chain", "chain", chain)
+		}
+
+		tkn, ok := findSymbol(meta.ID, symbol)
+		if !ok {
+			return nil, errors.New("unsupported rebalance band token", "chain", chain, "symbol", symbol)
+		}
+
+		minStr, maxStr, ok := strings.Cut(val, "-")
+		if !ok {
+			return nil, errors.New("invalid rebalance band, expect <min>-<max>", "key", key, "band", val)
+		}
+
+		minAmt, err := parseUnits(minStr, tkn.Decimals)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse band min", "key", key)
+		}
+
+		maxAmt, err := parseUnits(maxStr, tkn.Decimals)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse band max", "key", key)
+		}
+
+		if minAmt.Cmp(maxAmt) > 0 {
+			return nil, errors.New("rebalance band min exceeds max", "key", key)
+		}
+
+		resp = append(resp, rebalanceBand{Token: tkn, Min: minAmt, Max: maxAmt})
+	}
+
+	// Sort for deterministic planning.
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].Token.ChainID != resp[j].Token.ChainID {
+			return resp[i].Token.ChainID < resp[j].Token.ChainID
+		}
+
+		return resp[i].Token.Symbol < resp[j].Token.Symbol
+	})
+
+	return resp, nil
+}
+
+// findSymbol returns the non-mock token with the (case-insensitive) symbol on the chain.
+func findSymbol(chainID uint64, symbol string) (Token, bool) {
+	for _, tkn := range tokens.ForChain(chainID) {
+		if strings.EqualFold(tkn.Symbol, symbol) && !tkn.IsMock {
+			return tkn, true
+		}
+	}
+
+	return Token{}, false
+}
+
+// parseUnits parses a decimal token amount (e.g. "1.5") into its smallest unit.
+func parseUnits(s string, decimals uint) (*big.Int, error) {
+	f, ok := new(big.Float).SetPrec(256).SetString(strings.TrimSpace(s))
+	if !ok || f.Sign() < 0 {
+		return nil, errors.New("invalid amount", "amount", s)
+	}
+
+	scale := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	resp, _ := f.Mul(f, scale).Int(nil)
+
+	return resp, nil
+}
+
+// rebalanceTransfer is a planned inventory transfer between chains.
+type rebalanceTransfer struct {
+	From   Token
+	To     Token
+	Amount *big.Int
+}
+
+// bridger transfers solver inventory between chains.
+type bridger struct {
+	// Supports returns true if the bridger can transfer `from` tokens to `to` tokens.
+	Supports func(from, to Token) bool
+	// Transfer executes the transfer, returning once initiated on the source chain.
+	Transfer func(ctx context.Context, t rebalanceTransfer) error
+}
+
+// inventory is the sampled balance of a band's token.
+type inventory struct {
+	Band    rebalanceBand
+	Balance *big.Int
+}
+
+// planRebalance returns the transfers restoring inventories to their bands.
+// First, chains below their band minimum are topped up to target from chains above target.
+// Then, chains above their band maximum are drained to target into chains below target.
+// Transfers never move a chain's balance past its target, and only include supported routes.
+// It also returns the inventories outside their band for which no transfer could be planned.
+func planRebalance(invs []inventory, supports func(from, to Token) bool) ([]rebalanceTransfer, []inventory) {
+	// need and excess track the remaining amount below and above target per inventory.
+	need := make([]*big.Int, len(invs))
+	excess := make([]*big.Int, len(invs))
+	for i, inv := range invs {
+		diff := new(big.Int).Sub(inv.Balance, inv.Band.target())
+		need[i] = big.NewInt(0)
+		excess[i] = big.NewInt(0)
+		if diff.Sign() < 0 {
+			need[i] = diff.Neg(diff)
+		} else {
+			excess[i] = diff
+		}
+	}
+
+	var resp []rebalanceTransfer
+	routed := make([]bool, len(invs))
+	match := func(sink, source int) {
+		if sink == source || need[sink].Sign() == 0 || excess[source].Sign() == 0 {
+			return
+		}
+
+		from, to := invs[source].Band.Token, invs[sink].Band.Token
+		if from.Token != to.Token || from.ChainClass != to.ChainClass || !supports(from, to) {
+			return
+		}
+
+		amount := new(big.Int).Set(need[sink])
+		if excess[source].Cmp(amount) < 0 {
+			amount.Set(excess[source])
+		}
+
+		need[sink].Sub(need[sink], amount)
+		excess[source].Sub(excess[source], amount)
+		routed[sink], routed[source] = true, true
+		resp = append(resp, rebalanceTransfer{From: from, To: to, Amount: amount})
+	}
+
+	for sink, inv := range invs {
+		if inv.Balance.Cmp(inv.Band.Min) >= 0 {
+			continue
+		}
+		for source := range invs {
+			match(sink, source)
+		}
+	}
+
+	for source, inv := range invs {
+		if inv.Balance.Cmp(inv.Band.Max) <= 0 {
+			continue
+		}
+		for sink := range invs {
+			match(sink, source)
+		}
+	}
+
+	var unrouted []inventory
+	for i, inv := range invs {
+		if bandStatus(inv) != 0 && !routed[i] {
+			unrouted = append(unrouted, inv)
+		}
+	}
+
+	return resp, unrouted
+}
+
+// bandStatus returns -1 if the balance is below the band, 1 if above, and 0 if within.
+func bandStatus(inv inventory) int {
+	if inv.Balance.Cmp(inv.Band.Min) < 0 {
+		return -1
+	} else if inv.Balance.Cmp(inv.Band.Max) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// rebalanceRoute identifies a transfer route by its source and sink tokens.
+type rebalanceRoute struct {
+	SrcChainID uint64
+	SrcAddr    common.Address
+	DstChainID uint64
+	DstAddr    common.Address
+}
+
+func routeOf(t rebalanceTransfer) rebalanceRoute {
+	return rebalanceRoute{
+		SrcChainID: t.From.ChainID,
+		SrcAddr:    t.From.Address,
+		DstChainID: t.To.ChainID,
+		DstAddr:    t.To.Address,
+	}
+}
+
+// pendingTransfer is an initiated transfer not yet observed landing on its sink chain.
+type pendingTransfer struct {
+	Amount      *big.Int
+	Baseline    *big.Int // Sink balance when initiated
+	InitiatedAt time.Time
+}
+
+// pendingTransfers tracks initiated transfers per route until they land or time out.
+// Bridged funds only arrive on the sink chain after a delay, so pending transfers are counted toward
+// their sink's balance to avoid transferring the same deficit again on subsequent ticks.
+type pendingTransfers struct {
+	mu     sync.Mutex
+	routes map[rebalanceRoute][]pendingTransfer
+}
+
+func newPendingTransfers() *pendingTransfers {
+	return &pendingTransfers{routes: make(map[rebalanceRoute][]pendingTransfer)}
+}
+
+// Add tracks the initiated transfer given the sink's balance when initiated.
+func (p *pendingTransfers) Add(t rebalanceTransfer, baseline *big.Int, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	route := routeOf(t)
+	p.routes[route] = append(p.routes[route], pendingTransfer{
+		Amount:      new(big.Int).Set(t.Amount),
+		Baseline:    new(big.Int).Set(baseline),
+		InitiatedAt: now,
+	})
+}
+
+// Apply removes pending transfers that landed (sink balance reached baseline plus amount) or timed out.
+// It returns the inventories with remaining pending transfer amounts added to their sink balances.
+func (p *pendingTransfers) Apply(invs []inventory, now time.Time) []inventory {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	resp := make([]inventory, 0, len(invs))
+	for _, inv := range invs {
+		balance := new(big.Int).Set(inv.Balance)
+		for route, pendings := range p.routes {
+			if route.DstChainID != inv.Band.Token.ChainID || route.DstAddr != inv.Band.Token.Address {
+				continue
+			}
+
+			var remaining []pendingTransfer
+			for _, pending := range pendings {
+				landed := inv.Balance.Cmp(new(big.Int).Add(pending.Baseline, pending.Amount)) >= 0
+				if landed || now.Sub(pending.InitiatedAt) > pendingTransferTimeout {
+					continue
+				}
+
+				remaining = append(remaining, pending)
+				balance.Add(balance, pending.Amount)
+			}
+
+			if len(remaining) == 0 {
+				delete(p.routes, route)
+			} else {
+				p.routes[route] = remaining
+			}
+		}
+
+		resp = append(resp, inventory{Band: inv.Band, Balance: balance})
+	}
+
+	return resp
+}
+
+// rebalancer periodically samples solver inventory and transfers funds between chains
+// to keep each configured token within its target band.
+type rebalancer struct {
+	backends   ethbackend.Backends
+	solverAddr common.Address
+	bands      []rebalanceBand
+	bridger    bridger
+	dryRun     bool
+	interval   time.Duration
+	pending    *pendingTransfers
+	now        func() time.Time
+}
+
+// Run rebalances inventory every interval until the context is canceled.
+func (r rebalancer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.rebalanceOnce(ctx); err != nil {
+				log.Warn(ctx, "Failed rebalancing inventory (will retry)", err)
+			}
+		}
+	}
+}
+
+// rebalanceOnce samples inventory and executes (or logs if dry-run) the planned transfers.
+func (r rebalancer) rebalanceOnce(ctx context.Context) error {
+	invs, err := r.sample(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.rebalance(ctx, invs)
+
+	return nil
+}
+
+// rebalance executes (or logs if dry-run) the transfers planned for the sampled inventories,
+// counting pending transfers toward their sinks.
+func (r rebalancer) rebalance(ctx context.Context, invs []inventory) {
+	for _, inv := range invs {
+		status := bandStatus(inv)
+		inventoryBandStatus.WithLabelValues(chainName(inv.Band.Token.ChainID), inv.Band.Token.Symbol).Set(float64(status))
+		if status == 0 {
+			continue
+		}
+
+		log.Debug(ctx, "Inventory outside rebalance band",
+			"chain", chainName(inv.Band.Token.ChainID),
+			"token", inv.Band.Token.Symbol,
+			"balance", inv.Balance,
+			"min", inv.Band.Min,
+			"max", inv.Band.Max,
+		)
+	}
+
+	transfers, unrouted := planRebalance(r.pending.Apply(invs, r.now()), r.bridger.Supports)
+	for _, inv := range unrouted {
+		rebalanceUnrouted.WithLabelValues(chainName(inv.Band.Token.ChainID), inv.Band.Token.Symbol).Inc()
+		log.Debug(ctx, "No supported rebalance route for inventory outside band",
+			"chain", chainName(inv.Band.Token.ChainID),
+			"token", inv.Band.Token.Symbol,
+		)
+	}
+
+	for _, t := range transfers {
+		src, dst := chainName(t.From.ChainID), chainName(t.To.ChainID)
+		attrs := []any{"src_chain", src, "dst_chain", dst, "token", t.From.Symbol, "amount", t.Amount}
+
+		if r.dryRun {
+			rebalanceTransfers.WithLabelValues(src, dst, t.From.Symbol, rebalanceDryRun).Inc()
+			log.Info(ctx, "Dry-run: skipping rebalance transfer", attrs...)
+
+			continue
+		}
+
+		if err := r.bridger.Transfer(ctx, t); err != nil {
+			rebalanceTransfers.WithLabelValues(src, dst, t.From.Symbol, rebalanceFailed).Inc()
+			log.Warn(ctx, "Failed rebalance transfer", err, attrs...)
+
+			continue
+		}
+
+		r.pending.Add(t, sampledBalance(invs, t.To), r.now())
+		rebalanceTransfers.WithLabelValues(src, dst, t.From.Symbol, rebalanceSuccess).Inc()
+		log.Info(ctx, "Rebalance transfer initiated", attrs...)
+	}
+}
+
+// sampledBalance returns the sampled balance of the token, or zero if not sampled.
+func sampledBalance(invs []inventory, tkn Token) *big.Int {
+	for _, inv := range invs {
+		if inv.Band.Token.ChainID == tkn.ChainID && inv.Band.Token.Address == tkn.Address {
+			return inv.Balance
+		}
+	}
+
+	return big.NewInt(0)
+}
+
+// sample returns the current inventory of all bands, also updating the token balance metrics.
+func (r rebalancer) sample(ctx context.Context) ([]inventory, error) {
+	invs := make([]inventory, 0, len(r.bands))
+	for _, band := range r.bands {
+		backend, err := r.backends.Backend(band.Token.ChainID)
+		if err != nil {
+			return nil, err
+		}
+
+		bal, err := balanceOf(ctx, band.Token, backend, r.solverAddr)
+		if err != nil {
+			return nil, errors.Wrap(err, "get balance", "chain", chainName(band.Token.ChainID), "token", band.Token.Symbol)
+		}
+
+		sampleBalance(chainName(band.Token.ChainID), band.Token, r.solverAddr, bal)
+		invs = append(invs, inventory{Band: band, Balance: bal})
+	}
+
+	return invs, nil
+}
+
+// combineBridgers returns a bridger using the first of the provided bridgers supporting each transfer.
+func combineBridgers(bridgers ...bridger) bridger {
+	find := func(from, to Token) (bridger, bool) {
+		for _, b := range bridgers {
+			if b.Supports(from, to) {
+				return b, true
+			}
+		}
+
+		return bridger{}, false
+	}
+
+	return bridger{
+		Supports: func(from, to Token) bool {
+			_, ok := find(from, to)
+			return ok
+		},
+		Transfer: func(ctx context.Context, t rebalanceTransfer) error {
+			b, ok := find(t.From, t.To)
+			if !ok {
+				return errors.New("unsupported transfer", "from", t.From.Symbol, "to", t.To.Symbol)
+			}
+
+			return b.Transfer(ctx, t)
+		},
+	}
+}
+
+// newETHBridger returns a bridger depositing native ETH from L1 to OP-stack L2s via their
+// L1StandardBridge contracts, keyed by L2 chain ID.
+//
+// Note that SolverNet orders cannot rebalance solver inventory, since the solver is the only
+// filler of its own orders, so the deposit and the fill cancel out.
+// Withdrawals from L2s are not supported, since they require proving and finalizing after the challenge period.
+func newETHBridger(network netconf.ID, backends ethbackend.Backends, solverAddr common.Address, l1Bridges map[uint64]common.Address) bridger {
+	l1ChainID := netconf.EthereumChainID(network)
+
+	isL1ETH := func(t Token) bool { return t.IsNative() && !t.IsOMNI() && t.ChainID == l1ChainID }
+	isL2ETH := func(t Token) bool {
+		_, ok := l1Bridges[t.ChainID]
+		return ok && t.IsNative() && !t.IsOMNI()
+	}
+
+	return bridger{
+		Supports: func(from, to Token) bool {
+			return isL1ETH(from) && isL2ETH(to)
+		},
+		Transfer: func(ctx context.Context, t rebalanceTransfer) error {
+			if !isL1ETH(t.From) || !isL2ETH(t.To) {
+				return errors.New("unsupported transfer", "from", t.From.Symbol, "to", t.To.Symbol)
+			}
+
+			return depositETH(ctx, backends, t, solverAddr, l1Bridges[t.To.ChainID])
+		},
+	}
+}
+
+// depositETH deposits L1 native ETH to the solver on an OP-stack L2 via its L1StandardBridge.
+func depositETH(ctx context.Context, backends ethbackend.Backends, t rebalanceTransfer, solverAddr, l1BridgeAddr common.Address) error {
+	backend, err := backends.Backend(t.From.ChainID)
+	if err != nil {
+		return err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(opStandardBridgeABI))
+	if err != nil {
+		return errors.Wrap(err, "parse abi")
+	}
+
+	txOpts, err := backend.BindOpts(ctx, solverAddr)
+	if err != nil {
+		return err
+	}
+	txOpts.Value = t.Amount
+
+	contract := bind.NewBoundContract(l1BridgeAddr, parsed, backend, backend, backend)
+	tx, err := contract.Transact(txOpts, "depositETHTo", solverAddr, uint32(opDepositGasLimit), []byte{})
+	if err != nil {
+		return errors.Wrap(err, "deposit eth")
+	} else if _, err := backend.WaitMined(ctx, tx); err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	return nil
+}
+
+// parseETHBridges parses OP-stack L1StandardBridge addresses from config, keyed by L2 chain name.
+func parseETHBridges(network netconf.Network, cfg map[string]string) (map[uint64]common.Address, error) {
+	resp := make(map[uint64]common.Address)
+	for chain, addr := range cfg {
+		meta, ok := network.ChainByName(chain)
+		if !ok {
+			return nil, errors.New("unknown eth bridge chain", "chain", chain)
+		} else if !common.IsHexAddress(addr) {
+			return nil, errors.New("invalid eth bridge address", "chain", chain, "address", addr)
+		}
+
+		resp[meta.ID] = common.HexToAddress(addr)
+	}
+
+	return resp, nil
+}
+
+// newL1Bridger returns a bridger transferring OMNI between the L1 ERC20 token
+// and the native token on the Omni EVM via the OMNI L1 and native bridge contracts.
+func newL1Bridger(network netconf.ID, backends ethbackend.Backends, solverAddr, l1BridgeAddr common.Address) bridger {
+	l1ChainID := netconf.EthereumChainID(network)
+	omniChainID := network.Static().OmniExecutionChainID
+
+	isL1OMNI := func(t Token) bool { return t.IsOMNI() && !t.IsNative() && t.ChainID == l1ChainID }
+	isNativeOMNI := func(t Token) bool { return t.IsOMNI() && t.IsNative() && t.ChainID == omniChainID }
+
+	return bridger{
+		Supports: func(from, to Token) bool {
+			return (isL1OMNI(from) && isNativeOMNI(to)) || (isNativeOMNI(from) && isL1OMNI(to))
+		},
+		Transfer: func(ctx context.Context, t rebalanceTransfer) error {
+			switch {
+			case isL1OMNI(t.From) && isNativeOMNI(t.To):
+				return bridgeToNative(ctx, backends, t, solverAddr, l1BridgeAddr)
+			case isNativeOMNI(t.From) && isL1OMNI(t.To):
+				return bridgeToL1(ctx, backends, t, solverAddr)
+			default:
+				return errors.New("unsupported transfer", "from", t.From.Symbol, "to", t.To.Symbol)
+			}
+		},
+	}
+}
+
+// bridgeToNative bridges L1 OMNI to native OMNI on the Omni EVM.
+func bridgeToNative(ctx context.Context, backends ethbackend.Backends, t rebalanceTransfer, solverAddr, l1BridgeAddr common.Address) error {
+	backend, err := backends.Backend(t.From.ChainID)
+	if err != nil {
+		return err
+	}
+
+	if err := approveToken(ctx, backend, t.From, solverAddr, l1BridgeAddr); err != nil {
+		return errors.Wrap(err, "approve l1 bridge")
+	}
+
+	l1Bridge, err := bindings.NewOmniBridgeL1(l1BridgeAddr, backend)
+	if err != nil {
+		return errors.Wrap(err, "new l1 bridge")
+	}
+
+	fee, err := l1Bridge.BridgeFee(&bind.CallOpts{Context: ctx}, solverAddr, solverAddr, t.Amount)
+	if err != nil {
+		return errors.Wrap(err, "bridge fee")
+	}
+
+	txOpts, err := backend.BindOpts(ctx, solverAddr)
+	if err != nil {
+		return err
+	}
+	txOpts.Value = fee
+
+	tx, err := l1Bridge.Bridge(txOpts, solverAddr, t.Amount)
+	if err != nil {
+		return errors.Wrap(err, "bridge")
+	} else if _, err := backend.WaitMined(ctx, tx); err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	return nil
+}
+
+// bridgeToL1 bridges native OMNI on the Omni EVM to L1 OMNI.
+func bridgeToL1(ctx context.Context, backends ethbackend.Backends, t rebalanceTransfer, solverAddr common.Address) error {
+	backend, err := backends.Backend(t.From.ChainID)
+	if err != nil {
+		return err
+	}
+
+	nativeBridge, err := bindings.NewOmniBridgeNative(common.HexToAddress(predeploys.OmniBridgeNative), backend)
+	if err != nil {
+		return errors.Wrap(err, "new native bridge")
+	}
+
+	fee, err := nativeBridge.BridgeFee(&bind.CallOpts{Context: ctx}, solverAddr, t.Amount)
+	if err != nil {
+		return errors.Wrap(err, "bridge fee")
+	}
+
+	txOpts, err := backend.BindOpts(ctx, solverAddr)
+	if err != nil {
+		return err
+	}
+	txOpts.Value = new(big.Int).Add(t.Amount, fee)
+
+	tx, err := nativeBridge.Bridge(txOpts, solverAddr, t.Amount)
+	if err != nil {
+		return errors.Wrap(err, "bridge")
+	} else if _, err := backend.WaitMined(ctx, tx); err != nil {
+		return errors.Wrap(err, "wait mined")
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/log"
+	"github.com/omni-network/omni/lib/netconf"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRebalanceBands(t *testing.T) {
+	t.Parallel()
+
+	network := netconf.Network{
+		ID: netconf.Omega,
+		Chains: []netconf.Chain{
+			{ID: evmchain.IDHolesky, Name: "holesky"},
+			{ID: evmchain.IDOmniOmega, Name: "omni_evm"},
+		},
+	}
+
+	bands, err := parseRebalanceBands(network, map[string]string{
+		"omni_evm:omni": "100-1000.5",
+		"holesky:OMNI":  "0.5-2000",
+	})
+	require.NoError(t, err)
+	require.Len(t, bands, 2)
+
+	require.Equal(t, evmchain.IDOmniOmega, bands[0].Token.ChainID)
+	require.True(t, bands[0].Token.IsNative())
+	require.Equal(t, ether(100), bands[0].Min)
+	require.Equal(t, mustBig("1000500000000000000000"), bands[0].Max)
+
+	require.Equal(t, evmchain.IDHolesky, bands[1].Token.ChainID)
+	require.False(t, bands[1].Token.IsNative())
+	require.Equal(t, mustBig("500000000000000000"), bands[1].Min)
+	require.Equal(t, ether(2000), bands[1].Max)
+
+	for _, invalid := range []map[string]string{
+		{"holesky": "1-2"},
+		{"unknown:ETH": "1-2"},
+		{"holesky:FOO": "1-2"},
+		{"holesky:ETH": "1"},
+		{"holesky:ETH": "2-1"},
+		{"holesky:ETH": "a-b"},
+	} {
+		_, err := parseRebalanceBands(network, invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestPlanRebalance(t *testing.T) {
+	t.Parallel()
+
+	l1OMNI := omniERC20(netconf.Omega)
+	nativeOMNI := nativeOMNI(evmchain.IDOmniOmega)
+	holeskyETH := nativeETH(evmchain.IDHolesky)
+	baseETH := nativeETH(evmchain.IDBaseSepolia)
+
+	band := func(tkn Token, minAmt, maxAmt int64) rebalanceBand {
+		return rebalanceBand{Token: tkn, Min: ether(minAmt), Max: ether(maxAmt)}
+	}
+
+	supportsAll := func(Token, Token) bool { return true }
+
+	tests := []struct {
+		name     string
+		invs     []inventory
+		supports func(Token, Token) bool
+		expected []rebalanceTransfer
+		unrouted int
+	}{
+		{
+			name: "within bands",
+			invs: []inventory{
+				{Band: band(l1OMNI, 100, 300), Balance: ether(150)},
+				{Band: band(nativeOMNI, 100, 300), Balance: ether(250)},
+			},
+			supports: supportsAll,
+		},
+		{
+			name: "below min",
+			invs: []inventory{
+				{Band: band(l1OMNI, 100, 300), Balance: ether(50)},
+				{Band: band(nativeOMNI, 100, 300), Balance: ether(280)},
+			},
+			supports: supportsAll,
+			expected: []rebalanceTransfer{
+				{From: nativeOMNI, To: l1OMNI, Amount: ether(80)}, // limited by source target
+			},
+		},
+		{
+			name: "above max",
+			invs: []inventory{
+				{Band: band(l1OMNI, 100, 300), Balance: ether(500)},
+				{Band: band(nativeOMNI, 100, 300), Balance: ether(150)},
+			},
+			supports: supportsAll,
+			expected: []rebalanceTransfer{
+				{From: l1OMNI, To: nativeOMNI, Amount: ether(50)}, // limited by sink target
+			},
+		},
+		{
+			name: "unsupported route",
+			invs: []inventory{
+				{Band: band(l1OMNI, 100, 300), Balance: ether(50)},
+				{Band: band(nativeOMNI, 100, 300), Balance: ether(280)},
+			},
+			supports: func(Token, Token) bool { return false },
+			unrouted: 1,
+		},
+		{
+			name: "different tokens",
+			invs: []inventory{
+				{Band: band(holeskyETH, 1, 3), Balance: ether(0)},
+				{Band: band(nativeOMNI, 100, 300), Balance: ether(1000)},
+			},
+			supports: supportsAll,
+			unrouted: 2,
+		},
+		{
+			name: "multiple sources",
+			invs: []inventory{
+				{Band: band(holeskyETH, 10, 30), Balance: ether(0)},
+				{Band: band(baseETH, 1, 3), Balance: ether(5)},
+				{Band: band(nativeETH(evmchain.IDOpSepolia), 1, 3), Balance: ether(50)},
+			},
+			supports: supportsAll,
+			expected: []rebalanceTransfer{
+				{From: baseETH, To: holeskyETH, Amount: ether(3)},
+				{From: nativeETH(evmchain.IDOpSepolia), To: holeskyETH, Amount: ether(17)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transfers, unrouted := planRebalance(tt.invs, tt.supports)
+			require.Len(t, unrouted, tt.unrouted)
+			require.Len(t, transfers, len(tt.expected))
+			for i, expected := range tt.expected {
+				require.Equal(t, expected.From.ChainID, transfers[i].From.ChainID)
+				require.Equal(t, expected.To.ChainID, transfers[i].To.ChainID)
+				require.Equal(t, expected.Amount.String(), transfers[i].Amount.String())
+			}
+		})
+	}
+}
+
+func TestRebalancePending(t *testing.T) {
+	t.Parallel()
+	ctx := log.WithNoopLogger(context.Background())
+
+	l1OMNI := omniERC20(netconf.Omega)
+	nativeOMNI := nativeOMNI(evmchain.IDOmniOmega)
+	l1Band := rebalanceBand{Token: l1OMNI, Min: ether(100), Max: ether(300)}
+	nativeBand := rebalanceBand{Token: nativeOMNI, Min: ether(100), Max: ether(300)}
+
+	var transfers []rebalanceTransfer
+	now := time.Now()
+	r := rebalancer{
+		bridger: bridger{
+			Supports: func(Token, Token) bool { return true },
+			Transfer: func(_ context.Context, t rebalanceTransfer) error {
+				transfers = append(transfers, t)
+				return nil
+			},
+		},
+		pending: newPendingTransfers(),
+		now:     func() time.Time { return now },
+	}
+
+	// First tick tops up L1 from native.
+	r.rebalance(ctx, []inventory{{Band: l1Band, Balance: ether(50)}, {Band: nativeBand, Balance: ether(400)}})
+	require.Len(t, transfers, 1)
+	require.Equal(t, ether(150).String(), transfers[0].Amount.String())
+
+	// Second tick before landing doesn't transfer the same deficit again.
+	now = now.Add(time.Minute)
+	r.rebalance(ctx, []inventory{{Band: l1Band, Balance: ether(50)}, {Band: nativeBand, Balance: ether(250)}})
+	require.Len(t, transfers, 1)
+
+	// Landed transfers are no longer pending.
+	now = now.Add(time.Minute)
+	r.rebalance(ctx, []inventory{{Band: l1Band, Balance: ether(200)}, {Band: nativeBand, Balance: ether(250)}})
+	require.Len(t, transfers, 1)
+	require.Empty(t, r.pending.routes)
+
+	// Timed out transfers no longer count toward their sink.
+	r.pending.Add(rebalanceTransfer{From: nativeOMNI, To: l1OMNI, Amount: ether(150)}, ether(50), now)
+	invs := []inventory{{Band: l1Band, Balance: ether(50)}, {Band: nativeBand, Balance: ether(400)}}
+	require.Equal(t, ether(200).String(), r.pending.Apply(invs, now.Add(pendingTransferTimeout))[0].Balance.String())
+	require.Equal(t, ether(50).String(), r.pending.Apply(invs, now.Add(pendingTransferTimeout+time.Second))[0].Balance.String())
+	require.Empty(t, r.pending.routes)
+}
+
+func TestETHBridger(t *testing.T) {
+	t.Parallel()
+
+	l1ETH := nativeETH(evmchain.IDHolesky)
+	baseETH := nativeETH(evmchain.IDBaseSepolia)
+	opETH := nativeETH(evmchain.IDOpSepolia)
+	l1OMNI := omniERC20(netconf.Omega)
+	nativeOMNI := nativeOMNI(evmchain.IDOmniOmega)
+
+	ethBridger := newETHBridger(netconf.Omega, ethbackend.Backends{}, common.Address{}, map[uint64]common.Address{
+		evmchain.IDBaseSepolia: common.HexToAddress("0x01"),
+	})
+	l1Bridger := newL1Bridger(netconf.Omega, ethbackend.Backends{}, common.Address{}, common.Address{})
+	combined := combineBridgers(l1Bridger, ethBridger)
+
+	require.True(t, ethBridger.Supports(l1ETH, baseETH))
+	require.False(t, ethBridger.Supports(baseETH, l1ETH)) // Withdrawals not supported
+	require.False(t, ethBridger.Supports(l1ETH, opETH))   // No bridge configured
+	require.False(t, ethBridger.Supports(l1OMNI, nativeOMNI))
+
+	require.True(t, combined.Supports(l1ETH, baseETH))
+	require.True(t, combined.Supports(l1OMNI, nativeOMNI))
+	require.True(t, combined.Supports(nativeOMNI, l1OMNI))
+	require.False(t, combined.Supports(l1ETH, opETH))
+
+	err := combined.Transfer(context.Background(), rebalanceTransfer{From: l1ETH, To: opETH, Amount: ether(1)})
+	require.ErrorContains(t, err, "unsupported transfer")
+}
+
+func TestParseUnits(t *testing.T) {
+	t.Parallel()
+
+	amount, err := parseUnits("1.25", 18)
+	require.NoError(t, err)
+	require.Equal(t, mustBig("1250000000000000000"), amount)
+
+	amount, err = parseUnits("42", 6)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(42_000_000), amount)
+
+	_, err = parseUnits("-1", 18)
+	require.Error(t, err)
+}
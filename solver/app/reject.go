@@ -63,6 +63,7 @@ func newRejection(reason rejectReason, err error) *RejectionError {
 // should be made before calling ShouldReject.
 func newShouldRejector(
 	backends ethbackend.Backends,
+	engine quoteEngine,
 	solverAddr, outboxAddr common.Address,
 ) func(ctx context.Context, order Order) (rejectReason, bool, error) {
 	return func(ctx context.Context, order Order) (rejectReason, bool, error) {
@@ -83,11 +84,12 @@ func newShouldRejector(
 				return err
 			}
 
-			if err := checkQuote(deposits, expenses); err != nil {
+			quoteID, err := checkQuote(ctx, engine, order.Owner, deposits, expenses, &order.ID)
+			if err != nil {
 				return err
 			}
 
-			if err := checkLiquidity(ctx, expenses, backend, solverAddr); err != nil {
+			if err := checkLiquidity(ctx, expenses, backend, solverAddr, engine.book, quoteID); err != nil {
 				return err
 			}
 
@@ -278,23 +280,33 @@ func nativeAmt(ps []Payment) *big.Int {
 
 // checkQuote checks if deposits match or exceed quote for expenses.
 // only single expense supported with matching deposit is supported.
-func checkQuote(deposits, expenses []Payment) error {
-	quote, err := getQuote(tkns(deposits), expenses)
+//
+// A valid unexpired firm quote of the owner matching the deposits and expenses is honored regardless of current prices,
+// in which case its ID is returned. If an order ID is provided, the firm quote is used by that order.
+func checkQuote(ctx context.Context, engine quoteEngine, owner common.Address, deposits, expenses []Payment, orderID *OrderID) (common.Hash, error) {
+	if quoteID, ok := engine.book.Match(owner, deposits, expenses, orderID); ok {
+		return quoteID, nil
+	}
+
+	quote, err := engine.getQuote(ctx, tkns(deposits), expenses)
 	if err != nil {
-		return err
+		return common.Hash{}, err
 	}
 
-	return coversQuote(deposits, quote)
+	return common.Hash{}, coversQuote(deposits, quote)
 }
 
-// checkLiquidity checks that the solver has enough liquidity to pay for the expenses.
-func checkLiquidity(ctx context.Context, expenses []Payment, backend *ethbackend.Backend, solverAddr common.Address) error {
+// checkLiquidity checks that the solver has enough liquidity to pay for the expenses,
+// excluding inventory reserved by firm quotes other than `quoteID`.
+func checkLiquidity(ctx context.Context, expenses []Payment, backend *ethbackend.Backend, solverAddr common.Address, book *quoteBook, quoteID common.Hash) error {
 	for _, expense := range expenses {
 		bal, err := balanceOf(ctx, expense.Token, backend, solverAddr)
 		if err != nil {
 			return errors.Wrap(err, "get balance", "token", expense.Token.Symbol)
 		}
 
+		bal.Sub(bal, book.Reserved(expense.Token, quoteID))
+
 		// TODO: for native tokens, even if we have enough, we don't want to
 		// spend out whole balance. we'll need to keep some for gas
 		if bal.Cmp(expense.Amount) < 0 {
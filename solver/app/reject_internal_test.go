@@ -49,7 +49,7 @@ func TestShouldReject(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			backends, clients := testBackends(t)
 
-			shouldReject := newShouldRejector(backends, solver, outbox)
+			shouldReject := newShouldRejector(backends, quoteEngine{fees: DefaultFeeSchedule()}, solver, outbox)
 
 			if tt.mock != nil {
 				tt.mock(clients)
@@ -0,0 +1,158 @@
+package app
+
+import (
+	"context"
+	"sync"
+
+	"github.com/omni-network/omni/lib/errors"
+	"github.com/omni-network/omni/lib/ethclient/ethbackend"
+	"github.com/omni-network/omni/lib/umath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// reorgWindow is the number of recently processed block hashes tracked per chain.
+// Reorgs deeper than this roll back to the oldest tracked height.
+const reorgWindow = 128
+
+// blockTracker tracks the block hashes of recently processed heights of a chain.
+// Since the solver streams at ConfLatest, this is used to detect reorgs.
+type blockTracker struct {
+	mu     sync.Mutex
+	hashes map[uint64]common.Hash // Block hashes by height
+}
+
+func newBlockTracker() *blockTracker {
+	return &blockTracker{
+		hashes: make(map[uint64]common.Hash),
+	}
+}
+
+// Add tracks the block hash of the processed height, pruning heights outside the window.
+func (t *blockTracker) Add(height uint64, hash common.Hash) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.hashes[height] = hash
+
+	for h := range t.hashes {
+		if h+reorgWindow <= height {
+			delete(t.hashes, h)
+		}
+	}
+}
+
+// Get returns the tracked block hash of the height, or false.
+func (t *blockTracker) Get(height uint64) (common.Hash, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	hash, ok := t.hashes[height]
+
+	return hash, ok
+}
+
+// Rollback deletes all tracked heights after the provided height.
+func (t *blockTracker) Rollback(height uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for h := range t.hashes {
+		if h > height {
+			delete(t.hashes, h)
+		}
+	}
+}
+
+// lowest returns the lowest tracked height, or false if empty.
+func (t *blockTracker) lowest() (uint64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var resp uint64
+	var ok bool
+	for h := range t.hashes {
+		if !ok || h < resp {
+			resp, ok = h, true
+		}
+	}
+
+	return resp, ok
+}
+
+// detectReorg returns the canonical header of the height and true if a reorg is detected,
+// either since the header's parent doesn't match the previously processed block,
+// or since the height or its event logs are from a non-canonical block.
+// Similar to halo/attest/voter detectReorg, the first height (without previous) is not checked against its parent.
+func detectReorg(ctx context.Context, deps procDeps, chainID uint64, blocks *blockTracker, height uint64, elogs []types.Log) (*types.Header, bool, error) {
+	header, err := deps.BlockHeader(ctx, chainID, height)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "get header")
+	} else if header.Number.Uint64() != height {
+		return nil, false, errors.New("header height mismatch [BUG]", "expected", height, "actual", header.Number.Uint64())
+	}
+
+	for _, elog := range elogs {
+		if elog.BlockHash != header.Hash() {
+			return header, true, nil
+		}
+	}
+
+	// Heights are re-processed after restarts, ensure the block didn't change.
+	if hash, ok := blocks.Get(height); ok && hash != header.Hash() {
+		return header, true, nil
+	}
+
+	if height == 0 {
+		return header, false, nil
+	}
+
+	prevHash, ok := blocks.Get(height - 1)
+	if ok && prevHash != header.ParentHash {
+		return header, true, nil
+	}
+
+	return header, false, nil
+}
+
+// findCommonAncestor returns the highest tracked height before the provided height that is still canonical.
+// If none is found, the height before the lowest tracked height is returned.
+func findCommonAncestor(ctx context.Context, deps procDeps, chainID uint64, blocks *blockTracker, height uint64) (uint64, error) {
+	lowest, ok := blocks.lowest()
+	if !ok || height <= lowest {
+		return umath.SubtractOrZero(height, 1), nil
+	}
+
+	for h := height - 1; h >= lowest; h-- {
+		tracked, ok := blocks.Get(h)
+		if !ok {
+			continue
+		}
+
+		header, err := deps.BlockHeader(ctx, chainID, h)
+		if err != nil {
+			return 0, errors.Wrap(err, "get header")
+		} else if header.Hash() == tracked {
+			return h, nil
+		}
+
+		if h == 0 {
+			break
+		}
+	}
+
+	return umath.SubtractOrZero(lowest, 1), nil
+}
+
+// newHeaderFetcher returns a function that fetches the block header of a chain height.
+func newHeaderFetcher(backends ethbackend.Backends) func(ctx context.Context, chainID uint64, height uint64) (*types.Header, error) {
+	return func(ctx context.Context, chainID uint64, height uint64) (*types.Header, error) {
+		backend, err := backends.Backend(chainID)
+		if err != nil {
+			return nil, err
+		}
+
+		return backend.HeaderByNumber(ctx, umath.NewBigInt(height))
+	}
+}
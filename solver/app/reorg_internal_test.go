@@ -0,0 +1,192 @@
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/omni-network/omni/lib/contracts/solvernet"
+	"github.com/omni-network/omni/lib/evmchain"
+	"github.com/omni-network/omni/lib/tutil"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	db "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+)
+
+// mockChain is a mock chain of headers that supports reorgs.
+type mockChain struct {
+	headers []*types.Header
+}
+
+// extend appends n blocks to the chain, using the fork ID to ensure unique hashes.
+func (c *mockChain) extend(n int, fork byte) {
+	for i := 0; i < n; i++ {
+		var parent common.Hash
+		if len(c.headers) > 0 {
+			parent = c.headers[len(c.headers)-1].Hash()
+		}
+		c.headers = append(c.headers, &types.Header{
+			Number:     big.NewInt(int64(len(c.headers))),
+			ParentHash: parent,
+			Extra:      []byte{fork},
+		})
+	}
+}
+
+// reorg replaces all blocks after the height with n new blocks.
+func (c *mockChain) reorg(height uint64, n int, fork byte) {
+	c.headers = c.headers[:height+1]
+	c.extend(n, fork)
+}
+
+func (c *mockChain) header(_ context.Context, _ uint64, height uint64) (*types.Header, error) {
+	return c.headers[height], nil
+}
+
+func TestReorg(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	const chainID = 321
+
+	chain := new(mockChain)
+	chain.extend(6, 0)
+
+	var cursor, rolledBackTo uint64
+	deps := procDeps{
+		BlockHeader: chain.header,
+		SetCursor: func(_ context.Context, c uint64, h uint64) error {
+			require.EqualValues(t, chainID, c)
+			cursor = h
+
+			return nil
+		},
+		RollbackOrders: func(_ context.Context, c uint64, h uint64) (int, error) {
+			require.EqualValues(t, chainID, c)
+			rolledBackTo = h
+
+			return 1, nil
+		},
+		ChainName: func(uint64) string { return "" },
+	}
+
+	blocks := newBlockTracker()
+	processor := newEventProcessor(deps, chainID, blocks)
+
+	for h := uint64(1); h <= 5; h++ {
+		require.NoError(t, processor(ctx, h, nil))
+		require.Equal(t, h, cursor)
+	}
+
+	// Re-processing the same height is fine.
+	require.NoError(t, processor(ctx, 5, nil))
+
+	// Reorg out heights 4 and 5.
+	chain.reorg(3, 3, 1)
+
+	err := processor(ctx, 6, nil)
+	require.ErrorContains(t, err, "reorg detected")
+	require.EqualValues(t, 3, rolledBackTo)
+	require.EqualValues(t, 4, cursor)
+	_, ok := blocks.Get(4)
+	require.False(t, ok)
+
+	// Stream restarts from the cursor.
+	for h := uint64(4); h <= 6; h++ {
+		require.NoError(t, processor(ctx, h, nil))
+	}
+
+	// Logs from a non-canonical block are detected.
+	chain.extend(1, 1)
+	err = processor(ctx, 7, []types.Log{{BlockHash: tutil.RandomHash()}})
+	require.ErrorContains(t, err, "reorg detected")
+	require.EqualValues(t, 6, rolledBackTo)
+	require.EqualValues(t, 7, cursor)
+
+	// Re-processed height with a different block is detected.
+	require.NoError(t, processor(ctx, 7, nil))
+	chain.reorg(6, 1, 2)
+	err = processor(ctx, 7, nil)
+	require.ErrorContains(t, err, "reorg detected")
+	require.EqualValues(t, 6, rolledBackTo)
+}
+
+func TestBlockTrackerWindow(t *testing.T) {
+	t.Parallel()
+
+	blocks := newBlockTracker()
+	for h := uint64(0); h < reorgWindow*2; h++ {
+		blocks.Add(h, tutil.RandomHash())
+	}
+
+	lowest, ok := blocks.lowest()
+	require.True(t, ok)
+	require.EqualValues(t, reorgWindow, lowest)
+}
+
+func TestOrderStoreRollback(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store, err := newOrderStore(db.NewMemDB())
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Unix(1, 0) }
+
+	opened := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 1}
+	rejected := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 1}
+	reorged := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 1}
+	other := Order{ID: OrderID(tutil.RandomHash()), SourceChainID: 2}
+
+	record := func(order Order, status solvernet.OrderStatus, height uint64) {
+		u := orderUpdate{Status: status, Height: height, TxHash: tutil.RandomHash()}
+		require.NoError(t, store.Record(ctx, order, u, nil))
+	}
+
+	fee := &Payment{Token: nativeETH(evmchain.IDHolesky), Amount: ether(1)}
+
+	record(opened, solvernet.StatusPending, 10)
+	require.NoError(t, store.Record(ctx, opened, orderUpdate{Status: solvernet.StatusFilled, Height: 12, TxHash: tutil.RandomHash(), FillTxHash: tutil.RandomHash()}, fee))
+	require.NoError(t, store.Record(ctx, rejected, orderUpdate{Status: solvernet.StatusRejected, Height: 9, TxHash: tutil.RandomHash(), RejectReason: rejectInsufficientInventory}, nil))
+	record(rejected, solvernet.StatusClosed, 12)
+	record(reorged, solvernet.StatusPending, 11)
+	record(other, solvernet.StatusPending, 20)
+
+	rec, ok, err := store.Get(ctx, opened.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotEmpty(t, rec.GetFillTxHash())
+	require.NotEmpty(t, rec.GetFeeAmount())
+
+	n, err := store.Rollback(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	// Fields set by the reorged fill are reset.
+	rec, ok, err = store.Get(ctx, opened.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, solvernet.StatusPending, rec.GetStatus())
+	require.Len(t, rec.GetTransitions(), 1)
+	require.Empty(t, rec.GetFillTxHash())
+	require.Empty(t, rec.GetFeeAmount())
+	require.Empty(t, rec.GetFeeToken())
+
+	// Fields set by remaining transitions are retained.
+	rec, ok, err = store.Get(ctx, rejected.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.EqualValues(t, solvernet.StatusRejected, rec.GetStatus())
+	require.Equal(t, rejectInsufficientInventory.String(), rec.GetRejectReason())
+
+	_, ok, err = store.Get(ctx, reorged.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	rec, ok, err = store.Get(ctx, other.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, rec.GetTransitions(), 1)
+}
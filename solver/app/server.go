@@ -46,7 +46,7 @@ func serveAPI(address string, endpoints map[string]http.Handler) <-chan error {
 		c := cors.New(cors.Options{
 			AllowedOrigins: []string{"*"},
 			AllowedMethods: []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-			AllowedHeaders: []string{"Origin", "Content-Type", "Accept"},
+			AllowedHeaders: []string{"Origin", "Content-Type", "Accept", apiKeyHeader},
 		})
 
 		srv := &http.Server{
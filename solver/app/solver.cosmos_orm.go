@@ -128,20 +128,153 @@ func NewCursorTable(db ormtable.Schema) (CursorTable, error) {
 	return cursorTable{table}, nil
 }
 
+type OrderRecordTable interface {
+	Insert(ctx context.Context, orderRecord *OrderRecord) error
+	Update(ctx context.Context, orderRecord *OrderRecord) error
+	Save(ctx context.Context, orderRecord *OrderRecord) error
+	Delete(ctx context.Context, orderRecord *OrderRecord) error
+	Has(ctx context.Context, id []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id []byte) (*OrderRecord, error)
+	List(ctx context.Context, prefixKey OrderRecordIndexKey, opts ...ormlist.Option) (OrderRecordIterator, error)
+	ListRange(ctx context.Context, from, to OrderRecordIndexKey, opts ...ormlist.Option) (OrderRecordIterator, error)
+	DeleteBy(ctx context.Context, prefixKey OrderRecordIndexKey) error
+	DeleteRange(ctx context.Context, from, to OrderRecordIndexKey) error
+
+	doNotImplement()
+}
+
+type OrderRecordIterator struct {
+	ormtable.Iterator
+}
+
+func (i OrderRecordIterator) Value() (*OrderRecord, error) {
+	var orderRecord OrderRecord
+	err := i.UnmarshalMessage(&orderRecord)
+	return &orderRecord, err
+}
+
+type OrderRecordIndexKey interface {
+	id() uint32
+	values() []interface{}
+	orderRecordIndexKey()
+}
+
+// primary key starting index..
+type OrderRecordPrimaryKey = OrderRecordIdIndexKey
+
+type OrderRecordIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderRecordIdIndexKey) id() uint32            { return 0 }
+func (x OrderRecordIdIndexKey) values() []interface{} { return x.vs }
+func (x OrderRecordIdIndexKey) orderRecordIndexKey()  {}
+
+func (this OrderRecordIdIndexKey) WithId(id []byte) OrderRecordIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type OrderRecordUpdatedAtIndexKey struct {
+	vs []interface{}
+}
+
+func (x OrderRecordUpdatedAtIndexKey) id() uint32            { return 1 }
+func (x OrderRecordUpdatedAtIndexKey) values() []interface{} { return x.vs }
+func (x OrderRecordUpdatedAtIndexKey) orderRecordIndexKey()  {}
+
+func (this OrderRecordUpdatedAtIndexKey) WithUpdatedAt(updated_at int64) OrderRecordUpdatedAtIndexKey {
+	this.vs = []interface{}{updated_at}
+	return this
+}
+
+type orderRecordTable struct {
+	table ormtable.Table
+}
+
+func (this orderRecordTable) Insert(ctx context.Context, orderRecord *OrderRecord) error {
+	return this.table.Insert(ctx, orderRecord)
+}
+
+func (this orderRecordTable) Update(ctx context.Context, orderRecord *OrderRecord) error {
+	return this.table.Update(ctx, orderRecord)
+}
+
+func (this orderRecordTable) Save(ctx context.Context, orderRecord *OrderRecord) error {
+	return this.table.Save(ctx, orderRecord)
+}
+
+func (this orderRecordTable) Delete(ctx context.Context, orderRecord *OrderRecord) error {
+	return this.table.Delete(ctx, orderRecord)
+}
+
+func (this orderRecordTable) Has(ctx context.Context, id []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this orderRecordTable) Get(ctx context.Context, id []byte) (*OrderRecord, error) {
+	var orderRecord OrderRecord
+	found, err := this.table.PrimaryKey().Get(ctx, &orderRecord, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &orderRecord, nil
+}
+
+func (this orderRecordTable) List(ctx context.Context, prefixKey OrderRecordIndexKey, opts ...ormlist.Option) (OrderRecordIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return OrderRecordIterator{it}, err
+}
+
+func (this orderRecordTable) ListRange(ctx context.Context, from, to OrderRecordIndexKey, opts ...ormlist.Option) (OrderRecordIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return OrderRecordIterator{it}, err
+}
+
+func (this orderRecordTable) DeleteBy(ctx context.Context, prefixKey OrderRecordIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this orderRecordTable) DeleteRange(ctx context.Context, from, to OrderRecordIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this orderRecordTable) doNotImplement() {}
+
+var _ OrderRecordTable = orderRecordTable{}
+
+func NewOrderRecordTable(db ormtable.Schema) (OrderRecordTable, error) {
+	table := db.GetTable(&OrderRecord{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&OrderRecord{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return orderRecordTable{table}, nil
+}
+
 type SolverStore interface {
 	CursorTable() CursorTable
+	OrderRecordTable() OrderRecordTable
 
 	doNotImplement()
 }
 
 type solverStore struct {
-	cursor CursorTable
+	cursor      CursorTable
+	orderRecord OrderRecordTable
 }
 
 func (x solverStore) CursorTable() CursorTable {
 	return x.cursor
 }
 
+func (x solverStore) OrderRecordTable() OrderRecordTable {
+	return x.orderRecord
+}
+
 func (solverStore) doNotImplement() {}
 
 var _ SolverStore = solverStore{}
@@ -152,7 +285,13 @@ func NewSolverStore(db ormtable.Schema) (SolverStore, error) {
 		return nil, err
 	}
 
+	orderRecordTable, err := NewOrderRecordTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return solverStore{
 		cursorTable,
+		orderRecordTable,
 	}, nil
 }
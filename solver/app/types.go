@@ -17,6 +17,7 @@ type (
 
 type Order struct {
 	ID                 OrderID
+	Owner              common.Address
 	FillInstruction    bindings.IERC7683FillInstruction
 	FillOriginData     []byte
 	DestinationSettler common.Address
@@ -36,6 +37,7 @@ func newOrder(resolved OrderResolved, state OrderState) (Order, error) {
 
 	o := Order{
 		ID:                 resolved.OrderId,
+		Owner:              resolved.User,
 		Status:             solvernet.OrderStatus(state.Status),
 		UpdatedBy:          state.UpdatedBy,
 		FillInstruction:    resolved.FillInstructions[0],
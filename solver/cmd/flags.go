@@ -18,4 +18,12 @@ func bindRunFlags(flags *pflag.FlagSet, cfg *solver.Config) {
 	flags.StringVar(&cfg.MonitoringAddr, "monitoring-addr", cfg.MonitoringAddr, "The address to bind the monitoring server")
 	flags.StringVar(&cfg.APIAddr, "api-addr", cfg.APIAddr, "The address to bind the API server")
 	flags.StringVar(&cfg.DBDir, "db-dir", cfg.DBDir, "The path to the database directory")
+	flags.Int64Var(&cfg.FeeBips, "fee-bips", cfg.FeeBips, "Default fee in bips charged by the solver")
+	flags.DurationVar(&cfg.FirmQuoteTTL, "firm-quote-ttl", cfg.FirmQuoteTTL, "Duration firm quotes are valid for, quotes are indicative only if zero")
+	flags.StringSliceVar(&cfg.FirmQuoteAPIKeys, "firm-quote-api-keys", cfg.FirmQuoteAPIKeys, "API keys authenticating callers allowed to request firm quotes")
+	flags.StringToStringVar(&cfg.Rebalance.Bands, "rebalance-bands", cfg.Rebalance.Bands, "Target inventory bands per chain and token in token units, rebalancing is disabled if empty. e.g. \"ethereum:OMNI=1000-50000,omni_evm:OMNI=1000-50000\"")
+	flags.StringToStringVar(&cfg.Rebalance.ETHBridges, "rebalance-eth-bridges", cfg.Rebalance.ETHBridges, "OP-stack L1StandardBridge addresses per L2 chain used to rebalance ETH from L1. e.g. \"base_sepolia=0xfd0Bf71F60660E2f608ed56e1659C450eB113120\"")
+	flags.DurationVar(&cfg.Rebalance.Interval, "rebalance-interval", cfg.Rebalance.Interval, "Interval at which inventory is sampled and rebalanced")
+	flags.BoolVar(&cfg.Rebalance.DryRun, "rebalance-dry-run", cfg.Rebalance.DryRun, "Log planned rebalance transfers without executing them")
+	flags.StringToInt64Var(&cfg.RouteFeeBips, "route-fee-bips", cfg.RouteFeeBips, "Fee in bips overrides per route. e.g. \"holesky:base_sepolia=10,*:omni_omega=0\"")
 }
@@ -1,13 +1,16 @@
 package types
 
 import (
+	"encoding/binary"
 	"math/big"
 	"net/http"
+	"time"
 
 	"github.com/omni-network/omni/lib/contracts/solvernet"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type JSONResponse interface {
@@ -31,12 +34,13 @@ type JSONErrorResponse struct {
 // we'd need a more generic request / response format that discriminates on
 // order type hash.
 type CheckRequest struct {
-	SourceChainID      uint64       `json:"sourceChainId"`
-	DestinationChainID uint64       `json:"destChainId"`
-	FillDeadline       uint32       `json:"fillDeadline"`
-	Calls              JSONCalls    `json:"calls"`
-	Expenses           JSONExpenses `json:"expenses"`
-	Deposit            JSONDeposit  `json:"deposit"`
+	SourceChainID      uint64         `json:"sourceChainId"`
+	DestinationChainID uint64         `json:"destChainId"`
+	FillDeadline       uint32         `json:"fillDeadline"`
+	Owner              common.Address `json:"owner,omitempty"`
+	Calls              JSONCalls      `json:"calls"`
+	Expenses           JSONExpenses   `json:"expenses"`
+	Deposit            JSONDeposit    `json:"deposit"`
 }
 
 // CheckResponse is the response json for the /check endpoint.
@@ -61,11 +65,13 @@ func (r CheckResponse) StatusCode() int {
 // QuoteRequest is the expected request body for the /api/v1/quote endpoint.
 // If deposit amount is omitted, the response will include the required deposit amount.
 // If expense amount is omitted, the response will include the required expense amount.
+// Firm quotes are only issued to authenticated callers, and are bound to the order owner.
 type QuoteRequest struct {
-	SourceChainID      uint64        `json:"sourceChainId"`
-	DestinationChainID uint64        `json:"destChainId"`
-	Deposit            JSONQuoteUnit `json:"deposit"`
-	Expense            JSONQuoteUnit `json:"expense"`
+	SourceChainID      uint64         `json:"sourceChainId"`
+	DestinationChainID uint64         `json:"destChainId"`
+	Deposit            JSONQuoteUnit  `json:"deposit"`
+	Expense            JSONQuoteUnit  `json:"expense"`
+	Owner              common.Address `json:"owner,omitempty"`
 }
 
 // QuoteUnit represents a token and amount pair, with the amount being optional.
@@ -95,10 +101,87 @@ func (qu JSONQuoteUnit) Parse() QuoteUnit {
 }
 
 // QuoteResponse is the response json for the /api/v1/quote endpoint.
+//
+// Firm quotes include a quote ID, all its terms, and the solver signature. The solver honors orders matching
+// firm quote terms (a deposit of at least, and an expense of at most, the quoted amounts) until expiry.
+// The quote ID commits to all quote terms and is the digest signed by the solver, see FirmQuoteTerms.ID.
 type QuoteResponse struct {
-	Deposit JSONQuoteUnit      `json:"deposit"`
-	Expense JSONQuoteUnit      `json:"expense"`
-	Error   *JSONErrorResponse `json:"error,omitempty"`
+	Deposit       JSONQuoteUnit      `json:"deposit"`
+	Expense       JSONQuoteUnit      `json:"expense"`
+	QuoteID       *common.Hash       `json:"quoteId,omitempty"`
+	Expiry        int64              `json:"expiry,omitempty"` // Unix timestamp (seconds)
+	Signature     hexutil.Bytes      `json:"signature,omitempty"`
+	SignerChainID uint64             `json:"signerChainId,omitempty"` // Chain ID of the solver's signing key (Omni EVM)
+	Solver        *common.Address    `json:"solver,omitempty"`
+	Owner         *common.Address    `json:"owner,omitempty"`
+	SourceChainID uint64             `json:"sourceChainId,omitempty"`
+	DestChainID   uint64             `json:"destChainId,omitempty"`
+	Nonce         *common.Hash       `json:"nonce,omitempty"` // Random nonce making quote IDs unique
+	Error         *JSONErrorResponse `json:"error,omitempty"`
+}
+
+// FirmQuoteTerms returns the firm quote terms of the response, or false if it isn't a firm quote.
+func (r QuoteResponse) FirmQuoteTerms() (FirmQuoteTerms, bool) {
+	if r.QuoteID == nil || r.Solver == nil || r.Owner == nil || r.Nonce == nil {
+		return FirmQuoteTerms{}, false
+	}
+
+	return FirmQuoteTerms{
+		SignerChainID: r.SignerChainID,
+		Solver:        *r.Solver,
+		Owner:         *r.Owner,
+		SourceChainID: r.SourceChainID,
+		DestChainID:   r.DestChainID,
+		Deposit:       r.Deposit.Parse(),
+		Expense:       r.Expense.Parse(),
+		Expiry:        r.Expiry,
+		Nonce:         *r.Nonce,
+	}, true
+}
+
+// firmQuoteDomain is the domain separator tag of firm quote IDs.
+const firmQuoteDomain = "omni-solvernet-firm-quote-v1"
+
+// FirmQuoteTerms are the terms of a firm quote that its ID commits to.
+type FirmQuoteTerms struct {
+	SignerChainID uint64         // Chain ID of the solver's signing key, i.e., the Omni EVM
+	Solver        common.Address // Address of the signing solver
+	Owner         common.Address // Order owner the quote is bound to
+	SourceChainID uint64
+	DestChainID   uint64
+	Deposit       QuoteUnit // Native tokens have the zero address
+	Expense       QuoteUnit
+	Expiry        int64 // Unix timestamp (seconds)
+	Nonce         common.Hash
+}
+
+// ID returns the firm quote ID, which is the digest signed by the solver (a raw secp256k1 signature,
+// not EIP-191 prefixed). It is the keccak256 hash of the tightly packed preimage:
+//
+//	keccak256("omni-solvernet-firm-quote-v1") (32 bytes) ||
+//	signerChainId (uint64) || solver (address) || owner (address) ||
+//	sourceChainId (uint64) || destChainId (uint64) ||
+//	deposit.token (address) || deposit.amount (uint256) ||
+//	expense.token (address) || expense.amount (uint256) ||
+//	expiry (uint64) || nonce (32 bytes)
+//
+// Integers are big-endian.
+func (t FirmQuoteTerms) ID() common.Hash {
+	var bz []byte
+	bz = append(bz, crypto.Keccak256([]byte(firmQuoteDomain))...)
+	bz = binary.BigEndian.AppendUint64(bz, t.SignerChainID)
+	bz = append(bz, t.Solver.Bytes()...)
+	bz = append(bz, t.Owner.Bytes()...)
+	bz = binary.BigEndian.AppendUint64(bz, t.SourceChainID)
+	bz = binary.BigEndian.AppendUint64(bz, t.DestChainID)
+	bz = append(bz, t.Deposit.Token.Bytes()...)
+	bz = append(bz, common.BigToHash(t.Deposit.Amount).Bytes()...)
+	bz = append(bz, t.Expense.Token.Bytes()...)
+	bz = append(bz, common.BigToHash(t.Expense.Amount).Bytes()...)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(t.Expiry)) //nolint:gosec // Expiry is positive.
+	bz = append(bz, t.Nonce.Bytes()...)
+
+	return crypto.Keccak256Hash(bz)
 }
 
 var _ JSONResponse = (*QuoteResponse)(nil)
@@ -130,6 +213,62 @@ func (r ContractsResponse) StatusCode() int {
 	return http.StatusOK
 }
 
+// OrderResponse is the response json for the /api/v1/orders/{id} endpoint.
+type OrderResponse struct {
+	Order *JSONOrder         `json:"order,omitempty"`
+	Error *JSONErrorResponse `json:"error,omitempty"`
+}
+
+var _ JSONResponse = (*OrderResponse)(nil)
+
+func (r OrderResponse) StatusCode() int {
+	if r.Error != nil {
+		return r.Error.Code
+	}
+
+	return http.StatusOK
+}
+
+// OrdersResponse is the response json for the /api/v1/orders endpoint.
+// Orders are ordered by most recently updated first.
+type OrdersResponse struct {
+	Orders []JSONOrder        `json:"orders"`
+	Error  *JSONErrorResponse `json:"error,omitempty"`
+}
+
+var _ JSONResponse = (*OrdersResponse)(nil)
+
+func (r OrdersResponse) StatusCode() int {
+	if r.Error != nil {
+		return r.Error.Code
+	}
+
+	return http.StatusOK
+}
+
+// JSONOrder is a json marshal-able order processed by the solver.
+type JSONOrder struct {
+	ID                 common.Hash           `json:"id"`
+	SourceChainID      uint64                `json:"sourceChainId"`
+	DestinationChainID uint64                `json:"destChainId"`
+	Status             string                `json:"status"`
+	RejectReason       string                `json:"rejectReason,omitempty"`
+	FillTxHash         *common.Hash          `json:"fillTxHash,omitempty"`
+	FeeAmount          *hexutil.Big          `json:"feeAmount,omitempty"`
+	FeeToken           string                `json:"feeToken,omitempty"`
+	CreatedAt          time.Time             `json:"createdAt"`
+	UpdatedAt          time.Time             `json:"updatedAt"`
+	Transitions        []JSONOrderTransition `json:"transitions"`
+}
+
+// JSONOrderTransition is a json marshal-able order status transition.
+type JSONOrderTransition struct {
+	Status    string      `json:"status"`
+	Height    uint64      `json:"height"`
+	TxHash    common.Hash `json:"txHash"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
 // JSONExpense is a json marshal-able solvernt.Expense.
 type JSONExpense struct {
 	Spender common.Address `json:"spender"`